@@ -47,6 +47,7 @@ func GetCommentList(ctx context.Context, c *app.RequestContext) {
 		Page:           page,
 		PageSize:       pageSize,
 		IncludeReplies: includeReplies != nil && *includeReplies,
+		Cursor:         common.ParseOptionalStringParam(c, constants.ParamCursor),
 	}
 
 	if sortType != nil {
@@ -57,6 +58,11 @@ func GetCommentList(ctx context.Context, c *app.RequestContext) {
 		req.ParentId = parentID
 	}
 
+	// 过滤当前用户拉黑和屏蔽的用户的评论
+	if userID, ok := common.GetUserIDFromContext(c); ok {
+		req.ViewerId = &userID
+	}
+
 	// 调用评论服务
 	resp, err := handler.GetCommentClient().GetCommentList(ctx, req)
 	if err != nil {
@@ -105,6 +105,7 @@ func GetFollowList(ctx context.Context, c *app.RequestContext) {
 		UserId:   userID,
 		Page:     int32(page),
 		PageSize: int32(pageSize),
+		Cursor:   common.ParseOptionalStringParam(c, constants.ParamCursor),
 	}
 
 	resp, err := handler.GetFollowClient().GetFollowList(ctx, &req)
@@ -140,6 +141,7 @@ func GetFollowerList(ctx context.Context, c *app.RequestContext) {
 		UserId:   userID,
 		Page:     int32(page),
 		PageSize: int32(pageSize),
+		Cursor:   common.ParseOptionalStringParam(c, constants.ParamCursor),
 	}
 
 	resp, err := handler.GetFollowClient().GetFollowerList(ctx, &req)
@@ -52,3 +52,35 @@ func GetFollowerCountHandler(ctx context.Context, c *app.RequestContext) {
 func GetMutualFollowsHandler(ctx context.Context, c *app.RequestContext) {
 	GetMutualFollows(ctx, c)
 }
+
+// ==================== 拉黑和屏蔽Handler ====================
+
+// BlockHandler 拉黑用户
+func BlockHandler(ctx context.Context, c *app.RequestContext) {
+	Block(ctx, c)
+}
+
+// UnblockHandler 取消拉黑
+func UnblockHandler(ctx context.Context, c *app.RequestContext) {
+	Unblock(ctx, c)
+}
+
+// GetBlockListHandler 获取黑名单
+func GetBlockListHandler(ctx context.Context, c *app.RequestContext) {
+	GetBlockList(ctx, c)
+}
+
+// MuteHandler 屏蔽用户
+func MuteHandler(ctx context.Context, c *app.RequestContext) {
+	Mute(ctx, c)
+}
+
+// UnmuteHandler 取消屏蔽
+func UnmuteHandler(ctx context.Context, c *app.RequestContext) {
+	Unmute(ctx, c)
+}
+
+// GetMuteListHandler 获取屏蔽列表
+func GetMuteListHandler(ctx context.Context, c *app.RequestContext) {
+	GetMuteList(ctx, c)
+}
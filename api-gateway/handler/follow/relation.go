@@ -0,0 +1,126 @@
+package follow
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/kitex/client/callopt"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/follow"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+)
+
+// relationCall 拉黑、屏蔽及取消操作的服务调用
+type relationCall func(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (*follow.RelationResponse, error)
+
+// relationListCall 黑名单、屏蔽列表的服务调用
+type relationListCall func(ctx context.Context, req *follow.GetRelationListRequest, callOptions ...callopt.Option) (*follow.GetRelationListResponse, error)
+
+// Block 拉黑用户
+func Block(ctx context.Context, c *app.RequestContext) {
+	changeRelation(ctx, c, "Block", handler.GetFollowClient().Block, false)
+}
+
+// Unblock 取消拉黑
+func Unblock(ctx context.Context, c *app.RequestContext) {
+	changeRelation(ctx, c, "Unblock", handler.GetFollowClient().Unblock, true)
+}
+
+// Mute 屏蔽用户
+func Mute(ctx context.Context, c *app.RequestContext) {
+	changeRelation(ctx, c, "Mute", handler.GetFollowClient().Mute, false)
+}
+
+// Unmute 取消屏蔽
+func Unmute(ctx context.Context, c *app.RequestContext) {
+	changeRelation(ctx, c, "Unmute", handler.GetFollowClient().Unmute, true)
+}
+
+// GetBlockList 获取当前用户的黑名单
+func GetBlockList(ctx context.Context, c *app.RequestContext) {
+	getRelationList(ctx, c, "GetBlockList", handler.GetFollowClient().GetBlockList)
+}
+
+// GetMuteList 获取当前用户的屏蔽列表
+func GetMuteList(ctx context.Context, c *app.RequestContext) {
+	getRelationList(ctx, c, "GetMuteList", handler.GetFollowClient().GetMuteList)
+}
+
+// changeRelation 修改拉黑或屏蔽关系，添加时从请求体读取对方ID，取消时从路径读取
+func changeRelation(ctx context.Context, c *app.RequestContext, operation string, call relationCall, fromPath bool) {
+	// 获取trace ID
+	traceId := c.GetString("trace_id")
+	log.GetLogger().Infof("[%s] %s request started", traceId, operation)
+
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求参数
+	var req follow.RelationRequest
+	if fromPath {
+		req.TargetUserId = common.GetPathParam(c, common.UserIDKey)
+	} else if err := c.BindAndValidate(&req); err != nil {
+		common.RespondBadRequest(c, constants.MsgParamError+": "+err.Error())
+		return
+	}
+	if req.TargetUserId == "" {
+		common.RespondBadRequest(c, constants.MsgUserIDRequired)
+		return
+	}
+	req.UserId = userID
+
+	// 调用关注服务
+	resp, err := call(ctx, &req)
+	if err != nil {
+		common.HandleRpcError(c, operation, traceId)
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, operation, traceId, resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
+
+// getRelationList 获取当前用户的黑名单或屏蔽列表
+func getRelationList(ctx context.Context, c *app.RequestContext, operation string, call relationListCall) {
+	// 获取trace ID
+	traceId := c.GetString("trace_id")
+	log.GetLogger().Infof("[%s] %s request started", traceId, operation)
+
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 调用关注服务
+	req := follow.GetRelationListRequest{
+		UserId:   userID,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Cursor:   common.ParseOptionalStringParam(c, constants.ParamCursor),
+	}
+
+	resp, err := call(ctx, &req)
+	if err != nil {
+		common.HandleRpcError(c, operation, traceId)
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, operation, traceId, resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
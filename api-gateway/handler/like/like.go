@@ -485,6 +485,9 @@ func GetLikeUsers(ctx context.Context, c *app.RequestContext) {
 		Page:       page,
 		PageSize:   pageSize,
 	}
+	if userID, ok := common.GetUserIDFromContext(c); ok {
+		req.ViewerId = &userID
+	}
 
 	// 调用点赞服务
 	resp, err := handler.GetLikeClient().GetLikeUsers(ctx, req)
@@ -43,6 +43,9 @@ func GetNotificationList(ctx context.Context, c *app.RequestContext) {
 		Page:     int32(page),
 		PageSize: int32(pageSize),
 	}
+	if cursor := c.Query("cursor"); cursor != "" {
+		req.Cursor = &cursor
+	}
 	resp, err := notificationClient.GetNotificationList(ctx, req)
 	if err != nil {
 		log.GetLogger().Errorf("GetNotificationList error: %v", err)
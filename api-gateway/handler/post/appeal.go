@@ -0,0 +1,150 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// CreateAppealRequest 提交申诉请求结构
+type CreateAppealRequest struct {
+	TargetType string  `json:"target_type" binding:"required"` // post / user
+	TargetID   *string `json:"target_id,omitempty"`            // 申诉帖子时必填
+	Statement  string  `json:"statement" binding:"required"`
+}
+
+// ResolveAppealRequest 复核申诉请求结构
+type ResolveAppealRequest struct {
+	Overturn bool    `json:"overturn"`
+	Note     *string `json:"note,omitempty"`
+}
+
+// 对被移除的帖子或被封禁的账号提交申诉
+func CreateAppeal(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求参数
+	var body CreateAppealRequest
+	if !common.BindAndValidateRequest(c, &body) {
+		return
+	}
+
+	// 构建请求
+	req := &post.CreateAppealRequest{
+		TargetType: body.TargetType,
+		TargetId:   body.TargetID,
+		Statement:  body.Statement,
+		UserId:     userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.CreateAppeal(ctx, req)
+	}), "CreateAppeal", constants.MsgAppealFailed)
+}
+
+// 获取我的申诉
+func GetMyAppeals(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetMyAppealsRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetMyAppeals(ctx, req)
+	}), "GetMyAppeals", constants.MsgGetAppealsFailed)
+}
+
+// 获取申诉队列，仅管理员
+func GetAppeals(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	if _, exists := common.GetUserIDFromContext(c); !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetAppealsRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if status := c.Query("status"); status != "" {
+		req.Status = &status
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetAppeals(ctx, req)
+	}), "GetAppeals", constants.MsgGetAppealsFailed)
+}
+
+// 复核申诉，仅管理员
+func ResolveAppeal(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取申诉ID参数
+	appealID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgAppealIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var body ResolveAppealRequest
+	if !common.BindAndValidateRequest(c, &body) {
+		return
+	}
+
+	// 构建请求
+	req := &post.ResolveAppealRequest{
+		AppealId:   appealID,
+		Overturn:   body.Overturn,
+		Note:       body.Note,
+		OperatorId: userID,
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ResolveAppeal(ctx, req)
+	}), "ResolveAppeal", constants.MsgResolveAppealFail)
+}
@@ -0,0 +1,178 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 创建草稿
+func CreateDraft(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求参数
+	var req post.CreateDraftRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.CreateDraft(ctx, &req)
+	}), "CreateDraft", constants.MsgSaveDraftFailed)
+}
+
+// 自动保存草稿，版本冲突时响应中返回服务端当前的草稿
+func UpdateDraft(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取草稿ID参数
+	draftID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgDraftIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.UpdateDraftRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.DraftId = draftID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.UpdateDraft(ctx, &req)
+	}), "UpdateDraft", constants.MsgSaveDraftFailed)
+}
+
+// 获取草稿详情
+func GetDraft(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取草稿ID参数
+	draftID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgDraftIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.GetDraftRequest{
+		DraftId: draftID,
+		UserId:  userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetDraft(ctx, req)
+	}), "GetDraft", constants.MsgGetDraftFailed)
+}
+
+// 获取草稿箱列表
+func GetDraftList(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetDraftListRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetDraftList(ctx, req)
+	}), "GetDraftList", constants.MsgGetDraftFailed)
+}
+
+// 删除草稿
+func DeleteDraft(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取草稿ID参数
+	draftID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgDraftIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.DeleteDraftRequest{
+		DraftId: draftID,
+		UserId:  userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.DeleteDraft(ctx, req)
+	}), "DeleteDraft", constants.MsgDeleteDraftFailed)
+}
+
+// 发布草稿
+func PublishDraft(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取草稿ID参数
+	draftID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgDraftIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求，传入版本号时校验草稿未被其他设备修改
+	req := &post.PublishDraftRequest{
+		DraftId: draftID,
+		UserId:  userID,
+	}
+	if version := common.ParseOptionalIntParam(c, constants.ParamVersion); version > 0 {
+		req.Version = &version
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.PublishDraft(ctx, req)
+	}), "PublishDraft", constants.MsgPublishDraftFailed)
+}
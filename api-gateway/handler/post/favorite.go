@@ -27,10 +27,11 @@ func CollectPost(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 构建请求
+	// 构建请求，未指定收藏夹时收藏到默认收藏夹
 	req := &post.CollectPostRequest{
-		UserId: userID,
-		PostId: postID,
+		UserId:   userID,
+		PostId:   postID,
+		FolderId: common.ParseOptionalStringParam(c, constants.ParamFolderID),
 	}
 
 	// 调用帖子服务
@@ -85,6 +86,7 @@ func GetCollectedPosts(ctx context.Context, c *app.RequestContext) {
 		UserId:   userID,
 		Page:     page,
 		PageSize: pageSize,
+		Cursor:   common.ParseOptionalStringParam(c, constants.ParamCursor),
 	}
 
 	// 调用帖子服务
@@ -0,0 +1,194 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 获取我的收藏夹
+func GetMyFavoriteFolders(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 构建请求
+	req := &post.GetFavoriteFoldersRequest{
+		UserId:   userID,
+		ViewerId: &userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetFavoriteFolders(ctx, req)
+	}), "GetFavoriteFolders", constants.MsgGetFoldersFailed)
+}
+
+// 获取用户的收藏夹，查看他人时只返回公开的收藏夹
+func GetUserFavoriteFolders(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID参数
+	userID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgUserIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.GetFavoriteFoldersRequest{UserId: userID}
+	if viewerID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &viewerID
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetFavoriteFolders(ctx, req)
+	}), "GetFavoriteFolders", constants.MsgGetFoldersFailed)
+}
+
+// 创建收藏夹
+func CreateFavoriteFolder(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求参数
+	var req post.CreateFavoriteFolderRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.CreateFavoriteFolder(ctx, &req)
+	}), "CreateFavoriteFolder", constants.MsgCreateFolderFailed)
+}
+
+// 修改收藏夹
+func UpdateFavoriteFolder(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取收藏夹ID参数
+	folderID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgFolderIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.UpdateFavoriteFolderRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.FolderId = folderID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.UpdateFavoriteFolder(ctx, &req)
+	}), "UpdateFavoriteFolder", constants.MsgUpdateFolderFailed)
+}
+
+// 删除收藏夹
+func DeleteFavoriteFolder(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取收藏夹ID参数
+	folderID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgFolderIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.DeleteFavoriteFolderRequest{
+		FolderId: folderID,
+		UserId:   userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.DeleteFavoriteFolder(ctx, req)
+	}), "DeleteFavoriteFolder", constants.MsgDeleteFolderFailed)
+}
+
+// 获取收藏夹中的帖子
+func GetFavoriteFolderPosts(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取收藏夹ID参数
+	folderID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgFolderIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求，私密收藏夹只有所有者可以查看
+	req := &post.GetFavoriteFolderPostsRequest{
+		FolderId: folderID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if viewerID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &viewerID
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetFavoriteFolderPosts(ctx, req)
+	}), "GetFavoriteFolderPosts", constants.MsgGetFolderPostsFail)
+}
+
+// 移动收藏到其他收藏夹
+func MoveFavorites(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取目标收藏夹ID参数
+	folderID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgFolderIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.MoveFavoritesRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.FolderId = folderID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.MoveFavorites(ctx, &req)
+	}), "MoveFavorites", constants.MsgMoveFavoritesFail)
+}
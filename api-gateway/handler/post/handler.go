@@ -28,6 +28,16 @@ func DeletePostHandler(ctx context.Context, c *app.RequestContext) {
 	DeletePost(ctx, c)
 }
 
+// GetPostRevisionsHandler 获取帖子历史版本列表
+func GetPostRevisionsHandler(ctx context.Context, c *app.RequestContext) {
+	GetPostRevisions(ctx, c)
+}
+
+// GetPostRevisionHandler 获取帖子指定版本
+func GetPostRevisionHandler(ctx context.Context, c *app.RequestContext) {
+	GetPostRevision(ctx, c)
+}
+
 // GetPostListHandler 获取帖子列表
 func GetPostListHandler(ctx context.Context, c *app.RequestContext) {
 	GetPostList(ctx, c)
@@ -63,6 +73,33 @@ func SearchPostsHandler(ctx context.Context, c *app.RequestContext) {
 	SearchPosts(ctx, c)
 }
 
+// GetRelatedPostsHandler 获取相关帖子
+func GetRelatedPostsHandler(ctx context.Context, c *app.RequestContext) {
+	GetRelatedPosts(ctx, c)
+}
+
+// GetSearchSuggestionsHandler 搜索建议
+func GetSearchSuggestionsHandler(ctx context.Context, c *app.RequestContext) {
+	GetSearchSuggestions(ctx, c)
+}
+
+// GetTrendingSearchesHandler 热门搜索
+func GetTrendingSearchesHandler(ctx context.Context, c *app.RequestContext) {
+	GetTrendingSearches(ctx, c)
+}
+
+// ==================== 回收站Handler ====================
+
+// GetDeletedPostsHandler 获取最近删除的帖子
+func GetDeletedPostsHandler(ctx context.Context, c *app.RequestContext) {
+	GetDeletedPosts(ctx, c)
+}
+
+// RestorePostHandler 恢复已删除的帖子
+func RestorePostHandler(ctx context.Context, c *app.RequestContext) {
+	RestorePost(ctx, c)
+}
+
 // ==================== 话题管理Handler ====================
 
 // CreateTopicHandler 创建话题
@@ -100,6 +137,26 @@ func ShareTopicHandler(ctx context.Context, c *app.RequestContext) {
 	ShareTopic(ctx, c)
 }
 
+// SubscribeTopicHandler 订阅话题
+func SubscribeTopicHandler(ctx context.Context, c *app.RequestContext) {
+	SubscribeTopic(ctx, c)
+}
+
+// UnsubscribeTopicHandler 取消订阅话题
+func UnsubscribeTopicHandler(ctx context.Context, c *app.RequestContext) {
+	UnsubscribeTopic(ctx, c)
+}
+
+// GetSubscribedTopicsHandler 获取已订阅的话题
+func GetSubscribedTopicsHandler(ctx context.Context, c *app.RequestContext) {
+	GetSubscribedTopics(ctx, c)
+}
+
+// GetSubscribedTopicFeedHandler 获取已订阅话题下的新帖
+func GetSubscribedTopicFeedHandler(ctx context.Context, c *app.RequestContext) {
+	GetSubscribedTopicFeed(ctx, c)
+}
+
 // ==================== 收藏管理Handler ====================
 
 // CollectPostHandler 收藏帖子
@@ -139,7 +196,172 @@ func DeleteRatingHandler(ctx context.Context, c *app.RequestContext) {
 	DeleteRating(ctx, c)
 }
 
+// GetPostRatingDistributionHandler 获取帖子评分分布
+func GetPostRatingDistributionHandler(ctx context.Context, c *app.RequestContext) {
+	GetPostRatingDistribution(ctx, c)
+}
+
 // GetRatingRankHandler 获取评分排行榜
 func GetRatingRankHandler(ctx context.Context, c *app.RequestContext) {
 	GetRatingRank(ctx, c)
 }
+
+// CreateDraftHandler 创建草稿
+func CreateDraftHandler(ctx context.Context, c *app.RequestContext) {
+	CreateDraft(ctx, c)
+}
+
+// UpdateDraftHandler 自动保存草稿
+func UpdateDraftHandler(ctx context.Context, c *app.RequestContext) {
+	UpdateDraft(ctx, c)
+}
+
+// GetDraftHandler 获取草稿详情
+func GetDraftHandler(ctx context.Context, c *app.RequestContext) {
+	GetDraft(ctx, c)
+}
+
+// GetDraftListHandler 获取草稿箱列表
+func GetDraftListHandler(ctx context.Context, c *app.RequestContext) {
+	GetDraftList(ctx, c)
+}
+
+// DeleteDraftHandler 删除草稿
+func DeleteDraftHandler(ctx context.Context, c *app.RequestContext) {
+	DeleteDraft(ctx, c)
+}
+
+// PublishDraftHandler 发布草稿
+func PublishDraftHandler(ctx context.Context, c *app.RequestContext) {
+	PublishDraft(ctx, c)
+}
+
+// GetBrowsingHistoryHandler 获取浏览历史
+func GetBrowsingHistoryHandler(ctx context.Context, c *app.RequestContext) {
+	GetBrowsingHistory(ctx, c)
+}
+
+// ClearBrowsingHistoryHandler 清空浏览历史
+func ClearBrowsingHistoryHandler(ctx context.Context, c *app.RequestContext) {
+	ClearBrowsingHistory(ctx, c)
+}
+
+// RemoveBrowsingHistoryHandler 删除单条浏览历史
+func RemoveBrowsingHistoryHandler(ctx context.Context, c *app.RequestContext) {
+	RemoveBrowsingHistory(ctx, c)
+}
+
+// GetScheduledPostsHandler 获取定时发布的帖子
+func GetScheduledPostsHandler(ctx context.Context, c *app.RequestContext) {
+	GetScheduledPosts(ctx, c)
+}
+
+// ReschedulePostHandler 修改定时发布时间
+func ReschedulePostHandler(ctx context.Context, c *app.RequestContext) {
+	ReschedulePost(ctx, c)
+}
+
+// CancelScheduledPostHandler 取消定时发布
+func CancelScheduledPostHandler(ctx context.Context, c *app.RequestContext) {
+	CancelScheduledPost(ctx, c)
+}
+
+// VotePollHandler 投票
+func VotePollHandler(ctx context.Context, c *app.RequestContext) {
+	VotePoll(ctx, c)
+}
+
+// ChangePollVoteHandler 修改投票
+func ChangePollVoteHandler(ctx context.Context, c *app.RequestContext) {
+	ChangePollVote(ctx, c)
+}
+
+// GetTrendingTagsHandler 获取热门标签
+func GetTrendingTagsHandler(ctx context.Context, c *app.RequestContext) {
+	GetTrendingTags(ctx, c)
+}
+
+// GetTagHandler 获取标签详情
+func GetTagHandler(ctx context.Context, c *app.RequestContext) {
+	GetTag(ctx, c)
+}
+
+// GetMyFavoriteFoldersHandler 获取我的收藏夹
+func GetMyFavoriteFoldersHandler(ctx context.Context, c *app.RequestContext) {
+	GetMyFavoriteFolders(ctx, c)
+}
+
+// GetUserFavoriteFoldersHandler 获取用户公开的收藏夹
+func GetUserFavoriteFoldersHandler(ctx context.Context, c *app.RequestContext) {
+	GetUserFavoriteFolders(ctx, c)
+}
+
+// CreateFavoriteFolderHandler 创建收藏夹
+func CreateFavoriteFolderHandler(ctx context.Context, c *app.RequestContext) {
+	CreateFavoriteFolder(ctx, c)
+}
+
+// UpdateFavoriteFolderHandler 修改收藏夹
+func UpdateFavoriteFolderHandler(ctx context.Context, c *app.RequestContext) {
+	UpdateFavoriteFolder(ctx, c)
+}
+
+// DeleteFavoriteFolderHandler 删除收藏夹
+func DeleteFavoriteFolderHandler(ctx context.Context, c *app.RequestContext) {
+	DeleteFavoriteFolder(ctx, c)
+}
+
+// GetFavoriteFolderPostsHandler 获取收藏夹中的帖子
+func GetFavoriteFolderPostsHandler(ctx context.Context, c *app.RequestContext) {
+	GetFavoriteFolderPosts(ctx, c)
+}
+
+// MoveFavoritesHandler 移动收藏到其他收藏夹
+func MoveFavoritesHandler(ctx context.Context, c *app.RequestContext) {
+	MoveFavorites(ctx, c)
+}
+
+// GetModerationReviewsHandler 获取内容复审队列
+func GetModerationReviewsHandler(ctx context.Context, c *app.RequestContext) {
+	GetModerationReviews(ctx, c)
+}
+
+// ResolveModerationReviewHandler 处理送审内容
+func ResolveModerationReviewHandler(ctx context.Context, c *app.RequestContext) {
+	ResolveModerationReview(ctx, c)
+}
+
+// CreateReportHandler 举报
+func CreateReportHandler(ctx context.Context, c *app.RequestContext) {
+	CreateReport(ctx, c)
+}
+
+// GetReportCasesHandler 获取举报队列
+func GetReportCasesHandler(ctx context.Context, c *app.RequestContext) {
+	GetReportCases(ctx, c)
+}
+
+// ResolveReportCaseHandler 处理举报单
+func ResolveReportCaseHandler(ctx context.Context, c *app.RequestContext) {
+	ResolveReportCase(ctx, c)
+}
+
+// CreateAppealHandler 提交申诉
+func CreateAppealHandler(ctx context.Context, c *app.RequestContext) {
+	CreateAppeal(ctx, c)
+}
+
+// GetMyAppealsHandler 获取我的申诉
+func GetMyAppealsHandler(ctx context.Context, c *app.RequestContext) {
+	GetMyAppeals(ctx, c)
+}
+
+// GetAppealsHandler 获取申诉队列
+func GetAppealsHandler(ctx context.Context, c *app.RequestContext) {
+	GetAppeals(ctx, c)
+}
+
+// ResolveAppealHandler 复核申诉
+func ResolveAppealHandler(ctx context.Context, c *app.RequestContext) {
+	ResolveAppeal(ctx, c)
+}
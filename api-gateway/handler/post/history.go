@@ -0,0 +1,85 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 获取浏览历史
+func GetBrowsingHistory(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetBrowsingHistoryRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetBrowsingHistory(ctx, req)
+	}), "GetBrowsingHistory", constants.MsgGetHistoryFailed)
+}
+
+// 清空浏览历史
+func ClearBrowsingHistory(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 构建请求
+	req := &post.ClearBrowsingHistoryRequest{UserId: userID}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ClearBrowsingHistory(ctx, req)
+	}), "ClearBrowsingHistory", constants.MsgClearHistoryFailed)
+}
+
+// 删除单条浏览历史
+func RemoveBrowsingHistory(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.RemoveBrowsingHistoryRequest{
+		UserId: userID,
+		PostId: postID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.RemoveBrowsingHistory(ctx, req)
+	}), "RemoveBrowsingHistory", constants.MsgDelHistoryFailed)
+}
@@ -0,0 +1,85 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// ResolveModerationReviewRequest 处理送审内容请求结构
+type ResolveModerationReviewRequest struct {
+	Approve bool `json:"approve"`
+}
+
+// 获取内容复审队列，仅管理员
+func GetModerationReviews(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	if _, exists := common.GetUserIDFromContext(c); !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetModerationReviewsRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if status := c.Query("status"); status != "" {
+		req.ReviewStatus = &status
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetModerationReviews(ctx, req)
+	}), "GetModerationReviews", constants.MsgGetReviewsFailed)
+}
+
+// 处理送审的帖子或评论，仅管理员
+func ResolveModerationReview(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取审核记录ID参数
+	recordID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgReviewIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var body ResolveModerationReviewRequest
+	if !common.BindAndValidateRequest(c, &body) {
+		return
+	}
+
+	// 构建请求
+	req := &post.ResolveModerationReviewRequest{
+		RecordId:   recordID,
+		Approve:    body.Approve,
+		OperatorId: userID,
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ResolveModerationReview(ctx, req)
+	}), "ResolveModerationReview", constants.MsgResolveReviewFail)
+}
@@ -0,0 +1,72 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 投票
+func VotePoll(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.VotePollRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.PostId = postID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.VotePoll(ctx, &req)
+	}), "VotePoll", constants.MsgVotePollFailed)
+}
+
+// 修改投票
+func ChangePollVote(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.ChangePollVoteRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.PostId = postID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ChangePollVote(ctx, &req)
+	}), "ChangePollVote", constants.MsgChangeVoteFailed)
+}
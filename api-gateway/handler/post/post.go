@@ -2,6 +2,7 @@ package post
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cloudwego/hertz/pkg/app"
 
@@ -60,8 +61,18 @@ func GetPost(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 构建请求，登录用户记录看过的帖子，未登录时按设备去重浏览量，没有设备标识时按IP
+	clientIP := c.ClientIP()
+	req := &post.GetPostRequest{PostId: postID, ClientIp: &clientIP}
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+	if deviceID := string(c.GetHeader(constants.HeaderDeviceID)); deviceID != "" {
+		req.DeviceId = &deviceID
+	}
+
 	// 调用帖子服务
-	resp, err := postClient.GetPost(ctx, &post.GetPostRequest{PostId: postID})
+	resp, err := postClient.GetPost(ctx, req)
 	if err != nil {
 		common.HandleRpcError(c, "GetPost", traceId.(string))
 		return
@@ -100,9 +111,13 @@ func UpdatePost(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 设置用户ID和帖子ID
+	// 设置用户ID、角色和帖子ID，角色以JWT为准
 	req.UserId = userID
 	req.PostId = postID
+	req.OperatorRole = nil
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
 
 	// 调用帖子服务
 	resp, err := postClient.UpdatePost(ctx, &req)
@@ -117,6 +132,90 @@ func UpdatePost(ctx context.Context, c *app.RequestContext) {
 	common.RespondWithSuccess(c, resp)
 }
 
+// 获取帖子历史版本列表
+func GetPostRevisions(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	logger := log.GetLogger().WithField(constants.TraceIdKey, traceId)
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		logger.Errorf("GetPostRevisions ValidateRequiredPathParam failed")
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求，未发布或已删除的帖子仅作者和管理员可查看历史版本
+	req := &post.GetPostRevisionsRequest{
+		PostId:   postID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+	if role, exists := common.GetUserRoleFromContext(c); exists {
+		req.ViewerRole = &role
+	}
+
+	// 调用帖子服务
+	resp, err := handler.GetPostClient().GetPostRevisions(ctx, req)
+	if err != nil {
+		common.HandleRpcError(c, "GetPostRevisions", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "GetPostRevisions", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
+
+// 获取帖子指定版本
+func GetPostRevision(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	logger := log.GetLogger().WithField(constants.TraceIdKey, traceId)
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		logger.Errorf("GetPostRevision ValidateRequiredPathParam failed")
+		return
+	}
+
+	// 获取版本号参数
+	version, err := strconv.ParseInt(c.Param("version"), 10, 32)
+	if err != nil || version <= 0 {
+		logger.Errorf("GetPostRevision invalid version: %s", c.Param("version"))
+		common.RespondBadRequest(c, constants.MsgVersionInvalid)
+		return
+	}
+
+	// 构建请求，可见范围同历史版本列表
+	req := &post.GetPostRevisionRequest{
+		PostId:  postID,
+		Version: int32(version),
+	}
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+	if role, exists := common.GetUserRoleFromContext(c); exists {
+		req.ViewerRole = &role
+	}
+
+	// 调用帖子服务
+	resp, err := handler.GetPostClient().GetPostRevision(ctx, req)
+	if err != nil {
+		common.HandleRpcError(c, "GetPostRevision", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "GetPostRevision", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
+
 // 删除帖子
 func DeletePost(ctx context.Context, c *app.RequestContext) {
 	traceId, _ := c.Get(constants.TraceIdKey)
@@ -142,6 +241,9 @@ func DeletePost(ctx context.Context, c *app.RequestContext) {
 		UserId: userID,
 		PostId: postID,
 	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
 
 	// 调用帖子服务
 	resp, err := postClient.DeletePost(ctx, req)
@@ -166,6 +268,7 @@ func GetPostList(ctx context.Context, c *app.RequestContext) {
 	category := int64(common.ParseOptionalIntParam(c, constants.ParamCategory))
 	topic := common.ParseOptionalStringParam(c, constants.ParamTopicID)
 	sortType := common.ParseOptionalStringParam(c, constants.ParamSortType)
+	cursor := common.ParseOptionalStringParam(c, constants.ParamCursor)
 
 	// 构建请求
 	req := &post.GetPostListRequest{
@@ -174,6 +277,11 @@ func GetPostList(ctx context.Context, c *app.RequestContext) {
 		Category: (*post.PostCategory)(&category),
 		TopicId:  topic,
 		SortType: sortType,
+		Cursor:   cursor,
+	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
 	}
 
 	// 调用帖子服务
@@ -208,6 +316,11 @@ func GetRecommendPosts(ctx context.Context, c *app.RequestContext) {
 		Tag:      tag,
 	}
 
+	// 登录用户返回个性化推荐
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+
 	// 调用帖子服务
 	resp, err := postClient.GetRecommendPosts(ctx, req)
 	if err != nil {
@@ -231,6 +344,7 @@ func GetHotPosts(ctx context.Context, c *app.RequestContext) {
 	// 解析其他查询参数
 	category := common.ParseOptionalStringParam(c, constants.ParamCategory)
 	tag := common.ParseOptionalStringParam(c, constants.ParamTag)
+	cursor := common.ParseOptionalStringParam(c, constants.ParamCursor)
 
 	// 构建请求
 	req := &post.GetHotPostsRequest{
@@ -238,6 +352,11 @@ func GetHotPosts(ctx context.Context, c *app.RequestContext) {
 		PageSize: pageSize,
 		Category: category,
 		Tag:      tag,
+		Cursor:   cursor,
+	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
 	}
 
 	// 调用帖子服务
@@ -271,6 +390,10 @@ func GetHighScorePosts(ctx context.Context, c *app.RequestContext) {
 		Category: category,
 		Tag:      tag,
 	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
 
 	// 调用帖子服务
 	resp, err := postClient.GetHighScorePosts(ctx, req)
@@ -303,6 +426,10 @@ func GetLowScorePosts(ctx context.Context, c *app.RequestContext) {
 		Category: category,
 		Tag:      tag,
 	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
 
 	// 调用帖子服务
 	resp, err := postClient.GetLowScorePosts(ctx, req)
@@ -335,6 +462,10 @@ func GetControversialPosts(ctx context.Context, c *app.RequestContext) {
 		Category: category,
 		Tag:      tag,
 	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
 
 	// 调用帖子服务
 	resp, err := postClient.GetControversialPosts(ctx, req)
@@ -362,12 +493,22 @@ func SearchPosts(ctx context.Context, c *app.RequestContext) {
 
 	// 解析分页参数
 	page, pageSize := common.ParsePaginationParams(c)
+	category := common.ParseOptionalStringParam(c, constants.ParamCategory)
+	sortType := common.ParseOptionalStringParam(c, constants.ParamSortType)
 
-	// 构建请求
+	// 构建请求，客户端IP用于未登录时搜索词按人去重
+	clientIP := c.ClientIP()
 	req := &post.SearchPostsRequest{
 		Keyword:  keyword,
 		Page:     page,
 		PageSize: pageSize,
+		Category: category,
+		SortType: sortType,
+		ClientIp: &clientIP,
+	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
 	}
 
 	// 调用帖子服务
@@ -382,3 +523,81 @@ func SearchPosts(ctx context.Context, c *app.RequestContext) {
 	}
 	common.RespondWithSuccess(c, resp)
 }
+
+// 获取相关帖子
+func GetRelatedPosts(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.GetRelatedPostsRequest{
+		PostId: postID,
+		Limit:  common.ParseOptionalIntParam(c, constants.ParamLimit),
+	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetRelatedPosts(ctx, req)
+	}), "GetRelatedPosts", constants.MsgGetRelatedFailed)
+}
+
+// 搜索建议
+func GetSearchSuggestions(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	postClient := handler.GetPostClient()
+	// 获取输入的前缀
+	query := c.Query(constants.ParamQuery)
+	if query == "" {
+		common.RespondBadRequest(c, constants.MsgKeywordEmpty)
+		return
+	}
+
+	// 构建请求
+	req := &post.GetSearchSuggestionsRequest{
+		Query: query,
+		Limit: common.ParseOptionalIntParam(c, constants.ParamLimit),
+	}
+
+	// 调用帖子服务
+	resp, err := postClient.GetSearchSuggestions(ctx, req)
+	if err != nil {
+		common.HandleRpcError(c, "GetSearchSuggestions", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "GetSearchSuggestions", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
+
+// 热门搜索
+func GetTrendingSearches(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	postClient := handler.GetPostClient()
+
+	// 构建请求
+	req := &post.GetTrendingSearchesRequest{
+		Limit: common.ParseOptionalIntParam(c, constants.ParamLimit),
+	}
+
+	// 调用帖子服务
+	resp, err := postClient.GetTrendingSearches(ctx, req)
+	if err != nil {
+		common.HandleRpcError(c, "GetTrendingSearches", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "GetTrendingSearches", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
@@ -124,6 +124,26 @@ func DeleteRating(ctx context.Context, c *app.RequestContext) {
 	}), "DeleteRating", constants.MsgDeleteRatingFailed)
 }
 
+// 获取帖子评分分布
+func GetPostRatingDistribution(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.GetPostRatingDistributionRequest{
+		PostId: postID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetPostRatingDistribution(ctx, req)
+	}), "GetPostRatingDistribution", constants.MsgRatingDistFailed)
+}
+
 // 获取评分排行榜
 func GetRatingRank(ctx context.Context, c *app.RequestContext) {
 	postClient := handler.GetPostClient()
@@ -0,0 +1,126 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// CreateReportRequest 举报请求结构
+type CreateReportRequest struct {
+	TargetType string  `json:"target_type" binding:"required"` // post / comment / user
+	TargetID   string  `json:"target_id" binding:"required"`
+	Reason     string  `json:"reason" binding:"required"` // spam / abuse / porn / illegal / other
+	Detail     *string `json:"detail,omitempty"`
+}
+
+// ResolveReportCaseRequest 处理举报单请求结构
+type ResolveReportCaseRequest struct {
+	Action string  `json:"action" binding:"required"` // approve / remove / ban
+	Note   *string `json:"note,omitempty"`
+}
+
+// 举报帖子、评论或用户
+func CreateReport(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求参数
+	var body CreateReportRequest
+	if !common.BindAndValidateRequest(c, &body) {
+		return
+	}
+
+	// 构建请求
+	req := &post.CreateReportRequest{
+		TargetType: body.TargetType,
+		TargetId:   body.TargetID,
+		Reason:     body.Reason,
+		Detail:     body.Detail,
+		UserId:     userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.CreateReport(ctx, req)
+	}), "CreateReport", constants.MsgReportFailed)
+}
+
+// 获取举报队列，仅管理员
+func GetReportCases(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	if _, exists := common.GetUserIDFromContext(c); !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetReportCasesRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if status := c.Query("status"); status != "" {
+		req.Status = &status
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetReportCases(ctx, req)
+	}), "GetReportCases", constants.MsgGetReportsFailed)
+}
+
+// 处理举报单，仅管理员
+func ResolveReportCase(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取举报单ID参数
+	caseID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgReportCaseIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var body ResolveReportCaseRequest
+	if !common.BindAndValidateRequest(c, &body) {
+		return
+	}
+
+	// 构建请求
+	req := &post.ResolveReportCaseRequest{
+		CaseId:     caseID,
+		Action:     body.Action,
+		Note:       body.Note,
+		OperatorId: userID,
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ResolveReportCase(ctx, req)
+	}), "ResolveReportCase", constants.MsgResolveReportFail)
+}
@@ -0,0 +1,96 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 获取定时发布的帖子
+func GetScheduledPosts(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetScheduledPostsRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetScheduledPosts(ctx, req)
+	}), "GetScheduledPosts", constants.MsgGetScheduledFailed)
+}
+
+// 修改定时发布时间
+func ReschedulePost(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析请求参数
+	var req post.ReschedulePostRequest
+	if !common.BindAndValidateRequest(c, &req) {
+		return
+	}
+	req.PostId = postID
+	req.UserId = userID
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.ReschedulePost(ctx, &req)
+	}), "ReschedulePost", constants.MsgRescheduleFailed)
+}
+
+// 取消定时发布
+func CancelScheduledPost(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.CancelScheduledPostRequest{
+		PostId: postID,
+		UserId: userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.CancelScheduledPost(ctx, req)
+	}), "CancelScheduledPost", constants.MsgCancelSchedFailed)
+}
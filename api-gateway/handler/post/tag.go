@@ -0,0 +1,58 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+)
+
+// 获取热门标签
+func GetTrendingTags(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+
+	// 构建请求
+	req := &post.GetTrendingTagsRequest{
+		Window: common.ParseOptionalStringParam(c, constants.ParamWindow),
+		Limit:  common.ParseOptionalIntParam(c, constants.ParamLimit),
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetTrendingTags(ctx, req)
+	}), "GetTrendingTags", constants.MsgGetTrendTagsFailed)
+}
+
+// 获取标签详情
+func GetTag(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取标签名参数
+	name, valid := common.ValidateRequiredPathParam(c, "name", constants.MsgTagNameEmpty)
+	if !valid {
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetTagRequest{
+		Name:     name,
+		Page:     page,
+		PageSize: pageSize,
+		SortType: common.ParseOptionalStringParam(c, constants.ParamSortType),
+	}
+	// 登录用户过滤其拉黑和屏蔽的用户的帖子
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetTag(ctx, req)
+	}), "GetTag", constants.MsgGetTagFailed)
+}
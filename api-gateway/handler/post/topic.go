@@ -35,8 +35,13 @@ func GetTopic(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	// 调用帖子服务
+	// 登录用户额外返回订阅状态
 	req := &post.GetTopicRequest{TopicId: topicID}
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
+	}
+
+	// 调用帖子服务
 	common.CallService(c, common.ServiceCall(func() (any, error) {
 		return postClient.GetTopic(ctx, req)
 	}), "GetTopic", constants.MsgGetTopicFailed)
@@ -87,9 +92,13 @@ func GetTopicCategories(ctx context.Context, c *app.RequestContext) {
 	// 解析限制数量参数
 	limit := common.ParseOptionalIntParam(c, constants.ParamLimit)
 
+	// 指定分类时只返回该分类下的话题
+	categoryID := common.ParseOptionalStringParam(c, constants.ParamCategoryID)
+
 	// 构建请求
 	req := &post.GetTopicCategoriesRequest{
-		Limit: &limit,
+		Limit:      &limit,
+		CategoryId: categoryID,
 	}
 
 	// 调用帖子服务
@@ -111,11 +120,16 @@ func SearchTopics(ctx context.Context, c *app.RequestContext) {
 	// 解析分页参数
 	page, pageSize := common.ParsePaginationParams(c)
 
-	// 构建请求
+	// 构建请求，登录用户和客户端IP用于搜索词按人去重
+	clientIP := c.ClientIP()
 	req := &post.SearchTopicsRequest{
 		Keyword:  keyword,
 		Page:     page,
 		PageSize: pageSize,
+		ClientIp: &clientIP,
+	}
+	if userID, exists := common.GetUserIDFromContext(c); exists {
+		req.ViewerId = &userID
 	}
 
 	// 调用帖子服务
@@ -151,3 +165,112 @@ func ShareTopic(ctx context.Context, c *app.RequestContext) {
 		return postClient.ShareTopic(ctx, req)
 	}), "ShareTopic", constants.MsgShareTopicFailed)
 }
+
+// 订阅话题
+func SubscribeTopic(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取话题ID参数
+	topicID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgTopicIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.SubscribeTopicRequest{
+		TopicId: topicID,
+		UserId:  userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.SubscribeTopic(ctx, req)
+	}), "SubscribeTopic", constants.MsgSubscribeFailed)
+}
+
+// 取消订阅话题
+func UnsubscribeTopic(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 获取话题ID参数
+	topicID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgTopicIDEmpty)
+	if !valid {
+		return
+	}
+
+	// 构建请求
+	req := &post.UnsubscribeTopicRequest{
+		TopicId: topicID,
+		UserId:  userID,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.UnsubscribeTopic(ctx, req)
+	}), "UnsubscribeTopic", constants.MsgUnsubscribeFailed)
+}
+
+// 获取已订阅的话题
+func GetSubscribedTopics(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetSubscribedTopicsRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetSubscribedTopics(ctx, req)
+	}), "GetSubscribedTopics", constants.MsgGetSubTopicsFailed)
+}
+
+// 获取已订阅话题下的新帖
+func GetSubscribedTopicFeed(ctx context.Context, c *app.RequestContext) {
+	postClient := handler.GetPostClient()
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 构建请求
+	req := &post.GetSubscribedTopicFeedRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+		Cursor:   common.ParseOptionalStringParam(c, constants.ParamCursor),
+	}
+
+	// 调用帖子服务
+	common.CallService(c, common.ServiceCall(func() (any, error) {
+		return postClient.GetSubscribedTopicFeed(ctx, req)
+	}), "GetSubscribedTopicFeed", constants.MsgGetTopicFeedFailed)
+}
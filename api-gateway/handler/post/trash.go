@@ -0,0 +1,86 @@
+package post
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler"
+	"hupu/api-gateway/handler/common"
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+)
+
+// 获取最近删除的帖子
+func GetDeletedPosts(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	logger := log.GetLogger().WithField(constants.TraceIdKey, traceId)
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		logger.Errorf("GetDeletedPosts GetUserIDFromContext failed")
+		return
+	}
+
+	// 解析分页参数
+	page, pageSize := common.ParsePaginationParams(c)
+
+	// 调用帖子服务
+	resp, err := handler.GetPostClient().GetDeletedPosts(ctx, &post.GetDeletedPostsRequest{
+		UserId:   userID,
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		common.HandleRpcError(c, "GetDeletedPosts", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "GetDeletedPosts", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
+
+// 恢复已删除的帖子
+func RestorePost(ctx context.Context, c *app.RequestContext) {
+	traceId, _ := c.Get(constants.TraceIdKey)
+	logger := log.GetLogger().WithField(constants.TraceIdKey, traceId)
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		logger.Errorf("RestorePost GetUserIDFromContext failed")
+		return
+	}
+
+	// 获取帖子ID参数
+	postID, valid := common.ValidateRequiredPathParam(c, "id", constants.MsgPostIDEmpty)
+	if !valid {
+		logger.Errorf("RestorePost ValidateRequiredPathParam failed")
+		return
+	}
+
+	// 构建请求
+	req := &post.RestorePostRequest{
+		PostId: postID,
+		UserId: userID,
+	}
+	if role, ok := common.GetUserRoleFromContext(c); ok {
+		req.OperatorRole = &role
+	}
+
+	// 调用帖子服务
+	resp, err := handler.GetPostClient().RestorePost(ctx, req)
+	if err != nil {
+		common.HandleRpcError(c, "RestorePost", traceId.(string))
+		return
+	}
+	if resp.Code != constants.SuccessCode {
+		common.HandleServiceError(c, "RestorePost", traceId.(string), resp.Code, resp.Message)
+		return
+	}
+	common.RespondWithSuccess(c, resp)
+}
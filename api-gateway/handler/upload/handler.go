@@ -0,0 +1,22 @@
+package upload
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// CreateImageUploadHandler 申请图片上传凭证
+func CreateImageUploadHandler(ctx context.Context, c *app.RequestContext) {
+	CreateImageUpload(ctx, c)
+}
+
+// PutLocalObjectHandler 本地存储上传
+func PutLocalObjectHandler(ctx context.Context, c *app.RequestContext) {
+	PutLocalObject(ctx, c)
+}
+
+// GetLocalObjectHandler 本地存储读取
+func GetLocalObjectHandler(ctx context.Context, c *app.RequestContext) {
+	GetLocalObject(ctx, c)
+}
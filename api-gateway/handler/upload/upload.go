@@ -0,0 +1,161 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"hupu/api-gateway/handler/common"
+	"hupu/shared/constants"
+	"hupu/shared/storage"
+)
+
+// CreateImageUploadRequest 申请图片上传凭证请求结构
+type CreateImageUploadRequest struct {
+	ContentType string `json:"content_type" binding:"required"`
+	Size        int64  `json:"size"` // 文件大小(字节)，用于提前拒绝超限的图片
+}
+
+// ImageUploadResponse 图片上传凭证，客户端按 method 和 headers 将文件上传到 upload_url，发帖时提交 key
+type ImageUploadResponse struct {
+	Code      int32             `json:"code"`
+	Message   string            `json:"message"`
+	Key       string            `json:"key"`
+	UploadURL string            `json:"upload_url"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt int64             `json:"expires_at"`
+	URL       string            `json:"url"` // 上传完成后的访问地址
+}
+
+// CreateImageUpload 申请图片上传凭证
+func CreateImageUpload(ctx context.Context, c *app.RequestContext) {
+	traceID := c.GetString(constants.TraceIdKey)
+	// 获取用户ID
+	userID, exists := common.GetUserIDFromContext(c)
+	if !exists {
+		common.RespondUnauthorized(c)
+		return
+	}
+
+	// 解析请求体
+	var req CreateImageUploadRequest
+	if err := c.BindJSON(&req); err != nil || req.ContentType == "" {
+		common.RespondBadRequest(c, constants.MsgRequestFormatError)
+		return
+	}
+	if !storage.IsImageType(req.ContentType) {
+		common.ErrorResponseFunc(c, constants.HTTPStatusBadRequest, constants.FileTypeNotSupportedCode, constants.MsgImageTypeInvalid)
+		return
+	}
+	if req.Size < 0 || req.Size > storage.MaxImageSize() {
+		common.ErrorResponseFunc(c, constants.HTTPStatusBadRequest, constants.FileSizeTooLargeCode, constants.MsgImageTooLarge)
+		return
+	}
+
+	store, err := storage.Default()
+	if err != nil {
+		common.LogError("CreateImageUpload", traceID, err.Error())
+		common.ErrorResponseFunc(c, constants.HTTPStatusInternalServerError, constants.FileUploadFailCode, constants.MsgStorageUnavailable)
+		return
+	}
+	key, err := storage.NewImageKey(userID, req.ContentType)
+	if err != nil {
+		common.RespondBadRequest(c, constants.MsgParamError)
+		return
+	}
+	ticket, err := store.PresignPut(ctx, key, req.ContentType, storage.UploadExpire())
+	if err != nil {
+		common.LogError("CreateImageUpload", traceID, err.Error())
+		common.ErrorResponseFunc(c, constants.HTTPStatusInternalServerError, constants.FileUploadFailCode, constants.MsgCreateUploadFailed)
+		return
+	}
+
+	common.RespondWithSuccess(c, &ImageUploadResponse{
+		Code:      constants.SuccessCode,
+		Message:   "获取成功",
+		Key:       ticket.Key,
+		UploadURL: ticket.URL,
+		Method:    ticket.Method,
+		Headers:   ticket.Headers,
+		ExpiresAt: ticket.ExpiresAt.Unix(),
+		URL:       store.URL(ticket.Key),
+	})
+}
+
+// localStore 本地存储后端，其他后端时返回 false
+func localStore() (*storage.LocalStore, bool) {
+	store, err := storage.Default()
+	if err != nil {
+		return nil, false
+	}
+	local, ok := store.(*storage.LocalStore)
+	return local, ok
+}
+
+// PutLocalObject 本地存储后端的上传接口，按上传凭证中的签名校验后写入
+func PutLocalObject(ctx context.Context, c *app.RequestContext) {
+	traceID := c.GetString(constants.TraceIdKey)
+	store, ok := localStore()
+	if !ok {
+		common.ErrorResponseFunc(c, constants.HTTPStatusNotFound, constants.FileNotFoundCode, constants.MsgFileNotFound)
+		return
+	}
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	contentType := string(c.ContentType())
+	if err := store.VerifyUpload(key, contentType, c.Query("expires"), c.Query("signature")); err != nil {
+		common.ErrorResponseFunc(c, constants.HTTPStatusForbidden, constants.FileUploadTicketCode, constants.MsgUploadTicketBad)
+		return
+	}
+
+	body := c.Request.Body()
+	if int64(len(body)) > storage.MaxImageSize() {
+		common.ErrorResponseFunc(c, constants.HTTPStatusBadRequest, constants.FileSizeTooLargeCode, constants.MsgImageTooLarge)
+		return
+	}
+	// 按内容识别类型，拒绝与凭证中声明的类型不一致的文件
+	if http.DetectContentType(body) != contentType {
+		common.ErrorResponseFunc(c, constants.HTTPStatusBadRequest, constants.FileTypeNotSupportedCode, constants.MsgImageTypeInvalid)
+		return
+	}
+
+	if err := store.Put(ctx, key, bytes.NewReader(body), int64(len(body)), contentType); err != nil {
+		common.LogError("PutLocalObject", traceID, err.Error())
+		common.ErrorResponseFunc(c, constants.HTTPStatusInternalServerError, constants.FileUploadFailCode, constants.MsgUploadFailed)
+		return
+	}
+	c.Status(constants.HTTPStatusOK)
+}
+
+// GetLocalObject 读取本地存储后端中的文件
+func GetLocalObject(ctx context.Context, c *app.RequestContext) {
+	store, ok := localStore()
+	if !ok {
+		common.ErrorResponseFunc(c, constants.HTTPStatusNotFound, constants.FileNotFoundCode, constants.MsgFileNotFound)
+		return
+	}
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	info, err := store.Stat(ctx, key)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			common.LogError("GetLocalObject", c.GetString(constants.TraceIdKey), err.Error())
+		}
+		common.ErrorResponseFunc(c, constants.HTTPStatusNotFound, constants.FileNotFoundCode, constants.MsgFileNotFound)
+		return
+	}
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		common.ErrorResponseFunc(c, constants.HTTPStatusNotFound, constants.FileNotFoundCode, constants.MsgFileNotFound)
+		return
+	}
+
+	// 读取完成后由框架关闭文件
+	c.SetContentType(info.ContentType)
+	c.SetBodyStream(body, int(info.Size))
+}
@@ -56,3 +56,18 @@ func AuthMiddleware() app.HandlerFunc {
 		c.Next(ctx)
 	}
 }
+
+// OptionalAuthMiddleware 可选认证：携带有效token时写入用户信息，未携带或无效时按游客放行
+func OptionalAuthMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		auth := string(c.GetHeader("Authorization"))
+		if strings.HasPrefix(auth, "Bearer ") {
+			if claims, err := utils.ParseToken(auth[7:]); err == nil {
+				c.Set("user_id", claims.UserID)
+				c.Set("username", claims.Username)
+				c.Set("role", claims.Role)
+			}
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,35 @@
+package router
+
+import (
+	"hupu/api-gateway/handler/post"
+	"hupu/api-gateway/middleware"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+// RegisterAdminRoutes 注册管理相关路由，管理员权限由各服务按 JWT 中的角色校验
+func RegisterAdminRoutes(h *server.Hertz) {
+	// API版本组
+	adminGroup := h.Group("/api/v1/admin", middleware.AuthMiddleware())
+
+	// 内容审核相关
+	moderationGroup := adminGroup.Group("/moderation")
+	{
+		moderationGroup.GET("/reviews", post.GetModerationReviewsHandler)
+		moderationGroup.POST("/reviews/:id/resolve", post.ResolveModerationReviewHandler)
+	}
+
+	// 举报处理相关
+	reportGroup := adminGroup.Group("/reports")
+	{
+		reportGroup.GET("", post.GetReportCasesHandler)
+		reportGroup.POST("/:id/resolve", post.ResolveReportCaseHandler)
+	}
+
+	// 申诉复核相关
+	appealGroup := adminGroup.Group("/appeals")
+	{
+		appealGroup.GET("", post.GetAppealsHandler)
+		appealGroup.POST("/:id/resolve", post.ResolveAppealHandler)
+	}
+}
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"hupu/api-gateway/handler/post"
+	"hupu/api-gateway/middleware"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+// RegisterAppealRoutes 注册申诉相关路由
+func RegisterAppealRoutes(h *server.Hertz) {
+	// API版本组
+	apiV1 := h.Group("/api/v1/appeals")
+
+	// 需要认证的路由
+	authGroup := apiV1.Group("", middleware.AuthMiddleware())
+	{
+		authGroup.POST("", post.CreateAppealHandler) // 提交申诉
+		authGroup.GET("", post.GetMyAppealsHandler)  // 获取我的申诉
+	}
+}
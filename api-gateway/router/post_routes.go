@@ -17,14 +17,19 @@ func RegisterPostRoutes(h *server.Hertz) {
 	postGroup := apiV1.Group("/posts")
 	{
 		// 无需认证的路由
-		postGroup.GET("/", post.GetPostListHandler)
-		postGroup.GET("/:id", post.GetPostHandler)
-		postGroup.GET("/recommend", post.GetRecommendPostsHandler)
-		postGroup.GET("/hot", post.GetHotPostsHandler)
-		postGroup.GET("/high-score", post.GetHighScorePostsHandler)
-		postGroup.GET("/low-score", post.GetLowScorePostsHandler)
-		postGroup.GET("/controversial", post.GetControversialPostsHandler)
-		postGroup.GET("/search", post.SearchPostsHandler)
+		postGroup.GET("/", middleware.OptionalAuthMiddleware(), post.GetPostListHandler)
+		postGroup.GET("/:id", middleware.OptionalAuthMiddleware(), post.GetPostHandler)
+		postGroup.GET("/:id/revisions", middleware.OptionalAuthMiddleware(), post.GetPostRevisionsHandler)
+		postGroup.GET("/:id/revisions/:version", middleware.OptionalAuthMiddleware(), post.GetPostRevisionHandler)
+		postGroup.GET("/:id/related", middleware.OptionalAuthMiddleware(), post.GetRelatedPostsHandler)
+		postGroup.GET("/recommend", middleware.OptionalAuthMiddleware(), post.GetRecommendPostsHandler)
+		postGroup.GET("/hot", middleware.OptionalAuthMiddleware(), post.GetHotPostsHandler)
+		postGroup.GET("/high-score", middleware.OptionalAuthMiddleware(), post.GetHighScorePostsHandler)
+		postGroup.GET("/low-score", middleware.OptionalAuthMiddleware(), post.GetLowScorePostsHandler)
+		postGroup.GET("/controversial", middleware.OptionalAuthMiddleware(), post.GetControversialPostsHandler)
+		postGroup.GET("/search", middleware.OptionalAuthMiddleware(), post.SearchPostsHandler)
+		postGroup.GET("/search/suggest", post.GetSearchSuggestionsHandler)
+		postGroup.GET("/search/trending", post.GetTrendingSearchesHandler)
 
 		// 需要认证的路由
 		postAuthGroup := postGroup.Group("/", middleware.AuthMiddleware())
@@ -32,6 +37,27 @@ func RegisterPostRoutes(h *server.Hertz) {
 			postAuthGroup.POST("/", post.CreatePostHandler)
 			postAuthGroup.PUT("/:id", post.UpdatePostHandler)
 			postAuthGroup.DELETE("/:id", post.DeletePostHandler)
+			// 回收站相关
+			postAuthGroup.GET("/trash", post.GetDeletedPostsHandler)
+			postAuthGroup.POST("/:id/restore", post.RestorePostHandler)
+			// 草稿箱相关
+			postAuthGroup.GET("/drafts", post.GetDraftListHandler)
+			postAuthGroup.POST("/drafts", post.CreateDraftHandler)
+			postAuthGroup.GET("/drafts/:id", post.GetDraftHandler)
+			postAuthGroup.PUT("/drafts/:id", post.UpdateDraftHandler)
+			postAuthGroup.DELETE("/drafts/:id", post.DeleteDraftHandler)
+			postAuthGroup.POST("/drafts/:id/publish", post.PublishDraftHandler)
+			// 浏览历史相关
+			postAuthGroup.GET("/history", post.GetBrowsingHistoryHandler)
+			postAuthGroup.DELETE("/history", post.ClearBrowsingHistoryHandler)
+			postAuthGroup.DELETE("/history/:id", post.RemoveBrowsingHistoryHandler)
+			// 定时发布相关
+			postAuthGroup.GET("/scheduled", post.GetScheduledPostsHandler)
+			postAuthGroup.PUT("/:id/schedule", post.ReschedulePostHandler)
+			postAuthGroup.DELETE("/:id/schedule", post.CancelScheduledPostHandler)
+			// 投票相关
+			postAuthGroup.POST("/:id/poll/vote", post.VotePollHandler)
+			postAuthGroup.PUT("/:id/poll/vote", post.ChangePollVoteHandler)
 			// 点赞相关
 			postAuthGroup.POST("/:id/like", like.LikePostHandler)
 			postAuthGroup.DELETE("/:id/like", like.UnlikePostHandler)
@@ -50,13 +76,15 @@ func RegisterPostRoutes(h *server.Hertz) {
 		// 无需认证的点赞统计路由
 		postGroup.GET("/:id/like/count", like.GetPostLikeCountHandler)
 		postGroup.GET("/:id/like/status", like.CheckPostLikeStatusHandler)
+		// 无需认证的评分分布路由
+		postGroup.GET("/:id/rating/distribution", post.GetPostRatingDistributionHandler)
 
 		// 话题相关路由
 		topicGroup := postGroup.Group("/topics")
 		{
 			// 无需认证的路由
 			topicGroup.GET("/", post.GetTopicListHandler)
-			topicGroup.GET("/:id", post.GetTopicHandler)
+			topicGroup.GET("/:id", middleware.OptionalAuthMiddleware(), post.GetTopicHandler)
 			topicGroup.GET("/hot", post.GetHotTopicsHandler)
 			topicGroup.GET("/categories", post.GetTopicCategoriesHandler)
 			topicGroup.GET("/search", post.SearchTopicsHandler)
@@ -66,6 +94,35 @@ func RegisterPostRoutes(h *server.Hertz) {
 			{
 				topicAuthGroup.POST("/", post.CreateTopicHandler)
 				topicAuthGroup.POST("/:id/share", post.ShareTopicHandler)
+				topicAuthGroup.POST("/:id/subscribe", post.SubscribeTopicHandler)
+				topicAuthGroup.DELETE("/:id/subscribe", post.UnsubscribeTopicHandler)
+				topicAuthGroup.GET("/subscribed", post.GetSubscribedTopicsHandler)
+				topicAuthGroup.GET("/subscribed/feed", post.GetSubscribedTopicFeedHandler)
+			}
+		}
+
+		// 标签相关路由
+		tagGroup := postGroup.Group("/tags")
+		{
+			tagGroup.GET("/trending", post.GetTrendingTagsHandler)
+			tagGroup.GET("/:name", middleware.OptionalAuthMiddleware(), post.GetTagHandler)
+		}
+
+		// 收藏夹相关路由
+		folderGroup := postGroup.Group("/folders")
+		{
+			// 无需认证的路由，私密收藏夹只有所有者可以查看
+			folderGroup.GET("/user/:id", middleware.OptionalAuthMiddleware(), post.GetUserFavoriteFoldersHandler)
+			folderGroup.GET("/:id/posts", middleware.OptionalAuthMiddleware(), post.GetFavoriteFolderPostsHandler)
+
+			// 需要认证的路由
+			folderAuthGroup := folderGroup.Group("/", middleware.AuthMiddleware())
+			{
+				folderAuthGroup.GET("/", post.GetMyFavoriteFoldersHandler)
+				folderAuthGroup.POST("/", post.CreateFavoriteFolderHandler)
+				folderAuthGroup.PUT("/:id", post.UpdateFavoriteFolderHandler)
+				folderAuthGroup.DELETE("/:id", post.DeleteFavoriteFolderHandler)
+				folderAuthGroup.POST("/:id/move", post.MoveFavoritesHandler)
 			}
 		}
 	}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"hupu/api-gateway/handler/post"
+	"hupu/api-gateway/middleware"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+// RegisterReportRoutes 注册举报相关路由
+func RegisterReportRoutes(h *server.Hertz) {
+	// API版本组
+	apiV1 := h.Group("/api/v1/reports")
+
+	// 需要认证的路由
+	authGroup := apiV1.Group("", middleware.AuthMiddleware())
+	{
+		authGroup.POST("", post.CreateReportHandler) // 举报帖子、评论或用户
+	}
+}
@@ -26,4 +26,8 @@ func RegisterRoutes(h *server.Hertz) {
 	RegisterPostRoutes(h)
 	RegisterCommentRoutes(h)
 	RegisterSocialRoutes(h)
+	RegisterUploadRoutes(h)
+	RegisterReportRoutes(h)
+	RegisterAppealRoutes(h)
+	RegisterAdminRoutes(h)
 }
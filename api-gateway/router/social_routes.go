@@ -37,6 +37,14 @@ func RegisterSocialRoutes(h *server.Hertz) {
 		authGroup.GET("/follow/mutual", follow.GetMutualFollowsHandler)
 		authGroup.POST("/follow/check", follow.IsFollowingHandler)
 
+		// 拉黑和屏蔽相关
+		authGroup.POST("/blocks", follow.BlockHandler)
+		authGroup.DELETE("/blocks/:user_id", follow.UnblockHandler)
+		authGroup.GET("/blocks", follow.GetBlockListHandler)
+		authGroup.POST("/mutes", follow.MuteHandler)
+		authGroup.DELETE("/mutes/:user_id", follow.UnmuteHandler)
+		authGroup.GET("/mutes", follow.GetMuteListHandler)
+
 		// 通知相关
 		authGroup.GET("/notifications", handler.GetNotificationList)
 	}
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"hupu/api-gateway/handler/upload"
+	"hupu/api-gateway/middleware"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+// RegisterUploadRoutes 注册上传相关路由
+func RegisterUploadRoutes(h *server.Hertz) {
+	// API版本组
+	apiV1 := h.Group("/api/v1/uploads")
+
+	// 需要认证的路由
+	authGroup := apiV1.Group("", middleware.AuthMiddleware())
+	{
+		authGroup.POST("/images", upload.CreateImageUploadHandler) // 申请图片上传凭证
+	}
+
+	// 本地存储后端的上传和读取，上传由凭证中的签名鉴权
+	apiV1.PUT("/local/*key", upload.PutLocalObjectHandler)
+	apiV1.GET("/local/*key", upload.GetLocalObjectHandler)
+}
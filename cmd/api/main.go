@@ -6,6 +6,7 @@ import (
 	"hupu/api-gateway/router"
 	"hupu/shared/config"
 	"hupu/shared/log"
+	"hupu/shared/storage"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/app/server"
@@ -24,6 +25,8 @@ func main() {
 		server.WithHostPorts(config.GlobalConfig.Server.Host+":"+config.GlobalConfig.Server.Port),
 		server.WithReadTimeout(60*time.Second),
 		server.WithWriteTimeout(60*time.Second),
+		// 本地存储后端的图片经网关上传，请求体上限放宽到单张图片上限
+		server.WithMaxRequestBodySize(int(storage.MaxImageSize())+1<<20),
 	)
 	h.Use(
 		accesslog.New(accesslog.WithFormat("[${time}] ${status} - ${latency} ${method} ${path} ${queryParams}")),
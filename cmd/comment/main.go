@@ -10,6 +10,7 @@ import (
 	"hupu/shared/config"
 	"hupu/shared/log"
 	"hupu/shared/middleware"
+	"hupu/shared/moderation"
 	"hupu/shared/utils"
 
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
@@ -37,6 +38,9 @@ func main() {
 	// 创建服务处理器
 	commentHandler := handler.NewCommentHandler()
 
+	// 定时重新加载敏感词词库
+	go moderation.DefaultDictionary().StartReloader(context.Background())
+
 	// 创建服务器
 	addr, _ := net.ResolveTCPAddr("tcp", config.GlobalConfig.Services.Comment.Host+":"+config.GlobalConfig.Services.Comment.Port)
 	svr := commentservice.NewServer(commentHandler,
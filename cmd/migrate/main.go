@@ -52,7 +52,7 @@ func printIndexReport() {
 
 	fmt.Println("\n🔧 手动创建的高级索引:")
 	advancedIndexes := []string{
-		"idx_posts_title_content - 全文搜索索引",
+		"idx_posts_title_content - 全文搜索索引（ngram 分词）",
 		"idx_posts_tags_generated - JSON标签搜索索引",
 		"idx_posts_hot_score - 热门度计算索引",
 		"idx_posts_active - 活跃帖子条件索引",
@@ -14,6 +14,8 @@ import (
 	"hupu/shared/config"
 	"hupu/shared/log"
 	"hupu/shared/middleware"
+	"hupu/shared/moderation"
+	"hupu/shared/reconcile"
 	"hupu/shared/utils"
 )
 
@@ -41,6 +43,18 @@ func main() {
 	// 创建服务处理器
 	postHandler := handler.NewPostHandler()
 
+	// 启动后台任务
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+	go postHandler.StartTrashPurgeWorker(workerCtx)
+	go postHandler.StartDraftPurgeWorker(workerCtx)
+	go postHandler.StartViewFlushWorker(workerCtx)
+	go postHandler.StartScheduleWorker(workerCtx)
+	go postHandler.StartThumbnailWorker(workerCtx)
+	go postHandler.HotRanker().StartRebuildWorker(workerCtx)
+	go reconcile.NewReconciler(utils.GetDB(), utils.GetRedisClient()).StartWorker(workerCtx)
+	go moderation.DefaultDictionary().StartReloader(workerCtx)
+
 	// 创建服务器
 	addr, _ := net.ResolveTCPAddr("tcp", config.GlobalConfig.Services.Post.Host+":"+config.GlobalConfig.Services.Post.Port)
 	svr := post.NewServer(postHandler,
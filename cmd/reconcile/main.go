@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"hupu/shared/config"
+	"hupu/shared/log"
+	"hupu/shared/reconcile"
+	"hupu/shared/utils"
+)
+
+func main() {
+	configPath := flag.String("config", "../../config.yaml", "配置文件路径")
+	fix := flag.Bool("fix", false, "修复发现的偏差，默认只报告")
+	only := flag.String("checks", "", "只执行指定的校对项，逗号分隔，默认执行全部")
+	batchSize := flag.Int("batch", 0, "每批校对的行数，默认读取配置 reconcile.batch_size")
+	list := flag.Bool("list", false, "列出全部校对项")
+	flag.Parse()
+
+	if *list {
+		for _, name := range reconcile.CheckNames() {
+			fmt.Println(name)
+		}
+		return
+	}
+
+	// 初始化配置
+	config.Init(*configPath)
+
+	// 初始化日志
+	log.InitLogger("reconcile.log", config.GlobalConfig.Log.Path, config.GlobalConfig.Log.Level)
+
+	// 初始化数据库
+	if err := utils.InitDB(); err != nil {
+		log.GetLogger().Fatalf("Failed to init database: %v", err)
+	}
+
+	opts := reconcile.Options{BatchSize: *batchSize, Fix: *fix}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = reconcile.BatchSize()
+	}
+	if *only != "" {
+		opts.Checks = strings.Split(*only, ",")
+	}
+
+	report, err := reconcile.NewReconciler(utils.GetDB(), nil).Run(context.Background(), opts)
+	if report != nil {
+		printReport(report, *fix)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ 校对失败: %v\n", err)
+		os.Exit(1)
+	}
+	// 只报告时存在偏差以非零状态退出，便于在定时任务中告警
+	if !*fix && report.Drifted() > 0 {
+		os.Exit(2)
+	}
+}
+
+func printReport(report *reconcile.Report, fix bool) {
+	fmt.Println("📋 计数校对报告:")
+	fmt.Println("================")
+
+	if report.UserStatsMissing > 0 {
+		if fix {
+			fmt.Printf("  • 补建 user_stats 记录 %d 条\n", report.UserStatsMissing)
+		} else {
+			fmt.Printf("  • 缺少 user_stats 记录的用户 %d 个\n", report.UserStatsMissing)
+		}
+	}
+	for _, result := range report.Results {
+		if result.Drifted == 0 {
+			fmt.Printf("✅ %s: 校对 %d 行，无偏差\n", result.Name, result.Checked)
+			continue
+		}
+		if fix {
+			fmt.Printf("🔧 %s: 校对 %d 行，偏差 %d 行，修复 %d 行\n", result.Name, result.Checked, result.Drifted, result.Fixed)
+		} else {
+			fmt.Printf("⚠️  %s: 校对 %d 行，偏差 %d 行\n", result.Name, result.Checked, result.Drifted)
+		}
+		for _, d := range result.Samples {
+			fmt.Printf("  • %s: 记录值 %d，实际值 %d\n", d.Key, d.Stored, d.Actual)
+		}
+	}
+}
@@ -28,6 +28,7 @@ struct Comment {
     19: double score                      // 评论评分
     20: i32 rating_count                  // 评分人数
     21: bool is_rated                     // 当前用户是否已评分
+    22: i32 status                        // 审核状态：0正常 1审核中 2未通过
 }
 
 struct CreateCommentRequest {
@@ -54,6 +55,8 @@ struct GetCommentListRequest {
     4: optional string sort_type          // latest, hot, oldest, score_high, score_low
     5: optional string parent_id          // 获取特定评论的回复
     6: bool include_replies               // 是否包含回复
+    7: optional string cursor             // 游标，传入时忽略page
+    8: optional string viewer_id          // 当前用户，不返回其拉黑和屏蔽的用户的评论
 }
 
 struct GetCommentListResponse {
@@ -66,6 +69,7 @@ struct CommentListData {
     1: list<Comment> list
     2: i32 total
     3: bool hasMore
+    4: string nextCursor
 }
 
 // 删除评论
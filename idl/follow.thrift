@@ -56,6 +56,7 @@ struct GetFollowListRequest {
     2: i32 type // 1: following, 2: followers
     3: i32 page
     4: i32 page_size
+    5: optional string cursor // 游标，传入时忽略page
 }
 
 struct GetFollowListResponse {
@@ -64,6 +65,7 @@ struct GetFollowListResponse {
     3: list<Follow> follows
     4: i32 total
     5: bool has_more
+    6: string next_cursor
 }
 
 // 添加 GetFollowerListRequest 和 GetFollowerListResponse
@@ -71,6 +73,7 @@ struct GetFollowerListRequest {
     1: string user_id
     2: i32 page
     3: i32 page_size
+    4: optional string cursor
 }
 
 struct GetFollowerListResponse {
@@ -79,6 +82,7 @@ struct GetFollowerListResponse {
     3: list<Follow> followers
     4: i32 total
     5: bool has_more
+    6: string next_cursor
 }
 
 // 获取关注数量请求
@@ -120,6 +124,40 @@ struct GetMutualFollowsResponse {
 }
 
 
+// 拉黑或屏蔽的用户
+struct RelationUser {
+    1: string user_id          // 被拉黑或屏蔽的用户ID
+    2: i64 created_at
+}
+
+// 拉黑、取消拉黑、屏蔽、取消屏蔽请求
+struct RelationRequest {
+    1: string user_id
+    2: string target_user_id
+}
+
+struct RelationResponse {
+    1: i32 code
+    2: string message
+}
+
+// 获取黑名单、屏蔽列表请求
+struct GetRelationListRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+    4: optional string cursor  // 游标，传入时忽略page
+}
+
+struct GetRelationListResponse {
+    1: i32 code
+    2: string message
+    3: list<RelationUser> users
+    4: i32 total
+    5: bool has_more
+    6: string next_cursor
+}
+
 
 service FollowService {
     FollowResponse Follow(1: FollowRequest req)
@@ -131,4 +169,14 @@ service FollowService {
     GetFollowCountResponse GetFollowCount(1: GetFollowCountRequest req)
     GetFollowerCountResponse GetFollowerCount(1: GetFollowerCountRequest req)
     GetMutualFollowsResponse GetMutualFollows(1: GetMutualFollowsRequest req)
+    
+    // 拉黑：被拉黑的用户不能评论、评分、点赞自己的内容，也不能关注自己
+    RelationResponse Block(1: RelationRequest req)
+    RelationResponse Unblock(1: RelationRequest req)
+    GetRelationListResponse GetBlockList(1: GetRelationListRequest req)
+    
+    // 屏蔽：只隐藏对方的内容
+    RelationResponse Mute(1: RelationRequest req)
+    RelationResponse Unmute(1: RelationRequest req)
+    GetRelationListResponse GetMuteList(1: GetRelationListRequest req)
 }
\ No newline at end of file
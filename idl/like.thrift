@@ -66,6 +66,7 @@ struct GetLikeUsersRequest {
     2: string target_type
     3: i32 page
     4: i32 page_size
+    5: optional string viewer_id // 过滤查看者拉黑和屏蔽的用户
 }
 
 // 添加 LikeUser 结构体
@@ -47,6 +47,7 @@ struct GetNotificationListRequest {
     1: string user_id
     2: i32 page
     3: i32 page_size
+    4: optional string cursor // 游标，传入时忽略page
 }
 
 struct GetNotificationListResponse {
@@ -54,6 +55,8 @@ struct GetNotificationListResponse {
     2: string message
     3: list<Notification> notifications
     4: i32 total
+    5: bool has_more
+    6: string next_cursor
 }
 
 // 标记通知已读
@@ -11,6 +11,17 @@ enum PostCategory {
     OTHER = 99           // 其他
 }
 
+// 帖子发布状态
+enum PostStatus {
+    PUBLISHED = 0,        // 已发布
+    SCHEDULED = 1,        // 定时发布，到点前仅作者可见
+    DRAFT = 2,            // 取消定时发布后保留的帖子，仅作者可见
+    REVIEWING = 3,        // 内容审核中，仅作者可见
+    REJECTED = 4,         // 内容审核未通过，仅作者可见
+    HIDDEN = 5,           // 被举报人数过多自动隐藏，待处理，仅作者可见
+    REMOVED = 6           // 被管理员移除，仅作者可见
+}
+
 // 话题结构
 struct Topic {
     1: string id
@@ -21,6 +32,57 @@ struct Topic {
     6: i32 participant_count
     7: i64 created_at
     8: i64 updated_at
+    9: string category_id
+    10: i32 post_count
+    11: i32 share_count
+    12: optional i32 today_participant_count // 今日参与人数，仅热门话题返回
+    13: i32 subscriber_count
+    14: optional bool is_subscribed            // 当前用户是否已订阅，仅登录时返回
+}
+
+// 话题分类
+struct TopicCategory {
+    1: string id
+    2: string name
+    3: string icon
+    4: list<Topic> topics              // 分类下参与人数最多的话题
+}
+
+// 投票选项
+struct PollOption {
+    1: string id
+    2: string content
+    3: optional i32 vote_count         // 结果不可见时不返回
+}
+
+// 帖子附带的投票
+struct Poll {
+    1: string id
+    2: bool multiple                   // 是否多选
+    3: optional i64 deadline           // 截止时间，为空时不截止
+    4: bool hide_results               // 投票前是否隐藏结果
+    5: list<PollOption> options
+    6: optional i32 voter_count        // 参与人数，结果不可见时不返回
+    7: bool is_closed                  // 是否已截止
+    8: bool results_visible            // 当前用户是否可以查看结果
+    9: list<string> voted_option_ids   // 当前用户选择的选项，未投票或未登录时为空
+}
+
+// 标签
+struct Tag {
+    1: string id
+    2: string name                     // 规范化后的标签名
+    3: i32 post_count                  // 使用该标签的已发布帖子数
+    4: i64 created_at
+    5: optional i64 trending_count     // 统计窗口内的使用次数，仅热门标签返回
+}
+
+// 发帖时创建的投票
+struct CreatePollRequest {
+    1: list<string> options            // 选项内容，2-10个
+    2: bool multiple
+    3: optional i64 deadline
+    4: bool hide_results
 }
 
 struct Post {
@@ -45,6 +107,34 @@ struct Post {
     19: bool is_top                    // 是否置顶
     20: optional string location       // 发布位置
     21: list<string> tags              // 标签列表
+    22: bool is_edited                 // 是否编辑过
+    23: optional i64 edited_at         // 最后编辑时间
+    24: optional i64 deleted_at        // 删除时间，仅回收站列表返回
+    25: i32 rating_count               // 评分人数，score 为平均分
+    26: optional double adjusted_score // 贝叶斯修正后的评分，仅评分榜单返回
+    27: optional string title_highlight   // 标题高亮，命中词用<em>包裹，仅搜索返回
+    28: optional string content_highlight // 内容摘要高亮，仅搜索返回
+    29: optional string recommend_reason  // 推荐理由，仅推荐列表返回
+    30: PostStatus status              // 发布状态
+    31: optional i64 publish_at        // 定时发布时间，仅未发布的帖子返回
+    32: optional Poll poll             // 帖子附带的投票
+    33: list<string> thumbnails        // 九宫格缩略图地址，与 images 一一对应，未生成时为原图地址
+}
+
+// 帖子历史版本
+struct PostRevision {
+    1: string id
+    2: string post_id
+    3: i32 version                     // 版本号，从1开始
+    4: string editor_id                // 产生该版本的编辑者ID，仅返回给作者和管理员
+    5: string title
+    6: string content
+    7: list<string> images
+    8: optional string topic_id
+    9: PostCategory category
+    10: optional string location
+    11: list<string> tags
+    12: i64 created_at
 }
 
 struct CreatePostRequest {
@@ -57,6 +147,9 @@ struct CreatePostRequest {
     7: bool is_anonymous              // 是否匿名发布
     8: optional string location       // 发布位置
     9: list<string> tags              // 标签列表
+    10: optional string anonymous_profile_id // 匿名发布时使用的马甲ID
+    11: optional i64 publish_at       // 定时发布时间，为空时立即发布
+    12: optional CreatePollRequest poll // 附带投票
 }
 
 struct CreatePostResponse {
@@ -67,6 +160,9 @@ struct CreatePostResponse {
 
 struct GetPostRequest {
     1: string post_id
+    2: optional string viewer_id       // 登录用户，用于记录看过的帖子
+    3: optional string device_id       // 未登录设备标识，用于浏览量去重
+    4: optional string client_ip       // 客户端IP，既未登录也没有设备标识时用于浏览量去重
 }
 
 struct GetPostResponse {
@@ -83,6 +179,8 @@ struct GetPostListRequest {
     5: optional PostCategory category // 按分类筛选
     6: optional string sort_type      // 排序类型: latest, hot, score
     7: optional bool is_anonymous     // 是否只看匿名帖子
+    8: optional string cursor         // 游标，传入时忽略page，仅支持latest排序
+    9: optional string viewer_id      // 登录用户，过滤其拉黑和屏蔽的用户的帖子
 }
 
 struct GetPostListResponse {
@@ -90,6 +188,8 @@ struct GetPostListResponse {
     2: string message
     3: list<Post> posts
     4: i32 total
+    5: bool has_more
+    6: string next_cursor             // 下一页游标，无更多数据时为空
 }
 
 // 话题相关请求响应
@@ -98,6 +198,7 @@ struct CreateTopicRequest {
     2: string description
     3: string icon
     4: string color
+    5: optional string category_id
 }
 
 struct CreateTopicResponse {
@@ -119,10 +220,23 @@ struct GetTopicListResponse {
     4: i32 total
 }
 
+// 收藏夹
+struct FavoriteFolder {
+    1: string id
+    2: string user_id
+    3: string name
+    4: bool is_public                  // 是否公开，公开的收藏夹其他用户可查看
+    5: bool is_default                 // 默认收藏夹，未指定收藏夹时收藏到这里，不能删除
+    6: i32 post_count
+    7: i64 created_at
+    8: i64 updated_at
+}
+
 // 收藏相关请求响应
 struct CollectPostRequest {
     1: string user_id
     2: string post_id
+    3: optional string folder_id       // 收藏夹ID，为空时收藏到默认收藏夹
 }
 
 struct CollectPostResponse {
@@ -144,6 +258,7 @@ struct GetCollectedPostsRequest {
     1: string user_id
     2: i32 page
     3: i32 page_size
+    4: optional string cursor
 }
 
 struct GetCollectedPostsResponse {
@@ -151,6 +266,88 @@ struct GetCollectedPostsResponse {
     2: string message
     3: list<Post> posts
     4: i32 total
+    5: bool has_more
+    6: string next_cursor
+}
+
+// 获取收藏夹列表请求响应
+struct GetFavoriteFoldersRequest {
+    1: string user_id                  // 收藏夹所有者
+    2: optional string viewer_id       // 当前用户，不是所有者时只返回公开的收藏夹
+}
+
+struct GetFavoriteFoldersResponse {
+    1: i32 code
+    2: string message
+    3: list<FavoriteFolder> folders    // 默认收藏夹在最前，其余按创建时间正序
+}
+
+// 创建收藏夹请求响应
+struct CreateFavoriteFolderRequest {
+    1: string user_id
+    2: string name
+    3: bool is_public
+}
+
+struct CreateFavoriteFolderResponse {
+    1: i32 code
+    2: string message
+    3: FavoriteFolder folder
+}
+
+// 修改收藏夹请求响应
+struct UpdateFavoriteFolderRequest {
+    1: string folder_id
+    2: string user_id
+    3: optional string name
+    4: optional bool is_public
+}
+
+struct UpdateFavoriteFolderResponse {
+    1: i32 code
+    2: string message
+    3: FavoriteFolder folder
+}
+
+// 删除收藏夹请求响应，收藏夹中的帖子移到默认收藏夹
+struct DeleteFavoriteFolderRequest {
+    1: string folder_id
+    2: string user_id
+}
+
+struct DeleteFavoriteFolderResponse {
+    1: i32 code
+    2: string message
+}
+
+// 获取收藏夹中的帖子请求响应
+struct GetFavoriteFolderPostsRequest {
+    1: string folder_id
+    2: optional string viewer_id       // 当前用户，不是所有者时只能查看公开的收藏夹
+    3: i32 page
+    4: i32 page_size
+}
+
+struct GetFavoriteFolderPostsResponse {
+    1: i32 code
+    2: string message
+    3: FavoriteFolder folder
+    4: list<Post> posts                // 按收藏时间倒序
+    5: i32 total
+    6: bool has_more
+}
+
+// 移动收藏请求响应
+struct MoveFavoritesRequest {
+    1: string user_id
+    2: list<string> post_ids           // 已收藏的帖子ID
+    3: string folder_id                // 目标收藏夹ID
+}
+
+struct MoveFavoritesResponse {
+    1: i32 code
+    2: string message
+    3: i32 moved_count                 // 实际移动的收藏数，未收藏的帖子被忽略
 }
 
 // 评分相关请求响应
@@ -181,6 +378,7 @@ struct GetRatingRankResponse {
     2: string message
     3: list<Post> posts
     4: i32 total
+    5: bool has_more
 }
 
 // 更新帖子请求响应
@@ -189,24 +387,58 @@ struct UpdatePostRequest {
     2: string user_id
     3: string title
     4: string content
-    5: list<string> images
-    6: optional string topic_id
+    5: optional list<string> images     // 不传时保留原有图片
+    6: optional string topic_id         // 不传时保留原有话题，传空字符串移出话题
     7: PostCategory category
     8: bool is_anonymous
     9: optional string location
-    10: list<string> tags
+    10: optional list<string> tags      // 不传时保留原有标签
+    11: optional string operator_role  // 操作者角色，由网关从JWT中填充
 }
 
 struct UpdatePostResponse {
     1: i32 code
     2: string message
     3: Post post
+    4: bool edited                    // 本次是否产生了新版本
+}
+
+// 获取帖子历史版本列表请求响应
+struct GetPostRevisionsRequest {
+    1: string post_id
+    2: i32 page
+    3: i32 page_size
+    4: optional string viewer_id       // 登录用户，未发布或已删除的帖子仅作者和管理员可查看历史版本
+    5: optional string viewer_role     // 登录用户角色，由网关从JWT中填充
+}
+
+struct GetPostRevisionsResponse {
+    1: i32 code
+    2: string message
+    3: list<PostRevision> revisions
+    4: i32 total
+    5: bool has_more
+}
+
+// 获取帖子指定版本请求响应
+struct GetPostRevisionRequest {
+    1: string post_id
+    2: i32 version
+    3: optional string viewer_id       // 同 GetPostRevisionsRequest
+    4: optional string viewer_role
+}
+
+struct GetPostRevisionResponse {
+    1: i32 code
+    2: string message
+    3: PostRevision revision
 }
 
 // 删除帖子请求响应
 struct DeletePostRequest {
     1: string post_id
     2: string user_id
+    3: optional string operator_role  // 操作者角色，由网关从JWT中填充
 }
 
 struct DeletePostResponse {
@@ -214,11 +446,228 @@ struct DeletePostResponse {
     2: string message
 }
 
+// 获取最近删除的帖子请求响应
+struct GetDeletedPostsRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetDeletedPostsResponse {
+    1: i32 code
+    2: string message
+    3: list<Post> posts
+    4: i32 total
+    5: bool has_more
+    6: i32 retention_days             // 回收站保留天数
+}
+
+// 恢复帖子请求响应
+struct RestorePostRequest {
+    1: string post_id
+    2: string user_id
+    3: optional string operator_role
+}
+
+struct RestorePostResponse {
+    1: i32 code
+    2: string message
+    3: Post post
+}
+
+// 内容审核记录
+struct ModerationRecord {
+    1: string id
+    2: string target_type             // post / comment
+    3: string target_id
+    4: string user_id
+    5: string action                  // pass / mask / review / reject
+    6: string reason                  // sensitive / spam
+    7: list<string> hits              // 命中的敏感词
+    8: string content
+    9: string review_status           // pending / approved / rejected
+    10: optional string reviewer_id
+    11: optional i64 reviewed_at
+    12: i64 created_at
+}
+
+// 获取内容复审队列请求响应，仅管理员
+struct GetModerationReviewsRequest {
+    1: optional string review_status  // 为空时返回全部送审记录
+    2: i32 page
+    3: i32 page_size
+    4: optional string operator_role
+}
+
+struct GetModerationReviewsResponse {
+    1: i32 code
+    2: string message
+    3: list<ModerationRecord> records
+    4: i32 total
+    5: bool has_more
+}
+
+// 处理送审内容请求响应，仅管理员
+struct ResolveModerationReviewRequest {
+    1: string record_id
+    2: bool approve
+    3: string operator_id
+    4: optional string operator_role
+}
+
+struct ResolveModerationReviewResponse {
+    1: i32 code
+    2: string message
+    3: ModerationRecord record
+}
+
+// 举报单，同一对象的举报合并为一个举报单
+struct ReportCase {
+    1: string id
+    2: string target_type             // post / comment / user
+    3: string target_id
+    4: string target_user_id          // 被举报内容的作者或被举报的用户
+    5: i32 reporter_count             // 举报人数
+    6: map<string, i32> reason_counts // 各举报原因的人数
+    7: bool hidden                    // 是否已被自动隐藏
+    8: string status                  // pending / approved / removed / banned
+    9: optional string handler_id
+    10: optional i64 handled_at
+    11: string note                   // 处理说明
+    12: i64 created_at
+    13: i64 updated_at
+}
+
+// 举报请求响应
+struct CreateReportRequest {
+    1: string target_type             // post / comment / user
+    2: string target_id
+    3: string reason                  // spam / abuse / porn / illegal / other
+    4: optional string detail         // 补充说明，最多500字
+    5: string user_id
+}
+
+struct CreateReportResponse {
+    1: i32 code
+    2: string message
+}
+
+// 获取举报队列请求响应，仅管理员
+struct GetReportCasesRequest {
+    1: optional string status         // 默认 pending
+    2: i32 page
+    3: i32 page_size
+    4: optional string operator_role
+}
+
+struct GetReportCasesResponse {
+    1: i32 code
+    2: string message
+    3: list<ReportCase> cases
+    4: i32 total
+    5: bool has_more
+}
+
+// 处理举报单请求响应，仅管理员
+struct ResolveReportCaseRequest {
+    1: string case_id
+    2: string action                  // approve(举报不成立) / remove(移除内容) / ban(移除内容并封禁作者)
+    3: optional string note
+    4: string operator_id
+    5: optional string operator_role
+}
+
+struct ResolveReportCaseResponse {
+    1: i32 code
+    2: string message
+    3: ReportCase report_case
+}
+
+// 申诉
+struct Appeal {
+    1: string id
+    2: string user_id
+    3: string target_type             // post(被移除的帖子) / user(被封禁或暂停使用的账号)
+    4: string target_id
+    5: string statement               // 申诉理由
+    6: string status                  // open / upheld / overturned
+    7: optional string decider_id     // 作出原处理的管理员，仅管理员接口返回
+    8: optional string reviewer_id    // 复核的管理员，仅管理员接口返回
+    9: string review_note             // 复核说明
+    10: optional i64 reviewed_at
+    11: i64 created_at
+    12: i64 updated_at
+}
+
+// 提交申诉请求响应
+struct CreateAppealRequest {
+    1: string target_type             // post / user
+    2: optional string target_id      // 申诉帖子时必填，申诉账号时为当前用户
+    3: string statement               // 申诉理由，最多1000字
+    4: string user_id
+}
+
+struct CreateAppealResponse {
+    1: i32 code
+    2: string message
+    3: Appeal appeal
+}
+
+// 获取我的申诉请求响应
+struct GetMyAppealsRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetMyAppealsResponse {
+    1: i32 code
+    2: string message
+    3: list<Appeal> appeals
+    4: i32 total
+    5: bool has_more
+}
+
+// 获取申诉队列请求响应，仅管理员
+struct GetAppealsRequest {
+    1: optional string status         // 默认 open
+    2: i32 page
+    3: i32 page_size
+    4: optional string operator_role
+}
+
+struct GetAppealsResponse {
+    1: i32 code
+    2: string message
+    3: list<Appeal> appeals
+    4: i32 total
+    5: bool has_more
+}
+
+// 复核申诉请求响应，仅管理员，不能复核自己作出的处理
+struct ResolveAppealRequest {
+    1: string appeal_id
+    2: bool overturn                  // true 撤销原处理并恢复，false 维持原处理
+    3: optional string note
+    4: string operator_id
+    5: optional string operator_role
+}
+
+struct ResolveAppealResponse {
+    1: i32 code
+    2: string message
+    3: Appeal appeal
+}
+
 // 搜索帖子请求响应
 struct SearchPostsRequest {
     1: string keyword
     2: i32 page
     3: i32 page_size
+    4: optional string category
+    5: optional string sort_type       // relevance(默认，按相关度) / latest(按时间)
+    6: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
+    7: optional string client_ip       // 客户端IP，未登录时用于搜索词按人去重
 }
 
 struct SearchPostsResponse {
@@ -226,6 +675,53 @@ struct SearchPostsResponse {
     2: string message
     3: list<Post> posts
     4: i32 total
+    5: bool has_more
+}
+
+// 搜索建议
+struct SearchSuggestion {
+    1: string text
+    2: string type                     // topic / tag / query / post
+}
+
+struct GetSearchSuggestionsRequest {
+    1: string query
+    2: i32 limit
+}
+
+struct GetSearchSuggestionsResponse {
+    1: i32 code
+    2: string message
+    3: list<SearchSuggestion> suggestions
+}
+
+// 热门搜索
+struct TrendingSearch {
+    1: string query
+    2: double score                    // 最近24小时按时间衰减的搜索次数
+}
+
+struct GetTrendingSearchesRequest {
+    1: i32 limit
+}
+
+struct GetTrendingSearchesResponse {
+    1: i32 code
+    2: string message
+    3: list<TrendingSearch> queries
+}
+
+// 相关帖子
+struct GetRelatedPostsRequest {
+    1: string post_id
+    2: i32 limit                       // 默认6，最多20
+    3: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
+}
+
+struct GetRelatedPostsResponse {
+    1: i32 code
+    2: string message
+    3: list<Post> posts
 }
 
 // 获取推荐帖子请求响应
@@ -234,6 +730,7 @@ struct GetRecommendPostsRequest {
     2: i32 page_size
     3: optional string category
     4: optional string tag
+    5: optional string viewer_id       // 登录用户，为空时返回非个性化推荐
 }
 
 struct GetRecommendPostsResponse {
@@ -250,6 +747,8 @@ struct GetHotPostsRequest {
     2: i32 page_size
     3: optional string category
     4: optional string tag
+    5: optional string cursor         // 游标，按热度分值和ID翻页
+    6: optional string viewer_id      // 登录用户，过滤其拉黑和屏蔽的用户的帖子
 }
 
 struct GetHotPostsResponse {
@@ -258,6 +757,7 @@ struct GetHotPostsResponse {
     3: list<Post> posts
     4: i32 total
     5: bool has_more
+    6: string next_cursor
 }
 
 // 获取高分帖子请求响应
@@ -266,6 +766,7 @@ struct GetHighScorePostsRequest {
     2: i32 page_size
     3: optional string category
     4: optional string tag
+    5: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
 }
 
 struct GetHighScorePostsResponse {
@@ -282,6 +783,7 @@ struct GetLowScorePostsRequest {
     2: i32 page_size
     3: optional string category
     4: optional string tag
+    5: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
 }
 
 struct GetLowScorePostsResponse {
@@ -298,6 +800,7 @@ struct GetControversialPostsRequest {
     2: i32 page_size
     3: optional string category
     4: optional string tag
+    5: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
 }
 
 struct GetControversialPostsResponse {
@@ -311,6 +814,7 @@ struct GetControversialPostsResponse {
 // 获取话题详情请求响应
 struct GetTopicRequest {
     1: string topic_id
+    2: optional string viewer_id       // 当前登录用户，用于返回订阅状态
 }
 
 struct GetTopicResponse {
@@ -332,15 +836,17 @@ struct GetHotTopicsResponse {
 
 // 获取话题分类请求响应
 struct GetTopicCategoriesRequest {
-    1: optional i32 limit
+    1: optional i32 limit              // 每个分类返回的话题数
+    2: optional string category_id     // 指定分类时只返回该分类下的话题
 }
 
 struct GetTopicCategoriesResponse {
     1: i32 code
     2: string message
-    3: list<Topic> topics
+    3: list<Topic> topics              // 指定分类时该分类下的话题
     4: i32 total
     5: bool has_more
+    6: list<TopicCategory> categories  // 未指定分类时返回全部分类
 }
 
 // 搜索话题请求响应
@@ -348,6 +854,8 @@ struct SearchTopicsRequest {
     1: string keyword
     2: i32 page
     3: i32 page_size
+    4: optional string viewer_id       // 登录用户，用于搜索词按人去重
+    5: optional string client_ip       // 客户端IP，未登录时用于搜索词按人去重
 }
 
 struct SearchTopicsResponse {
@@ -364,9 +872,323 @@ struct ShareTopicRequest {
     2: string user_id
 }
 
+// 分享卡片内容
+struct TopicSharePayload {
+    1: string title
+    2: string description
+    3: string icon
+    4: string url
+}
+
 struct ShareTopicResponse {
     1: i32 code
     2: string message
+    3: optional TopicSharePayload payload
+    4: i32 share_count
+}
+
+// 帖子草稿，字段与 CreatePostRequest 一致
+struct PostDraft {
+    1: string id
+    2: string user_id
+    3: string title
+    4: string content
+    5: list<string> images
+    6: optional string topic_id
+    7: PostCategory category
+    8: bool is_anonymous
+    9: optional string location
+    10: list<string> tags
+    11: optional string anonymous_profile_id
+    12: i32 version                    // 每次保存加一，用于乐观锁
+    13: i64 created_at
+    14: i64 updated_at
+}
+
+// 创建草稿请求响应
+struct CreateDraftRequest {
+    1: string user_id
+    2: string title
+    3: string content
+    4: list<string> images
+    5: optional string topic_id
+    6: PostCategory category
+    7: bool is_anonymous
+    8: optional string location
+    9: list<string> tags
+    10: optional string anonymous_profile_id
+}
+
+struct CreateDraftResponse {
+    1: i32 code
+    2: string message
+    3: PostDraft draft
+}
+
+// 更新草稿请求响应，version 与服务端不一致时拒绝保存
+struct UpdateDraftRequest {
+    1: string draft_id
+    2: string user_id
+    3: i32 version                     // 客户端持有的草稿版本
+    4: string title
+    5: string content
+    6: list<string> images
+    7: optional string topic_id
+    8: PostCategory category
+    9: bool is_anonymous
+    10: optional string location
+    11: list<string> tags
+    12: optional string anonymous_profile_id
+}
+
+struct UpdateDraftResponse {
+    1: i32 code
+    2: string message
+    3: PostDraft draft                 // 版本冲突时为服务端当前的草稿
+}
+
+// 获取草稿详情请求响应
+struct GetDraftRequest {
+    1: string draft_id
+    2: string user_id
+}
+
+struct GetDraftResponse {
+    1: i32 code
+    2: string message
+    3: PostDraft draft
+}
+
+// 获取草稿列表请求响应
+struct GetDraftListRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetDraftListResponse {
+    1: i32 code
+    2: string message
+    3: list<PostDraft> drafts
+    4: i32 total
+    5: bool has_more
+}
+
+// 删除草稿请求响应
+struct DeleteDraftRequest {
+    1: string draft_id
+    2: string user_id
+}
+
+struct DeleteDraftResponse {
+    1: i32 code
+    2: string message
+}
+
+// 发布草稿请求响应，发布成功后草稿被删除
+struct PublishDraftRequest {
+    1: string draft_id
+    2: string user_id
+    3: optional i32 version            // 传入时校验草稿版本
+}
+
+struct PublishDraftResponse {
+    1: i32 code
+    2: string message
+    3: Post post
+}
+
+// 浏览历史
+struct BrowsingHistoryItem {
+    1: Post post
+    2: i64 viewed_at                   // 最近一次浏览时间
+}
+
+// 获取浏览历史请求响应
+struct GetBrowsingHistoryRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetBrowsingHistoryResponse {
+    1: i32 code
+    2: string message
+    3: list<BrowsingHistoryItem> items
+    4: i32 total
+    5: bool has_more
+}
+
+// 清空浏览历史请求响应
+struct ClearBrowsingHistoryRequest {
+    1: string user_id
+}
+
+struct ClearBrowsingHistoryResponse {
+    1: i32 code
+    2: string message
+}
+
+// 删除单条浏览历史请求响应
+struct RemoveBrowsingHistoryRequest {
+    1: string user_id
+    2: string post_id
+}
+
+struct RemoveBrowsingHistoryResponse {
+    1: i32 code
+    2: string message
+}
+
+// 获取定时发布帖子请求响应
+struct GetScheduledPostsRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetScheduledPostsResponse {
+    1: i32 code
+    2: string message
+    3: list<Post> posts                // 按发布时间正序，已取消的排在最后
+    4: i32 total
+    5: bool has_more
+}
+
+// 修改定时发布时间请求响应，已取消的帖子可重新定时
+struct ReschedulePostRequest {
+    1: string post_id
+    2: string user_id
+    3: i64 publish_at
+}
+
+struct ReschedulePostResponse {
+    1: i32 code
+    2: string message
+    3: Post post
+}
+
+// 取消定时发布请求响应
+struct CancelScheduledPostRequest {
+    1: string post_id
+    2: string user_id
+}
+
+struct CancelScheduledPostResponse {
+    1: i32 code
+    2: string message
+    3: Post post
+}
+
+// 投票请求响应，单选时只能选择一个选项
+struct VotePollRequest {
+    1: string post_id
+    2: string user_id
+    3: list<string> option_ids
+}
+
+struct VotePollResponse {
+    1: i32 code
+    2: string message
+    3: Poll poll
+}
+
+// 修改投票请求响应，替换用户之前的全部选择
+struct ChangePollVoteRequest {
+    1: string post_id
+    2: string user_id
+    3: list<string> option_ids
+}
+
+struct ChangePollVoteResponse {
+    1: i32 code
+    2: string message
+    3: Poll poll
+}
+
+// 获取热门标签请求响应
+struct GetTrendingTagsRequest {
+    1: optional string window          // 统计窗口: 1h / 24h / 7d，默认24h
+    2: i32 limit
+}
+
+struct GetTrendingTagsResponse {
+    1: i32 code
+    2: string message
+    3: list<Tag> tags
+}
+
+// 获取标签详情请求响应
+struct GetTagRequest {
+    1: string name                     // 标签名，按规范化规则匹配
+    2: i32 page
+    3: i32 page_size
+    4: optional string sort_type       // latest / hot，默认latest
+    5: optional string viewer_id       // 登录用户，过滤其拉黑和屏蔽的用户的帖子
+}
+
+struct GetTagResponse {
+    1: i32 code
+    2: string message
+    3: Tag tag
+    4: list<Post> posts
+    5: bool has_more
+}
+
+// 订阅话题请求响应
+struct SubscribeTopicRequest {
+    1: string topic_id
+    2: string user_id
+}
+
+struct SubscribeTopicResponse {
+    1: i32 code
+    2: string message
+    3: i32 subscriber_count
+}
+
+// 取消订阅话题请求响应
+struct UnsubscribeTopicRequest {
+    1: string topic_id
+    2: string user_id
+}
+
+struct UnsubscribeTopicResponse {
+    1: i32 code
+    2: string message
+    3: i32 subscriber_count
+}
+
+// 获取已订阅话题请求响应
+struct GetSubscribedTopicsRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+}
+
+struct GetSubscribedTopicsResponse {
+    1: i32 code
+    2: string message
+    3: list<Topic> topics
+    4: i32 total
+    5: bool has_more
+}
+
+// 已订阅话题的新帖请求响应
+struct GetSubscribedTopicFeedRequest {
+    1: string user_id
+    2: i32 page
+    3: i32 page_size
+    4: optional string cursor          // 游标，传入时忽略page
+}
+
+struct GetSubscribedTopicFeedResponse {
+    1: i32 code
+    2: string message
+    3: list<Post> posts
+    4: i32 total
+    5: bool has_more
+    6: string next_cursor
 }
 
 // 获取用户评分请求响应
@@ -411,6 +1233,26 @@ struct DeleteRatingResponse {
     4: i32 total_ratings
 }
 
+// 评分分布中的一档
+struct RatingBucket {
+    1: i32 score
+    2: i32 count
+    3: double ratio              // 占总评分数的比例，0-1
+}
+
+// 获取帖子评分分布请求响应
+struct GetPostRatingDistributionRequest {
+    1: string post_id
+}
+
+struct GetPostRatingDistributionResponse {
+    1: i32 code
+    2: string message
+    3: double average_score
+    4: i32 total_ratings
+    5: list<RatingBucket> buckets  // 按分值从高到低排列
+}
+
 service PostService {
     // 帖子管理
     CreatePostResponse CreatePost(1: CreatePostRequest req)
@@ -418,6 +1260,53 @@ service PostService {
     GetPostListResponse GetPostList(1: GetPostListRequest req)
     UpdatePostResponse UpdatePost(1: UpdatePostRequest req)
     DeletePostResponse DeletePost(1: DeletePostRequest req)
+    GetPostRevisionsResponse GetPostRevisions(1: GetPostRevisionsRequest req)
+    GetPostRevisionResponse GetPostRevision(1: GetPostRevisionRequest req)
+    
+    // 草稿箱
+    CreateDraftResponse CreateDraft(1: CreateDraftRequest req)
+    UpdateDraftResponse UpdateDraft(1: UpdateDraftRequest req)
+    GetDraftResponse GetDraft(1: GetDraftRequest req)
+    GetDraftListResponse GetDraftList(1: GetDraftListRequest req)
+    DeleteDraftResponse DeleteDraft(1: DeleteDraftRequest req)
+    PublishDraftResponse PublishDraft(1: PublishDraftRequest req)
+    
+    // 浏览历史
+    GetBrowsingHistoryResponse GetBrowsingHistory(1: GetBrowsingHistoryRequest req)
+    ClearBrowsingHistoryResponse ClearBrowsingHistory(1: ClearBrowsingHistoryRequest req)
+    RemoveBrowsingHistoryResponse RemoveBrowsingHistory(1: RemoveBrowsingHistoryRequest req)
+    
+    // 定时发布
+    GetScheduledPostsResponse GetScheduledPosts(1: GetScheduledPostsRequest req)
+    ReschedulePostResponse ReschedulePost(1: ReschedulePostRequest req)
+    CancelScheduledPostResponse CancelScheduledPost(1: CancelScheduledPostRequest req)
+    
+    // 投票
+    VotePollResponse VotePoll(1: VotePollRequest req)
+    ChangePollVoteResponse ChangePollVote(1: ChangePollVoteRequest req)
+    
+    // 标签
+    GetTrendingTagsResponse GetTrendingTags(1: GetTrendingTagsRequest req)
+    GetTagResponse GetTag(1: GetTagRequest req)
+    
+    // 回收站
+    GetDeletedPostsResponse GetDeletedPosts(1: GetDeletedPostsRequest req)
+    RestorePostResponse RestorePost(1: RestorePostRequest req)
+    
+    // 内容审核
+    GetModerationReviewsResponse GetModerationReviews(1: GetModerationReviewsRequest req)
+    ResolveModerationReviewResponse ResolveModerationReview(1: ResolveModerationReviewRequest req)
+    
+    // 举报
+    CreateReportResponse CreateReport(1: CreateReportRequest req)
+    GetReportCasesResponse GetReportCases(1: GetReportCasesRequest req)
+    ResolveReportCaseResponse ResolveReportCase(1: ResolveReportCaseRequest req)
+    
+    // 申诉
+    CreateAppealResponse CreateAppeal(1: CreateAppealRequest req)
+    GetMyAppealsResponse GetMyAppeals(1: GetMyAppealsRequest req)
+    GetAppealsResponse GetAppeals(1: GetAppealsRequest req)
+    ResolveAppealResponse ResolveAppeal(1: ResolveAppealRequest req)
     
     // 帖子列表获取
     GetRecommendPostsResponse GetRecommendPosts(1: GetRecommendPostsRequest req)
@@ -428,6 +1317,9 @@ service PostService {
     
     // 搜索功能
     SearchPostsResponse SearchPosts(1: SearchPostsRequest req)
+    GetSearchSuggestionsResponse GetSearchSuggestions(1: GetSearchSuggestionsRequest req)
+    GetTrendingSearchesResponse GetTrendingSearches(1: GetTrendingSearchesRequest req)
+    GetRelatedPostsResponse GetRelatedPosts(1: GetRelatedPostsRequest req)
     
     // 话题管理
     CreateTopicResponse CreateTopic(1: CreateTopicRequest req)
@@ -437,11 +1329,21 @@ service PostService {
     GetTopicCategoriesResponse GetTopicCategories(1: GetTopicCategoriesRequest req)
     SearchTopicsResponse SearchTopics(1: SearchTopicsRequest req)
     ShareTopicResponse ShareTopic(1: ShareTopicRequest req)
+    SubscribeTopicResponse SubscribeTopic(1: SubscribeTopicRequest req)
+    UnsubscribeTopicResponse UnsubscribeTopic(1: UnsubscribeTopicRequest req)
+    GetSubscribedTopicsResponse GetSubscribedTopics(1: GetSubscribedTopicsRequest req)
+    GetSubscribedTopicFeedResponse GetSubscribedTopicFeed(1: GetSubscribedTopicFeedRequest req)
     
     // 收藏功能
     CollectPostResponse CollectPost(1: CollectPostRequest req)
     UncollectPostResponse UncollectPost(1: UncollectPostRequest req)
     GetCollectedPostsResponse GetCollectedPosts(1: GetCollectedPostsRequest req)
+    GetFavoriteFoldersResponse GetFavoriteFolders(1: GetFavoriteFoldersRequest req)
+    CreateFavoriteFolderResponse CreateFavoriteFolder(1: CreateFavoriteFolderRequest req)
+    UpdateFavoriteFolderResponse UpdateFavoriteFolder(1: UpdateFavoriteFolderRequest req)
+    DeleteFavoriteFolderResponse DeleteFavoriteFolder(1: DeleteFavoriteFolderRequest req)
+    GetFavoriteFolderPostsResponse GetFavoriteFolderPosts(1: GetFavoriteFolderPostsRequest req)
+    MoveFavoritesResponse MoveFavorites(1: MoveFavoritesRequest req)
     
     // 评分功能
     RatePostResponse RatePost(1: RatePostRequest req)
@@ -449,4 +1351,5 @@ service PostService {
     UpdateRatingResponse UpdateRating(1: UpdateRatingRequest req)
     DeleteRatingResponse DeleteRating(1: DeleteRatingRequest req)
     GetRatingRankResponse GetRatingRank(1: GetRatingRankRequest req)
+    GetPostRatingDistributionResponse GetPostRatingDistribution(1: GetPostRatingDistributionRequest req)
 }
\ No newline at end of file
@@ -313,6 +313,7 @@ type Comment struct {
 	Score          float64    `thrift:"score,19" frugal:"19,default,double" json:"score"`
 	RatingCount    int32      `thrift:"rating_count,20" frugal:"20,default,i32" json:"rating_count"`
 	IsRated        bool       `thrift:"is_rated,21" frugal:"21,default,bool" json:"is_rated"`
+	Status         int32      `thrift:"status,22" frugal:"22,default,i32" json:"status"`
 }
 
 func NewComment() *Comment {
@@ -430,6 +431,10 @@ func (p *Comment) GetRatingCount() (v int32) {
 func (p *Comment) GetIsRated() (v bool) {
 	return p.IsRated
 }
+
+func (p *Comment) GetStatus() (v int32) {
+	return p.Status
+}
 func (p *Comment) SetId(val string) {
 	p.Id = val
 }
@@ -493,6 +498,9 @@ func (p *Comment) SetRatingCount(val int32) {
 func (p *Comment) SetIsRated(val bool) {
 	p.IsRated = val
 }
+func (p *Comment) SetStatus(val int32) {
+	p.Status = val
+}
 
 var fieldIDToName_Comment = map[int16]string{
 	1:  "id",
@@ -516,6 +524,7 @@ var fieldIDToName_Comment = map[int16]string{
 	19: "score",
 	20: "rating_count",
 	21: "is_rated",
+	22: "status",
 }
 
 func (p *Comment) IsSetParentId() bool {
@@ -725,6 +734,14 @@ func (p *Comment) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 22:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField22(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1006,6 +1023,17 @@ func (p *Comment) ReadField21(iprot thrift.TProtocol) error {
 	p.IsRated = _field
 	return nil
 }
+func (p *Comment) ReadField22(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
 
 func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 
@@ -1098,6 +1126,10 @@ func (p *Comment) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 21
 			goto WriteFieldError
 		}
+		if err = p.writeField22(oprot); err != nil {
+			fieldId = 22
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1499,6 +1531,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 21 end error: ", p), err)
 }
 
+func (p *Comment) writeField22(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I32, 22); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 22 end error: ", p), err)
+}
+
 func (p *Comment) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1576,6 +1625,9 @@ func (p *Comment) DeepEqual(ano *Comment) bool {
 	if !p.Field21DeepEqual(ano.IsRated) {
 		return false
 	}
+	if !p.Field22DeepEqual(ano.Status) {
+		return false
+	}
 	return true
 }
 
@@ -1758,6 +1810,13 @@ func (p *Comment) Field21DeepEqual(src bool) bool {
 	}
 	return true
 }
+func (p *Comment) Field22DeepEqual(src int32) bool {
+
+	if p.Status != src {
+		return false
+	}
+	return true
+}
 
 type CreateCommentRequest struct {
 	PostId             string   `thrift:"post_id,1" frugal:"1,default,string" json:"post_id"`
@@ -2705,6 +2764,8 @@ type GetCommentListRequest struct {
 	SortType       *string `thrift:"sort_type,4,optional" frugal:"4,optional,string" json:"sort_type,omitempty"`
 	ParentId       *string `thrift:"parent_id,5,optional" frugal:"5,optional,string" json:"parent_id,omitempty"`
 	IncludeReplies bool    `thrift:"include_replies,6" frugal:"6,default,bool" json:"include_replies"`
+	Cursor         *string `thrift:"cursor,7,optional" frugal:"7,optional,string" json:"cursor,omitempty"`
+	ViewerId       *string `thrift:"viewer_id,8,optional" frugal:"8,optional,string" json:"viewer_id,omitempty"`
 }
 
 func NewGetCommentListRequest() *GetCommentListRequest {
@@ -2747,6 +2808,24 @@ func (p *GetCommentListRequest) GetParentId() (v string) {
 func (p *GetCommentListRequest) GetIncludeReplies() (v bool) {
 	return p.IncludeReplies
 }
+
+var GetCommentListRequest_Cursor_DEFAULT string
+
+func (p *GetCommentListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetCommentListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var GetCommentListRequest_ViewerId_DEFAULT string
+
+func (p *GetCommentListRequest) GetViewerId() (v string) {
+	if !p.IsSetViewerId() {
+		return GetCommentListRequest_ViewerId_DEFAULT
+	}
+	return *p.ViewerId
+}
 func (p *GetCommentListRequest) SetPostId(val string) {
 	p.PostId = val
 }
@@ -2765,6 +2844,12 @@ func (p *GetCommentListRequest) SetParentId(val *string) {
 func (p *GetCommentListRequest) SetIncludeReplies(val bool) {
 	p.IncludeReplies = val
 }
+func (p *GetCommentListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *GetCommentListRequest) SetViewerId(val *string) {
+	p.ViewerId = val
+}
 
 var fieldIDToName_GetCommentListRequest = map[int16]string{
 	1: "post_id",
@@ -2773,6 +2858,8 @@ var fieldIDToName_GetCommentListRequest = map[int16]string{
 	4: "sort_type",
 	5: "parent_id",
 	6: "include_replies",
+	7: "cursor",
+	8: "viewer_id",
 }
 
 func (p *GetCommentListRequest) IsSetSortType() bool {
@@ -2783,6 +2870,14 @@ func (p *GetCommentListRequest) IsSetParentId() bool {
 	return p.ParentId != nil
 }
 
+func (p *GetCommentListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetCommentListRequest) IsSetViewerId() bool {
+	return p.ViewerId != nil
+}
+
 func (p *GetCommentListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -2850,6 +2945,22 @@ func (p *GetCommentListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2945,6 +3056,28 @@ func (p *GetCommentListRequest) ReadField6(iprot thrift.TProtocol) error {
 	p.IncludeReplies = _field
 	return nil
 }
+func (p *GetCommentListRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+func (p *GetCommentListRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ViewerId = _field
+	return nil
+}
 
 func (p *GetCommentListRequest) Write(oprot thrift.TProtocol) (err error) {
 
@@ -2977,6 +3110,14 @@ func (p *GetCommentListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3101,6 +3242,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
+func (p *GetCommentListRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *GetCommentListRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetViewerId() {
+		if err = oprot.WriteFieldBegin("viewer_id", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ViewerId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
 func (p *GetCommentListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3133,6 +3312,12 @@ func (p *GetCommentListRequest) DeepEqual(ano *GetCommentListRequest) bool {
 	if !p.Field6DeepEqual(ano.IncludeReplies) {
 		return false
 	}
+	if !p.Field7DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.ViewerId) {
+		return false
+	}
 	return true
 }
 
@@ -3188,6 +3373,30 @@ func (p *GetCommentListRequest) Field6DeepEqual(src bool) bool {
 	}
 	return true
 }
+func (p *GetCommentListRequest) Field7DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetCommentListRequest) Field8DeepEqual(src *string) bool {
+
+	if p.ViewerId == src {
+		return true
+	} else if p.ViewerId == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ViewerId, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetCommentListResponse struct {
 	Code    int32            `thrift:"code,1" frugal:"1,default,i32" json:"code"`
@@ -3478,9 +3687,10 @@ func (p *GetCommentListResponse) Field3DeepEqual(src *CommentListData) bool {
 }
 
 type CommentListData struct {
-	List    []*Comment `thrift:"list,1" frugal:"1,default,list<Comment>" json:"list"`
-	Total   int32      `thrift:"total,2" frugal:"2,default,i32" json:"total"`
-	HasMore bool       `thrift:"hasMore,3" frugal:"3,default,bool" json:"hasMore"`
+	List       []*Comment `thrift:"list,1" frugal:"1,default,list<Comment>" json:"list"`
+	Total      int32      `thrift:"total,2" frugal:"2,default,i32" json:"total"`
+	HasMore    bool       `thrift:"hasMore,3" frugal:"3,default,bool" json:"hasMore"`
+	NextCursor string     `thrift:"nextCursor,4" frugal:"4,default,string" json:"nextCursor"`
 }
 
 func NewCommentListData() *CommentListData {
@@ -3501,6 +3711,10 @@ func (p *CommentListData) GetTotal() (v int32) {
 func (p *CommentListData) GetHasMore() (v bool) {
 	return p.HasMore
 }
+
+func (p *CommentListData) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *CommentListData) SetList(val []*Comment) {
 	p.List = val
 }
@@ -3510,11 +3724,15 @@ func (p *CommentListData) SetTotal(val int32) {
 func (p *CommentListData) SetHasMore(val bool) {
 	p.HasMore = val
 }
+func (p *CommentListData) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 var fieldIDToName_CommentListData = map[int16]string{
 	1: "list",
 	2: "total",
 	3: "hasMore",
+	4: "nextCursor",
 }
 
 func (p *CommentListData) Read(iprot thrift.TProtocol) (err error) {
@@ -3560,6 +3778,14 @@ func (p *CommentListData) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3634,6 +3860,17 @@ func (p *CommentListData) ReadField3(iprot thrift.TProtocol) error {
 	p.HasMore = _field
 	return nil
 }
+func (p *CommentListData) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
 
 func (p *CommentListData) Write(oprot thrift.TProtocol) (err error) {
 
@@ -3654,6 +3891,10 @@ func (p *CommentListData) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3731,6 +3972,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *CommentListData) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("nextCursor", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *CommentListData) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3754,6 +4012,9 @@ func (p *CommentListData) DeepEqual(ano *CommentListData) bool {
 	if !p.Field3DeepEqual(ano.HasMore) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -3784,6 +4045,13 @@ func (p *CommentListData) Field3DeepEqual(src bool) bool {
 	}
 	return true
 }
+func (p *CommentListData) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
+}
 
 type DeleteCommentRequest struct {
 	UserId    string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
@@ -2274,10 +2274,11 @@ func (p *CheckFollowStatusResponse) Field4DeepEqual(src bool) bool {
 }
 
 type GetFollowListRequest struct {
-	UserId   string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
-	Type     int32  `thrift:"type,2" frugal:"2,default,i32" json:"type"`
-	Page     int32  `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize int32  `thrift:"page_size,4" frugal:"4,default,i32" json:"page_size"`
+	UserId   string  `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	Type     int32   `thrift:"type,2" frugal:"2,default,i32" json:"type"`
+	Page     int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize int32   `thrift:"page_size,4" frugal:"4,default,i32" json:"page_size"`
+	Cursor   *string `thrift:"cursor,5,optional" frugal:"5,optional,string" json:"cursor,omitempty"`
 }
 
 func NewGetFollowListRequest() *GetFollowListRequest {
@@ -2302,6 +2303,15 @@ func (p *GetFollowListRequest) GetPage() (v int32) {
 func (p *GetFollowListRequest) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetFollowListRequest_Cursor_DEFAULT string
+
+func (p *GetFollowListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetFollowListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *GetFollowListRequest) SetUserId(val string) {
 	p.UserId = val
 }
@@ -2314,12 +2324,20 @@ func (p *GetFollowListRequest) SetPage(val int32) {
 func (p *GetFollowListRequest) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetFollowListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
 
 var fieldIDToName_GetFollowListRequest = map[int16]string{
 	1: "user_id",
 	2: "type",
 	3: "page",
 	4: "page_size",
+	5: "cursor",
+}
+
+func (p *GetFollowListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
 }
 
 func (p *GetFollowListRequest) Read(iprot thrift.TProtocol) (err error) {
@@ -2373,6 +2391,14 @@ func (p *GetFollowListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2446,6 +2472,17 @@ func (p *GetFollowListRequest) ReadField4(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *GetFollowListRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
 
 func (p *GetFollowListRequest) Write(oprot thrift.TProtocol) (err error) {
 
@@ -2470,6 +2507,10 @@ func (p *GetFollowListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2556,6 +2597,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *GetFollowListRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *GetFollowListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2582,6 +2642,9 @@ func (p *GetFollowListRequest) DeepEqual(ano *GetFollowListRequest) bool {
 	if !p.Field4DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -2613,13 +2676,26 @@ func (p *GetFollowListRequest) Field4DeepEqual(src int32) bool {
 	}
 	return true
 }
+func (p *GetFollowListRequest) Field5DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetFollowListResponse struct {
-	Code    int32     `thrift:"code,1" frugal:"1,default,i32" json:"code"`
-	Message string    `thrift:"message,2" frugal:"2,default,string" json:"message"`
-	Follows []*Follow `thrift:"follows,3" frugal:"3,default,list<Follow>" json:"follows"`
-	Total   int32     `thrift:"total,4" frugal:"4,default,i32" json:"total"`
-	HasMore bool      `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	Code       int32     `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Message    string    `thrift:"message,2" frugal:"2,default,string" json:"message"`
+	Follows    []*Follow `thrift:"follows,3" frugal:"3,default,list<Follow>" json:"follows"`
+	Total      int32     `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	HasMore    bool      `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	NextCursor string    `thrift:"next_cursor,6" frugal:"6,default,string" json:"next_cursor"`
 }
 
 func NewGetFollowListResponse() *GetFollowListResponse {
@@ -2648,6 +2724,10 @@ func (p *GetFollowListResponse) GetTotal() (v int32) {
 func (p *GetFollowListResponse) GetHasMore() (v bool) {
 	return p.HasMore
 }
+
+func (p *GetFollowListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *GetFollowListResponse) SetCode(val int32) {
 	p.Code = val
 }
@@ -2663,6 +2743,9 @@ func (p *GetFollowListResponse) SetTotal(val int32) {
 func (p *GetFollowListResponse) SetHasMore(val bool) {
 	p.HasMore = val
 }
+func (p *GetFollowListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 var fieldIDToName_GetFollowListResponse = map[int16]string{
 	1: "code",
@@ -2670,6 +2753,7 @@ var fieldIDToName_GetFollowListResponse = map[int16]string{
 	3: "follows",
 	4: "total",
 	5: "has_more",
+	6: "next_cursor",
 }
 
 func (p *GetFollowListResponse) Read(iprot thrift.TProtocol) (err error) {
@@ -2731,6 +2815,14 @@ func (p *GetFollowListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2827,6 +2919,17 @@ func (p *GetFollowListResponse) ReadField5(iprot thrift.TProtocol) error {
 	p.HasMore = _field
 	return nil
 }
+func (p *GetFollowListResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
 
 func (p *GetFollowListResponse) Write(oprot thrift.TProtocol) (err error) {
 
@@ -2855,6 +2958,10 @@ func (p *GetFollowListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2966,6 +3073,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *GetFollowListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *GetFollowListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2995,6 +3119,9 @@ func (p *GetFollowListResponse) DeepEqual(ano *GetFollowListResponse) bool {
 	if !p.Field5DeepEqual(ano.HasMore) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -3039,11 +3166,19 @@ func (p *GetFollowListResponse) Field5DeepEqual(src bool) bool {
 	}
 	return true
 }
+func (p *GetFollowListResponse) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetFollowerListRequest struct {
-	UserId   string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
-	Page     int32  `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize int32  `thrift:"page_size,3" frugal:"3,default,i32" json:"page_size"`
+	UserId   string  `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	Page     int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize int32   `thrift:"page_size,3" frugal:"3,default,i32" json:"page_size"`
+	Cursor   *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
 }
 
 func NewGetFollowerListRequest() *GetFollowerListRequest {
@@ -3064,6 +3199,15 @@ func (p *GetFollowerListRequest) GetPage() (v int32) {
 func (p *GetFollowerListRequest) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetFollowerListRequest_Cursor_DEFAULT string
+
+func (p *GetFollowerListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetFollowerListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *GetFollowerListRequest) SetUserId(val string) {
 	p.UserId = val
 }
@@ -3073,11 +3217,19 @@ func (p *GetFollowerListRequest) SetPage(val int32) {
 func (p *GetFollowerListRequest) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetFollowerListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
 
 var fieldIDToName_GetFollowerListRequest = map[int16]string{
 	1: "user_id",
 	2: "page",
 	3: "page_size",
+	4: "cursor",
+}
+
+func (p *GetFollowerListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
 }
 
 func (p *GetFollowerListRequest) Read(iprot thrift.TProtocol) (err error) {
@@ -3123,6 +3275,14 @@ func (p *GetFollowerListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3185,6 +3345,17 @@ func (p *GetFollowerListRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *GetFollowerListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
 
 func (p *GetFollowerListRequest) Write(oprot thrift.TProtocol) (err error) {
 
@@ -3205,6 +3376,10 @@ func (p *GetFollowerListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3274,6 +3449,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *GetFollowerListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *GetFollowerListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3297,6 +3491,9 @@ func (p *GetFollowerListRequest) DeepEqual(ano *GetFollowerListRequest) bool {
 	if !p.Field3DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -3321,13 +3518,26 @@ func (p *GetFollowerListRequest) Field3DeepEqual(src int32) bool {
 	}
 	return true
 }
+func (p *GetFollowerListRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetFollowerListResponse struct {
-	Code      int32     `thrift:"code,1" frugal:"1,default,i32" json:"code"`
-	Message   string    `thrift:"message,2" frugal:"2,default,string" json:"message"`
-	Followers []*Follow `thrift:"followers,3" frugal:"3,default,list<Follow>" json:"followers"`
-	Total     int32     `thrift:"total,4" frugal:"4,default,i32" json:"total"`
-	HasMore   bool      `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	Code       int32     `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Message    string    `thrift:"message,2" frugal:"2,default,string" json:"message"`
+	Followers  []*Follow `thrift:"followers,3" frugal:"3,default,list<Follow>" json:"followers"`
+	Total      int32     `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	HasMore    bool      `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	NextCursor string    `thrift:"next_cursor,6" frugal:"6,default,string" json:"next_cursor"`
 }
 
 func NewGetFollowerListResponse() *GetFollowerListResponse {
@@ -3356,6 +3566,10 @@ func (p *GetFollowerListResponse) GetTotal() (v int32) {
 func (p *GetFollowerListResponse) GetHasMore() (v bool) {
 	return p.HasMore
 }
+
+func (p *GetFollowerListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *GetFollowerListResponse) SetCode(val int32) {
 	p.Code = val
 }
@@ -3371,6 +3585,9 @@ func (p *GetFollowerListResponse) SetTotal(val int32) {
 func (p *GetFollowerListResponse) SetHasMore(val bool) {
 	p.HasMore = val
 }
+func (p *GetFollowerListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 var fieldIDToName_GetFollowerListResponse = map[int16]string{
 	1: "code",
@@ -3378,6 +3595,7 @@ var fieldIDToName_GetFollowerListResponse = map[int16]string{
 	3: "followers",
 	4: "total",
 	5: "has_more",
+	6: "next_cursor",
 }
 
 func (p *GetFollowerListResponse) Read(iprot thrift.TProtocol) (err error) {
@@ -3439,6 +3657,14 @@ func (p *GetFollowerListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3535,6 +3761,17 @@ func (p *GetFollowerListResponse) ReadField5(iprot thrift.TProtocol) error {
 	p.HasMore = _field
 	return nil
 }
+func (p *GetFollowerListResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
 
 func (p *GetFollowerListResponse) Write(oprot thrift.TProtocol) (err error) {
 
@@ -3563,6 +3800,10 @@ func (p *GetFollowerListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3674,6 +3915,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *GetFollowerListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *GetFollowerListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3703,6 +3961,9 @@ func (p *GetFollowerListResponse) DeepEqual(ano *GetFollowerListResponse) bool {
 	if !p.Field5DeepEqual(ano.HasMore) {
 		return false
 	}
+	if !p.Field6DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -3747,6 +4008,13 @@ func (p *GetFollowerListResponse) Field5DeepEqual(src bool) bool {
 	}
 	return true
 }
+func (p *GetFollowerListResponse) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetFollowCountRequest struct {
 	UserId string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
@@ -5407,58 +5675,38 @@ func (p *GetMutualFollowsResponse) Field5DeepEqual(src bool) bool {
 	return true
 }
 
-type FollowService interface {
-	Follow(ctx context.Context, req *FollowRequest) (r *FollowResponse, err error)
-
-	Unfollow(ctx context.Context, req *UnfollowRequest) (r *UnfollowResponse, err error)
-
-	IsFollowing(ctx context.Context, req *FollowRequest) (r *FollowResponse, err error)
-
-	GetFollowList(ctx context.Context, req *GetFollowListRequest) (r *GetFollowListResponse, err error)
-
-	GetFollowerList(ctx context.Context, req *GetFollowerListRequest) (r *GetFollowerListResponse, err error)
-
-	CheckFollowStatus(ctx context.Context, req *CheckFollowStatusRequest) (r *CheckFollowStatusResponse, err error)
-
-	GetFollowCount(ctx context.Context, req *GetFollowCountRequest) (r *GetFollowCountResponse, err error)
-
-	GetFollowerCount(ctx context.Context, req *GetFollowerCountRequest) (r *GetFollowerCountResponse, err error)
-
-	GetMutualFollows(ctx context.Context, req *GetMutualFollowsRequest) (r *GetMutualFollowsResponse, err error)
+type RelationUser struct {
+	UserId    string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	CreatedAt int64  `thrift:"created_at,2" frugal:"2,default,i64" json:"created_at"`
 }
 
-type FollowServiceFollowArgs struct {
-	Req *FollowRequest `thrift:"req,1" frugal:"1,default,FollowRequest" json:"req"`
+func NewRelationUser() *RelationUser {
+	return &RelationUser{}
 }
 
-func NewFollowServiceFollowArgs() *FollowServiceFollowArgs {
-	return &FollowServiceFollowArgs{}
+func (p *RelationUser) InitDefault() {
 }
 
-func (p *FollowServiceFollowArgs) InitDefault() {
+func (p *RelationUser) GetUserId() (v string) {
+	return p.UserId
 }
 
-var FollowServiceFollowArgs_Req_DEFAULT *FollowRequest
-
-func (p *FollowServiceFollowArgs) GetReq() (v *FollowRequest) {
-	if !p.IsSetReq() {
-		return FollowServiceFollowArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *RelationUser) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
-func (p *FollowServiceFollowArgs) SetReq(val *FollowRequest) {
-	p.Req = val
+func (p *RelationUser) SetUserId(val string) {
+	p.UserId = val
 }
-
-var fieldIDToName_FollowServiceFollowArgs = map[int16]string{
-	1: "req",
+func (p *RelationUser) SetCreatedAt(val int64) {
+	p.CreatedAt = val
 }
 
-func (p *FollowServiceFollowArgs) IsSetReq() bool {
-	return p.Req != nil
+var fieldIDToName_RelationUser = map[int16]string{
+	1: "user_id",
+	2: "created_at",
 }
 
-func (p *FollowServiceFollowArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RelationUser) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5478,13 +5726,21 @@ func (p *FollowServiceFollowArgs) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5504,7 +5760,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceFollowArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationUser[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5514,19 +5770,3606 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceFollowArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFollowRequest()
-	if err := _field.Read(iprot); err != nil {
+func (p *RelationUser) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Req = _field
+	p.UserId = _field
+	return nil
+}
+func (p *RelationUser) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *RelationUser) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationUser"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationUser) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationUser) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationUser) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationUser(%+v)", *p)
+
+}
+
+func (p *RelationUser) DeepEqual(ano *RelationUser) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationUser) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationUser) Field2DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+type RelationRequest struct {
+	UserId       string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	TargetUserId string `thrift:"target_user_id,2" frugal:"2,default,string" json:"target_user_id"`
+}
+
+func NewRelationRequest() *RelationRequest {
+	return &RelationRequest{}
+}
+
+func (p *RelationRequest) InitDefault() {
+}
+
+func (p *RelationRequest) GetUserId() (v string) {
+	return p.UserId
+}
+
+func (p *RelationRequest) GetTargetUserId() (v string) {
+	return p.TargetUserId
+}
+func (p *RelationRequest) SetUserId(val string) {
+	p.UserId = val
+}
+func (p *RelationRequest) SetTargetUserId(val string) {
+	p.TargetUserId = val
+}
+
+var fieldIDToName_RelationRequest = map[int16]string{
+	1: "user_id",
+	2: "target_user_id",
+}
+
+func (p *RelationRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *RelationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TargetUserId = _field
+	return nil
+}
+
+func (p *RelationRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("target_user_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TargetUserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationRequest(%+v)", *p)
+
+}
+
+func (p *RelationRequest) DeepEqual(ano *RelationRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.TargetUserId) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *RelationRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.TargetUserId, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type RelationResponse struct {
+	Code    int32  `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Message string `thrift:"message,2" frugal:"2,default,string" json:"message"`
+}
+
+func NewRelationResponse() *RelationResponse {
+	return &RelationResponse{}
+}
+
+func (p *RelationResponse) InitDefault() {
+}
+
+func (p *RelationResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *RelationResponse) GetMessage() (v string) {
+	return p.Message
+}
+func (p *RelationResponse) SetCode(val int32) {
+	p.Code = val
+}
+func (p *RelationResponse) SetMessage(val string) {
+	p.Message = val
+}
+
+var fieldIDToName_RelationResponse = map[int16]string{
+	1: "code",
+	2: "message",
+}
+
+func (p *RelationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RelationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RelationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *RelationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *RelationResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RelationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RelationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RelationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RelationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelationResponse(%+v)", *p)
+
+}
+
+func (p *RelationResponse) DeepEqual(ano *RelationResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	return true
+}
+
+func (p *RelationResponse) Field1DeepEqual(src int32) bool {
+
+	if p.Code != src {
+		return false
+	}
+	return true
+}
+func (p *RelationResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type GetRelationListRequest struct {
+	UserId   string  `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	Page     int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize int32   `thrift:"page_size,3" frugal:"3,default,i32" json:"page_size"`
+	Cursor   *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
+}
+
+func NewGetRelationListRequest() *GetRelationListRequest {
+	return &GetRelationListRequest{}
+}
+
+func (p *GetRelationListRequest) InitDefault() {
+}
+
+func (p *GetRelationListRequest) GetUserId() (v string) {
+	return p.UserId
+}
+
+func (p *GetRelationListRequest) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *GetRelationListRequest) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var GetRelationListRequest_Cursor_DEFAULT string
+
+func (p *GetRelationListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetRelationListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+func (p *GetRelationListRequest) SetUserId(val string) {
+	p.UserId = val
+}
+func (p *GetRelationListRequest) SetPage(val int32) {
+	p.Page = val
+}
+func (p *GetRelationListRequest) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *GetRelationListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+
+var fieldIDToName_GetRelationListRequest = map[int16]string{
+	1: "user_id",
+	2: "page",
+	3: "page_size",
+	4: "cursor",
+}
+
+func (p *GetRelationListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *GetRelationListRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationListRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserId = _field
+	return nil
+}
+func (p *GetRelationListRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Page = _field
+	return nil
+}
+func (p *GetRelationListRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *GetRelationListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
+
+func (p *GetRelationListRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRelationListRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UserId); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Page); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetRelationListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelationListRequest(%+v)", *p)
+
+}
+
+func (p *GetRelationListRequest) DeepEqual(ano *GetRelationListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Page) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PageSize) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Cursor) {
+		return false
+	}
+	return true
+}
+
+func (p *GetRelationListRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserId, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListRequest) Field2DeepEqual(src int32) bool {
+
+	if p.Page != src {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListRequest) Field3DeepEqual(src int32) bool {
+
+	if p.PageSize != src {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+type GetRelationListResponse struct {
+	Code       int32           `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Message    string          `thrift:"message,2" frugal:"2,default,string" json:"message"`
+	Users      []*RelationUser `thrift:"users,3" frugal:"3,default,list<RelationUser>" json:"users"`
+	Total      int32           `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	HasMore    bool            `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	NextCursor string          `thrift:"next_cursor,6" frugal:"6,default,string" json:"next_cursor"`
+}
+
+func NewGetRelationListResponse() *GetRelationListResponse {
+	return &GetRelationListResponse{}
+}
+
+func (p *GetRelationListResponse) InitDefault() {
+}
+
+func (p *GetRelationListResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *GetRelationListResponse) GetMessage() (v string) {
+	return p.Message
+}
+
+func (p *GetRelationListResponse) GetUsers() (v []*RelationUser) {
+	return p.Users
+}
+
+func (p *GetRelationListResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *GetRelationListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *GetRelationListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+func (p *GetRelationListResponse) SetCode(val int32) {
+	p.Code = val
+}
+func (p *GetRelationListResponse) SetMessage(val string) {
+	p.Message = val
+}
+func (p *GetRelationListResponse) SetUsers(val []*RelationUser) {
+	p.Users = val
+}
+func (p *GetRelationListResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *GetRelationListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *GetRelationListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
+
+var fieldIDToName_GetRelationListResponse = map[int16]string{
+	1: "code",
+	2: "message",
+	3: "users",
+	4: "total",
+	5: "has_more",
+	6: "next_cursor",
+}
+
+func (p *GetRelationListResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRelationListResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *GetRelationListResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+func (p *GetRelationListResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*RelationUser, 0, size)
+	values := make([]RelationUser, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Users = _field
+	return nil
+}
+func (p *GetRelationListResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *GetRelationListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *GetRelationListResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
+
+func (p *GetRelationListResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRelationListResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("users", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Users)); err != nil {
+		return err
+	}
+	for _, v := range p.Users {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *GetRelationListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRelationListResponse(%+v)", *p)
+
+}
+
+func (p *GetRelationListResponse) DeepEqual(ano *GetRelationListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Message) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Users) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.NextCursor) {
+		return false
+	}
+	return true
+}
+
+func (p *GetRelationListResponse) Field1DeepEqual(src int32) bool {
+
+	if p.Code != src {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Message, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListResponse) Field3DeepEqual(src []*RelationUser) bool {
+
+	if len(p.Users) != len(src) {
+		return false
+	}
+	for i, v := range p.Users {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *GetRelationListResponse) Field4DeepEqual(src int32) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+func (p *GetRelationListResponse) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
+}
+
+type FollowService interface {
+	Follow(ctx context.Context, req *FollowRequest) (r *FollowResponse, err error)
+
+	Unfollow(ctx context.Context, req *UnfollowRequest) (r *UnfollowResponse, err error)
+
+	IsFollowing(ctx context.Context, req *FollowRequest) (r *FollowResponse, err error)
+
+	GetFollowList(ctx context.Context, req *GetFollowListRequest) (r *GetFollowListResponse, err error)
+
+	GetFollowerList(ctx context.Context, req *GetFollowerListRequest) (r *GetFollowerListResponse, err error)
+
+	CheckFollowStatus(ctx context.Context, req *CheckFollowStatusRequest) (r *CheckFollowStatusResponse, err error)
+
+	GetFollowCount(ctx context.Context, req *GetFollowCountRequest) (r *GetFollowCountResponse, err error)
+
+	GetFollowerCount(ctx context.Context, req *GetFollowerCountRequest) (r *GetFollowerCountResponse, err error)
+
+	GetMutualFollows(ctx context.Context, req *GetMutualFollowsRequest) (r *GetMutualFollowsResponse, err error)
+
+	Block(ctx context.Context, req *RelationRequest) (r *RelationResponse, err error)
+
+	Unblock(ctx context.Context, req *RelationRequest) (r *RelationResponse, err error)
+
+	GetBlockList(ctx context.Context, req *GetRelationListRequest) (r *GetRelationListResponse, err error)
+
+	Mute(ctx context.Context, req *RelationRequest) (r *RelationResponse, err error)
+
+	Unmute(ctx context.Context, req *RelationRequest) (r *RelationResponse, err error)
+
+	GetMuteList(ctx context.Context, req *GetRelationListRequest) (r *GetRelationListResponse, err error)
+}
+
+type FollowServiceFollowArgs struct {
+	Req *FollowRequest `thrift:"req,1" frugal:"1,default,FollowRequest" json:"req"`
+}
+
+func NewFollowServiceFollowArgs() *FollowServiceFollowArgs {
+	return &FollowServiceFollowArgs{}
+}
+
+func (p *FollowServiceFollowArgs) InitDefault() {
+}
+
+var FollowServiceFollowArgs_Req_DEFAULT *FollowRequest
+
+func (p *FollowServiceFollowArgs) GetReq() (v *FollowRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceFollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceFollowArgs) SetReq(val *FollowRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceFollowArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceFollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceFollowArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceFollowArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceFollowArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFollowRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
 	return nil
 }
 
 func (p *FollowServiceFollowArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Follow_args"); err != nil {
+	if err = oprot.WriteStructBegin("Follow_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceFollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceFollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceFollowArgs) DeepEqual(ano *FollowServiceFollowArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceFollowArgs) Field1DeepEqual(src *FollowRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceFollowResult struct {
+	Success *FollowResponse `thrift:"success,0,optional" frugal:"0,optional,FollowResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceFollowResult() *FollowServiceFollowResult {
+	return &FollowServiceFollowResult{}
+}
+
+func (p *FollowServiceFollowResult) InitDefault() {
+}
+
+var FollowServiceFollowResult_Success_DEFAULT *FollowResponse
+
+func (p *FollowServiceFollowResult) GetSuccess() (v *FollowResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceFollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceFollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowResponse)
+}
+
+var fieldIDToName_FollowServiceFollowResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceFollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceFollowResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceFollowResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceFollowResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFollowResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceFollowResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Follow_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceFollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceFollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceFollowResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceFollowResult) DeepEqual(ano *FollowServiceFollowResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceFollowResult) Field0DeepEqual(src *FollowResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceUnfollowArgs struct {
+	Req *UnfollowRequest `thrift:"req,1" frugal:"1,default,UnfollowRequest" json:"req"`
+}
+
+func NewFollowServiceUnfollowArgs() *FollowServiceUnfollowArgs {
+	return &FollowServiceUnfollowArgs{}
+}
+
+func (p *FollowServiceUnfollowArgs) InitDefault() {
+}
+
+var FollowServiceUnfollowArgs_Req_DEFAULT *UnfollowRequest
+
+func (p *FollowServiceUnfollowArgs) GetReq() (v *UnfollowRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceUnfollowArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceUnfollowArgs) SetReq(val *UnfollowRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceUnfollowArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceUnfollowArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceUnfollowArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnfollowArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUnfollowRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceUnfollowArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Unfollow_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceUnfollowArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceUnfollowArgs) DeepEqual(ano *FollowServiceUnfollowArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceUnfollowArgs) Field1DeepEqual(src *UnfollowRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceUnfollowResult struct {
+	Success *UnfollowResponse `thrift:"success,0,optional" frugal:"0,optional,UnfollowResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceUnfollowResult() *FollowServiceUnfollowResult {
+	return &FollowServiceUnfollowResult{}
+}
+
+func (p *FollowServiceUnfollowResult) InitDefault() {
+}
+
+var FollowServiceUnfollowResult_Success_DEFAULT *UnfollowResponse
+
+func (p *FollowServiceUnfollowResult) GetSuccess() (v *UnfollowResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceUnfollowResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceUnfollowResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UnfollowResponse)
+}
+
+var fieldIDToName_FollowServiceUnfollowResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceUnfollowResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceUnfollowResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnfollowResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUnfollowResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceUnfollowResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Unfollow_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceUnfollowResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceUnfollowResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceUnfollowResult) DeepEqual(ano *FollowServiceUnfollowResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceUnfollowResult) Field0DeepEqual(src *UnfollowResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceIsFollowingArgs struct {
+	Req *FollowRequest `thrift:"req,1" frugal:"1,default,FollowRequest" json:"req"`
+}
+
+func NewFollowServiceIsFollowingArgs() *FollowServiceIsFollowingArgs {
+	return &FollowServiceIsFollowingArgs{}
+}
+
+func (p *FollowServiceIsFollowingArgs) InitDefault() {
+}
+
+var FollowServiceIsFollowingArgs_Req_DEFAULT *FollowRequest
+
+func (p *FollowServiceIsFollowingArgs) GetReq() (v *FollowRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceIsFollowingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceIsFollowingArgs) SetReq(val *FollowRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceIsFollowingArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceIsFollowingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceIsFollowingArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceIsFollowingArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFollowRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceIsFollowingArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("IsFollowing_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceIsFollowingArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceIsFollowingArgs) DeepEqual(ano *FollowServiceIsFollowingArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceIsFollowingArgs) Field1DeepEqual(src *FollowRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceIsFollowingResult struct {
+	Success *FollowResponse `thrift:"success,0,optional" frugal:"0,optional,FollowResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceIsFollowingResult() *FollowServiceIsFollowingResult {
+	return &FollowServiceIsFollowingResult{}
+}
+
+func (p *FollowServiceIsFollowingResult) InitDefault() {
+}
+
+var FollowServiceIsFollowingResult_Success_DEFAULT *FollowResponse
+
+func (p *FollowServiceIsFollowingResult) GetSuccess() (v *FollowResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceIsFollowingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceIsFollowingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FollowResponse)
+}
+
+var fieldIDToName_FollowServiceIsFollowingResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceIsFollowingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceIsFollowingResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceIsFollowingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFollowResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceIsFollowingResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("IsFollowing_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceIsFollowingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceIsFollowingResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceIsFollowingResult) DeepEqual(ano *FollowServiceIsFollowingResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceIsFollowingResult) Field0DeepEqual(src *FollowResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceGetFollowListArgs struct {
+	Req *GetFollowListRequest `thrift:"req,1" frugal:"1,default,GetFollowListRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowListArgs() *FollowServiceGetFollowListArgs {
+	return &FollowServiceGetFollowListArgs{}
+}
+
+func (p *FollowServiceGetFollowListArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowListArgs_Req_DEFAULT *GetFollowListRequest
+
+func (p *FollowServiceGetFollowListArgs) GetReq() (v *GetFollowListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowListArgs) SetReq(val *GetFollowListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceGetFollowListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceGetFollowListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetFollowListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceGetFollowListArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowListArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceGetFollowListArgs) DeepEqual(ano *FollowServiceGetFollowListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceGetFollowListArgs) Field1DeepEqual(src *GetFollowListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceGetFollowListResult struct {
+	Success *GetFollowListResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceGetFollowListResult() *FollowServiceGetFollowListResult {
+	return &FollowServiceGetFollowListResult{}
+}
+
+func (p *FollowServiceGetFollowListResult) InitDefault() {
+}
+
+var FollowServiceGetFollowListResult_Success_DEFAULT *GetFollowListResponse
+
+func (p *FollowServiceGetFollowListResult) GetSuccess() (v *GetFollowListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceGetFollowListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceGetFollowListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowListResponse)
+}
+
+var fieldIDToName_FollowServiceGetFollowListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceGetFollowListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceGetFollowListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetFollowListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceGetFollowListResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowListResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceGetFollowListResult) DeepEqual(ano *FollowServiceGetFollowListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceGetFollowListResult) Field0DeepEqual(src *GetFollowListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceGetFollowerListArgs struct {
+	Req *GetFollowerListRequest `thrift:"req,1" frugal:"1,default,GetFollowerListRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowerListArgs() *FollowServiceGetFollowerListArgs {
+	return &FollowServiceGetFollowerListArgs{}
+}
+
+func (p *FollowServiceGetFollowerListArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowerListArgs_Req_DEFAULT *GetFollowerListRequest
+
+func (p *FollowServiceGetFollowerListArgs) GetReq() (v *GetFollowerListRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowerListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowerListArgs) SetReq(val *GetFollowerListRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceGetFollowerListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceGetFollowerListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowerListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetFollowerListRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceGetFollowerListArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowerList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowerListArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceGetFollowerListArgs) DeepEqual(ano *FollowServiceGetFollowerListArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceGetFollowerListArgs) Field1DeepEqual(src *GetFollowerListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceGetFollowerListResult struct {
+	Success *GetFollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowerListResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceGetFollowerListResult() *FollowServiceGetFollowerListResult {
+	return &FollowServiceGetFollowerListResult{}
+}
+
+func (p *FollowServiceGetFollowerListResult) InitDefault() {
+}
+
+var FollowServiceGetFollowerListResult_Success_DEFAULT *GetFollowerListResponse
+
+func (p *FollowServiceGetFollowerListResult) GetSuccess() (v *GetFollowerListResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceGetFollowerListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceGetFollowerListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowerListResponse)
+}
+
+var fieldIDToName_FollowServiceGetFollowerListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceGetFollowerListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceGetFollowerListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetFollowerListResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceGetFollowerListResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowerList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowerListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceGetFollowerListResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceGetFollowerListResult) DeepEqual(ano *FollowServiceGetFollowerListResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceGetFollowerListResult) Field0DeepEqual(src *GetFollowerListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceCheckFollowStatusArgs struct {
+	Req *CheckFollowStatusRequest `thrift:"req,1" frugal:"1,default,CheckFollowStatusRequest" json:"req"`
+}
+
+func NewFollowServiceCheckFollowStatusArgs() *FollowServiceCheckFollowStatusArgs {
+	return &FollowServiceCheckFollowStatusArgs{}
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) InitDefault() {
+}
+
+var FollowServiceCheckFollowStatusArgs_Req_DEFAULT *CheckFollowStatusRequest
+
+func (p *FollowServiceCheckFollowStatusArgs) GetReq() (v *CheckFollowStatusRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceCheckFollowStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceCheckFollowStatusArgs) SetReq(val *CheckFollowStatusRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceCheckFollowStatusArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceCheckFollowStatusArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCheckFollowStatusRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CheckFollowStatus_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceCheckFollowStatusArgs(%+v)", *p)
+
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) DeepEqual(ano *FollowServiceCheckFollowStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceCheckFollowStatusArgs) Field1DeepEqual(src *CheckFollowStatusRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceCheckFollowStatusResult struct {
+	Success *CheckFollowStatusResponse `thrift:"success,0,optional" frugal:"0,optional,CheckFollowStatusResponse" json:"success,omitempty"`
+}
+
+func NewFollowServiceCheckFollowStatusResult() *FollowServiceCheckFollowStatusResult {
+	return &FollowServiceCheckFollowStatusResult{}
+}
+
+func (p *FollowServiceCheckFollowStatusResult) InitDefault() {
+}
+
+var FollowServiceCheckFollowStatusResult_Success_DEFAULT *CheckFollowStatusResponse
+
+func (p *FollowServiceCheckFollowStatusResult) GetSuccess() (v *CheckFollowStatusResponse) {
+	if !p.IsSetSuccess() {
+		return FollowServiceCheckFollowStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *FollowServiceCheckFollowStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckFollowStatusResponse)
+}
+
+var fieldIDToName_FollowServiceCheckFollowStatusResult = map[int16]string{
+	0: "success",
+}
+
+func (p *FollowServiceCheckFollowStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *FollowServiceCheckFollowStatusResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceCheckFollowStatusResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCheckFollowStatusResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *FollowServiceCheckFollowStatusResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CheckFollowStatus_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *FollowServiceCheckFollowStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FollowServiceCheckFollowStatusResult(%+v)", *p)
+
+}
+
+func (p *FollowServiceCheckFollowStatusResult) DeepEqual(ano *FollowServiceCheckFollowStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *FollowServiceCheckFollowStatusResult) Field0DeepEqual(src *CheckFollowStatusResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+type FollowServiceGetFollowCountArgs struct {
+	Req *GetFollowCountRequest `thrift:"req,1" frugal:"1,default,GetFollowCountRequest" json:"req"`
+}
+
+func NewFollowServiceGetFollowCountArgs() *FollowServiceGetFollowCountArgs {
+	return &FollowServiceGetFollowCountArgs{}
+}
+
+func (p *FollowServiceGetFollowCountArgs) InitDefault() {
+}
+
+var FollowServiceGetFollowCountArgs_Req_DEFAULT *GetFollowCountRequest
+
+func (p *FollowServiceGetFollowCountArgs) GetReq() (v *GetFollowCountRequest) {
+	if !p.IsSetReq() {
+		return FollowServiceGetFollowCountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *FollowServiceGetFollowCountArgs) SetReq(val *GetFollowCountRequest) {
+	p.Req = val
+}
+
+var fieldIDToName_FollowServiceGetFollowCountArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *FollowServiceGetFollowCountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *FollowServiceGetFollowCountArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowCountArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *FollowServiceGetFollowCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetFollowCountRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *FollowServiceGetFollowCountArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5552,7 +9395,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceFollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5569,15 +9412,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceFollowArgs) String() string {
+func (p *FollowServiceGetFollowCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetFollowCountArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceFollowArgs) DeepEqual(ano *FollowServiceFollowArgs) bool {
+func (p *FollowServiceGetFollowCountArgs) DeepEqual(ano *FollowServiceGetFollowCountArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5589,7 +9432,7 @@ func (p *FollowServiceFollowArgs) DeepEqual(ano *FollowServiceFollowArgs) bool {
 	return true
 }
 
-func (p *FollowServiceFollowArgs) Field1DeepEqual(src *FollowRequest) bool {
+func (p *FollowServiceGetFollowCountArgs) Field1DeepEqual(src *GetFollowCountRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5597,38 +9440,38 @@ func (p *FollowServiceFollowArgs) Field1DeepEqual(src *FollowRequest) bool {
 	return true
 }
 
-type FollowServiceFollowResult struct {
-	Success *FollowResponse `thrift:"success,0,optional" frugal:"0,optional,FollowResponse" json:"success,omitempty"`
+type FollowServiceGetFollowCountResult struct {
+	Success *GetFollowCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowCountResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceFollowResult() *FollowServiceFollowResult {
-	return &FollowServiceFollowResult{}
+func NewFollowServiceGetFollowCountResult() *FollowServiceGetFollowCountResult {
+	return &FollowServiceGetFollowCountResult{}
 }
 
-func (p *FollowServiceFollowResult) InitDefault() {
+func (p *FollowServiceGetFollowCountResult) InitDefault() {
 }
 
-var FollowServiceFollowResult_Success_DEFAULT *FollowResponse
+var FollowServiceGetFollowCountResult_Success_DEFAULT *GetFollowCountResponse
 
-func (p *FollowServiceFollowResult) GetSuccess() (v *FollowResponse) {
+func (p *FollowServiceGetFollowCountResult) GetSuccess() (v *GetFollowCountResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceFollowResult_Success_DEFAULT
+		return FollowServiceGetFollowCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceFollowResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowResponse)
+func (p *FollowServiceGetFollowCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowCountResponse)
 }
 
-var fieldIDToName_FollowServiceFollowResult = map[int16]string{
+var fieldIDToName_FollowServiceGetFollowCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceFollowResult) IsSetSuccess() bool {
+func (p *FollowServiceGetFollowCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceFollowResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowCountResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5674,7 +9517,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceFollowResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5684,8 +9527,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceFollowResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewFollowResponse()
+func (p *FollowServiceGetFollowCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetFollowCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5693,10 +9536,10 @@ func (p *FollowServiceFollowResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FollowServiceFollowResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowCountResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Follow_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5722,7 +9565,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceFollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5741,15 +9584,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceFollowResult) String() string {
+func (p *FollowServiceGetFollowCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceFollowResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetFollowCountResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceFollowResult) DeepEqual(ano *FollowServiceFollowResult) bool {
+func (p *FollowServiceGetFollowCountResult) DeepEqual(ano *FollowServiceGetFollowCountResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5761,7 +9604,7 @@ func (p *FollowServiceFollowResult) DeepEqual(ano *FollowServiceFollowResult) bo
 	return true
 }
 
-func (p *FollowServiceFollowResult) Field0DeepEqual(src *FollowResponse) bool {
+func (p *FollowServiceGetFollowCountResult) Field0DeepEqual(src *GetFollowCountResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -5769,38 +9612,38 @@ func (p *FollowServiceFollowResult) Field0DeepEqual(src *FollowResponse) bool {
 	return true
 }
 
-type FollowServiceUnfollowArgs struct {
-	Req *UnfollowRequest `thrift:"req,1" frugal:"1,default,UnfollowRequest" json:"req"`
+type FollowServiceGetFollowerCountArgs struct {
+	Req *GetFollowerCountRequest `thrift:"req,1" frugal:"1,default,GetFollowerCountRequest" json:"req"`
 }
 
-func NewFollowServiceUnfollowArgs() *FollowServiceUnfollowArgs {
-	return &FollowServiceUnfollowArgs{}
+func NewFollowServiceGetFollowerCountArgs() *FollowServiceGetFollowerCountArgs {
+	return &FollowServiceGetFollowerCountArgs{}
 }
 
-func (p *FollowServiceUnfollowArgs) InitDefault() {
+func (p *FollowServiceGetFollowerCountArgs) InitDefault() {
 }
 
-var FollowServiceUnfollowArgs_Req_DEFAULT *UnfollowRequest
+var FollowServiceGetFollowerCountArgs_Req_DEFAULT *GetFollowerCountRequest
 
-func (p *FollowServiceUnfollowArgs) GetReq() (v *UnfollowRequest) {
+func (p *FollowServiceGetFollowerCountArgs) GetReq() (v *GetFollowerCountRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceUnfollowArgs_Req_DEFAULT
+		return FollowServiceGetFollowerCountArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceUnfollowArgs) SetReq(val *UnfollowRequest) {
+func (p *FollowServiceGetFollowerCountArgs) SetReq(val *GetFollowerCountRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceUnfollowArgs = map[int16]string{
+var fieldIDToName_FollowServiceGetFollowerCountArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceUnfollowArgs) IsSetReq() bool {
+func (p *FollowServiceGetFollowerCountArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceUnfollowArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5846,7 +9689,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnfollowArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerCountArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5856,8 +9699,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUnfollowRequest()
+func (p *FollowServiceGetFollowerCountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetFollowerCountRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5865,10 +9708,10 @@ func (p *FollowServiceUnfollowArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FollowServiceUnfollowArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Unfollow_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowerCount_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5894,7 +9737,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5911,15 +9754,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowArgs) String() string {
+func (p *FollowServiceGetFollowerCountArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceUnfollowArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetFollowerCountArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceUnfollowArgs) DeepEqual(ano *FollowServiceUnfollowArgs) bool {
+func (p *FollowServiceGetFollowerCountArgs) DeepEqual(ano *FollowServiceGetFollowerCountArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -5931,7 +9774,7 @@ func (p *FollowServiceUnfollowArgs) DeepEqual(ano *FollowServiceUnfollowArgs) bo
 	return true
 }
 
-func (p *FollowServiceUnfollowArgs) Field1DeepEqual(src *UnfollowRequest) bool {
+func (p *FollowServiceGetFollowerCountArgs) Field1DeepEqual(src *GetFollowerCountRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -5939,38 +9782,38 @@ func (p *FollowServiceUnfollowArgs) Field1DeepEqual(src *UnfollowRequest) bool {
 	return true
 }
 
-type FollowServiceUnfollowResult struct {
-	Success *UnfollowResponse `thrift:"success,0,optional" frugal:"0,optional,UnfollowResponse" json:"success,omitempty"`
+type FollowServiceGetFollowerCountResult struct {
+	Success *GetFollowerCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowerCountResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceUnfollowResult() *FollowServiceUnfollowResult {
-	return &FollowServiceUnfollowResult{}
+func NewFollowServiceGetFollowerCountResult() *FollowServiceGetFollowerCountResult {
+	return &FollowServiceGetFollowerCountResult{}
 }
 
-func (p *FollowServiceUnfollowResult) InitDefault() {
+func (p *FollowServiceGetFollowerCountResult) InitDefault() {
 }
 
-var FollowServiceUnfollowResult_Success_DEFAULT *UnfollowResponse
+var FollowServiceGetFollowerCountResult_Success_DEFAULT *GetFollowerCountResponse
 
-func (p *FollowServiceUnfollowResult) GetSuccess() (v *UnfollowResponse) {
+func (p *FollowServiceGetFollowerCountResult) GetSuccess() (v *GetFollowerCountResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceUnfollowResult_Success_DEFAULT
+		return FollowServiceGetFollowerCountResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceUnfollowResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UnfollowResponse)
+func (p *FollowServiceGetFollowerCountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetFollowerCountResponse)
 }
 
-var fieldIDToName_FollowServiceUnfollowResult = map[int16]string{
+var fieldIDToName_FollowServiceGetFollowerCountResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceUnfollowResult) IsSetSuccess() bool {
+func (p *FollowServiceGetFollowerCountResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceUnfollowResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6016,7 +9859,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnfollowResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerCountResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6026,8 +9869,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUnfollowResponse()
+func (p *FollowServiceGetFollowerCountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetFollowerCountResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6035,10 +9878,10 @@ func (p *FollowServiceUnfollowResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FollowServiceUnfollowResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Unfollow_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowerCount_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6064,7 +9907,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetFollowerCountResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6083,15 +9926,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceUnfollowResult) String() string {
+func (p *FollowServiceGetFollowerCountResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceUnfollowResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetFollowerCountResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceUnfollowResult) DeepEqual(ano *FollowServiceUnfollowResult) bool {
+func (p *FollowServiceGetFollowerCountResult) DeepEqual(ano *FollowServiceGetFollowerCountResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6103,7 +9946,7 @@ func (p *FollowServiceUnfollowResult) DeepEqual(ano *FollowServiceUnfollowResult
 	return true
 }
 
-func (p *FollowServiceUnfollowResult) Field0DeepEqual(src *UnfollowResponse) bool {
+func (p *FollowServiceGetFollowerCountResult) Field0DeepEqual(src *GetFollowerCountResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6111,38 +9954,38 @@ func (p *FollowServiceUnfollowResult) Field0DeepEqual(src *UnfollowResponse) boo
 	return true
 }
 
-type FollowServiceIsFollowingArgs struct {
-	Req *FollowRequest `thrift:"req,1" frugal:"1,default,FollowRequest" json:"req"`
+type FollowServiceGetMutualFollowsArgs struct {
+	Req *GetMutualFollowsRequest `thrift:"req,1" frugal:"1,default,GetMutualFollowsRequest" json:"req"`
 }
 
-func NewFollowServiceIsFollowingArgs() *FollowServiceIsFollowingArgs {
-	return &FollowServiceIsFollowingArgs{}
+func NewFollowServiceGetMutualFollowsArgs() *FollowServiceGetMutualFollowsArgs {
+	return &FollowServiceGetMutualFollowsArgs{}
 }
 
-func (p *FollowServiceIsFollowingArgs) InitDefault() {
+func (p *FollowServiceGetMutualFollowsArgs) InitDefault() {
 }
 
-var FollowServiceIsFollowingArgs_Req_DEFAULT *FollowRequest
+var FollowServiceGetMutualFollowsArgs_Req_DEFAULT *GetMutualFollowsRequest
 
-func (p *FollowServiceIsFollowingArgs) GetReq() (v *FollowRequest) {
+func (p *FollowServiceGetMutualFollowsArgs) GetReq() (v *GetMutualFollowsRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceIsFollowingArgs_Req_DEFAULT
+		return FollowServiceGetMutualFollowsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceIsFollowingArgs) SetReq(val *FollowRequest) {
+func (p *FollowServiceGetMutualFollowsArgs) SetReq(val *GetMutualFollowsRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceIsFollowingArgs = map[int16]string{
+var fieldIDToName_FollowServiceGetMutualFollowsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceIsFollowingArgs) IsSetReq() bool {
+func (p *FollowServiceGetMutualFollowsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceIsFollowingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6188,7 +10031,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceIsFollowingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMutualFollowsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6198,8 +10041,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewFollowRequest()
+func (p *FollowServiceGetMutualFollowsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetMutualFollowsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6207,10 +10050,10 @@ func (p *FollowServiceIsFollowingArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *FollowServiceIsFollowingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IsFollowing_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetMutualFollows_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6236,7 +10079,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6253,15 +10096,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingArgs) String() string {
+func (p *FollowServiceGetMutualFollowsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceIsFollowingArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetMutualFollowsArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceIsFollowingArgs) DeepEqual(ano *FollowServiceIsFollowingArgs) bool {
+func (p *FollowServiceGetMutualFollowsArgs) DeepEqual(ano *FollowServiceGetMutualFollowsArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6273,7 +10116,7 @@ func (p *FollowServiceIsFollowingArgs) DeepEqual(ano *FollowServiceIsFollowingAr
 	return true
 }
 
-func (p *FollowServiceIsFollowingArgs) Field1DeepEqual(src *FollowRequest) bool {
+func (p *FollowServiceGetMutualFollowsArgs) Field1DeepEqual(src *GetMutualFollowsRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6281,38 +10124,38 @@ func (p *FollowServiceIsFollowingArgs) Field1DeepEqual(src *FollowRequest) bool
 	return true
 }
 
-type FollowServiceIsFollowingResult struct {
-	Success *FollowResponse `thrift:"success,0,optional" frugal:"0,optional,FollowResponse" json:"success,omitempty"`
+type FollowServiceGetMutualFollowsResult struct {
+	Success *GetMutualFollowsResponse `thrift:"success,0,optional" frugal:"0,optional,GetMutualFollowsResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceIsFollowingResult() *FollowServiceIsFollowingResult {
-	return &FollowServiceIsFollowingResult{}
+func NewFollowServiceGetMutualFollowsResult() *FollowServiceGetMutualFollowsResult {
+	return &FollowServiceGetMutualFollowsResult{}
 }
 
-func (p *FollowServiceIsFollowingResult) InitDefault() {
+func (p *FollowServiceGetMutualFollowsResult) InitDefault() {
 }
 
-var FollowServiceIsFollowingResult_Success_DEFAULT *FollowResponse
+var FollowServiceGetMutualFollowsResult_Success_DEFAULT *GetMutualFollowsResponse
 
-func (p *FollowServiceIsFollowingResult) GetSuccess() (v *FollowResponse) {
+func (p *FollowServiceGetMutualFollowsResult) GetSuccess() (v *GetMutualFollowsResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceIsFollowingResult_Success_DEFAULT
+		return FollowServiceGetMutualFollowsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceIsFollowingResult) SetSuccess(x interface{}) {
-	p.Success = x.(*FollowResponse)
+func (p *FollowServiceGetMutualFollowsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetMutualFollowsResponse)
 }
 
-var fieldIDToName_FollowServiceIsFollowingResult = map[int16]string{
+var fieldIDToName_FollowServiceGetMutualFollowsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceIsFollowingResult) IsSetSuccess() bool {
+func (p *FollowServiceGetMutualFollowsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceIsFollowingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6358,7 +10201,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceIsFollowingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMutualFollowsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6368,8 +10211,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewFollowResponse()
+func (p *FollowServiceGetMutualFollowsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetMutualFollowsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6377,10 +10220,10 @@ func (p *FollowServiceIsFollowingResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *FollowServiceIsFollowingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("IsFollowing_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetMutualFollows_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6406,7 +10249,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMutualFollowsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6425,15 +10268,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceIsFollowingResult) String() string {
+func (p *FollowServiceGetMutualFollowsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceIsFollowingResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetMutualFollowsResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceIsFollowingResult) DeepEqual(ano *FollowServiceIsFollowingResult) bool {
+func (p *FollowServiceGetMutualFollowsResult) DeepEqual(ano *FollowServiceGetMutualFollowsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6445,7 +10288,7 @@ func (p *FollowServiceIsFollowingResult) DeepEqual(ano *FollowServiceIsFollowing
 	return true
 }
 
-func (p *FollowServiceIsFollowingResult) Field0DeepEqual(src *FollowResponse) bool {
+func (p *FollowServiceGetMutualFollowsResult) Field0DeepEqual(src *GetMutualFollowsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6453,38 +10296,38 @@ func (p *FollowServiceIsFollowingResult) Field0DeepEqual(src *FollowResponse) bo
 	return true
 }
 
-type FollowServiceGetFollowListArgs struct {
-	Req *GetFollowListRequest `thrift:"req,1" frugal:"1,default,GetFollowListRequest" json:"req"`
+type FollowServiceBlockArgs struct {
+	Req *RelationRequest `thrift:"req,1" frugal:"1,default,RelationRequest" json:"req"`
 }
 
-func NewFollowServiceGetFollowListArgs() *FollowServiceGetFollowListArgs {
-	return &FollowServiceGetFollowListArgs{}
+func NewFollowServiceBlockArgs() *FollowServiceBlockArgs {
+	return &FollowServiceBlockArgs{}
 }
 
-func (p *FollowServiceGetFollowListArgs) InitDefault() {
+func (p *FollowServiceBlockArgs) InitDefault() {
 }
 
-var FollowServiceGetFollowListArgs_Req_DEFAULT *GetFollowListRequest
+var FollowServiceBlockArgs_Req_DEFAULT *RelationRequest
 
-func (p *FollowServiceGetFollowListArgs) GetReq() (v *GetFollowListRequest) {
+func (p *FollowServiceBlockArgs) GetReq() (v *RelationRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceGetFollowListArgs_Req_DEFAULT
+		return FollowServiceBlockArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceGetFollowListArgs) SetReq(val *GetFollowListRequest) {
+func (p *FollowServiceBlockArgs) SetReq(val *RelationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceGetFollowListArgs = map[int16]string{
+var fieldIDToName_FollowServiceBlockArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceGetFollowListArgs) IsSetReq() bool {
+func (p *FollowServiceBlockArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceGetFollowListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6530,7 +10373,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceBlockArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6539,9 +10382,9 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-
-func (p *FollowServiceGetFollowListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetFollowListRequest()
+
+func (p *FollowServiceBlockArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6549,10 +10392,10 @@ func (p *FollowServiceGetFollowListArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *FollowServiceGetFollowListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowList_args"); err != nil {
+	if err = oprot.WriteStructBegin("Block_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6578,7 +10421,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6595,15 +10438,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowListArgs) String() string {
+func (p *FollowServiceBlockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowListArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceBlockArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowListArgs) DeepEqual(ano *FollowServiceGetFollowListArgs) bool {
+func (p *FollowServiceBlockArgs) DeepEqual(ano *FollowServiceBlockArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6615,7 +10458,7 @@ func (p *FollowServiceGetFollowListArgs) DeepEqual(ano *FollowServiceGetFollowLi
 	return true
 }
 
-func (p *FollowServiceGetFollowListArgs) Field1DeepEqual(src *GetFollowListRequest) bool {
+func (p *FollowServiceBlockArgs) Field1DeepEqual(src *RelationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6623,38 +10466,38 @@ func (p *FollowServiceGetFollowListArgs) Field1DeepEqual(src *GetFollowListReque
 	return true
 }
 
-type FollowServiceGetFollowListResult struct {
-	Success *GetFollowListResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowListResponse" json:"success,omitempty"`
+type FollowServiceBlockResult struct {
+	Success *RelationResponse `thrift:"success,0,optional" frugal:"0,optional,RelationResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceGetFollowListResult() *FollowServiceGetFollowListResult {
-	return &FollowServiceGetFollowListResult{}
+func NewFollowServiceBlockResult() *FollowServiceBlockResult {
+	return &FollowServiceBlockResult{}
 }
 
-func (p *FollowServiceGetFollowListResult) InitDefault() {
+func (p *FollowServiceBlockResult) InitDefault() {
 }
 
-var FollowServiceGetFollowListResult_Success_DEFAULT *GetFollowListResponse
+var FollowServiceBlockResult_Success_DEFAULT *RelationResponse
 
-func (p *FollowServiceGetFollowListResult) GetSuccess() (v *GetFollowListResponse) {
+func (p *FollowServiceBlockResult) GetSuccess() (v *RelationResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceGetFollowListResult_Success_DEFAULT
+		return FollowServiceBlockResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceGetFollowListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFollowListResponse)
+func (p *FollowServiceBlockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationResponse)
 }
 
-var fieldIDToName_FollowServiceGetFollowListResult = map[int16]string{
+var fieldIDToName_FollowServiceBlockResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceGetFollowListResult) IsSetSuccess() bool {
+func (p *FollowServiceBlockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceGetFollowListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6700,7 +10543,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceBlockResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6710,8 +10553,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetFollowListResponse()
+func (p *FollowServiceBlockResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6719,10 +10562,10 @@ func (p *FollowServiceGetFollowListResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *FollowServiceGetFollowListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowList_result"); err != nil {
+	if err = oprot.WriteStructBegin("Block_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6748,7 +10591,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceBlockResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6767,15 +10610,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowListResult) String() string {
+func (p *FollowServiceBlockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowListResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceBlockResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowListResult) DeepEqual(ano *FollowServiceGetFollowListResult) bool {
+func (p *FollowServiceBlockResult) DeepEqual(ano *FollowServiceBlockResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6787,7 +10630,7 @@ func (p *FollowServiceGetFollowListResult) DeepEqual(ano *FollowServiceGetFollow
 	return true
 }
 
-func (p *FollowServiceGetFollowListResult) Field0DeepEqual(src *GetFollowListResponse) bool {
+func (p *FollowServiceBlockResult) Field0DeepEqual(src *RelationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -6795,38 +10638,38 @@ func (p *FollowServiceGetFollowListResult) Field0DeepEqual(src *GetFollowListRes
 	return true
 }
 
-type FollowServiceGetFollowerListArgs struct {
-	Req *GetFollowerListRequest `thrift:"req,1" frugal:"1,default,GetFollowerListRequest" json:"req"`
+type FollowServiceUnblockArgs struct {
+	Req *RelationRequest `thrift:"req,1" frugal:"1,default,RelationRequest" json:"req"`
 }
 
-func NewFollowServiceGetFollowerListArgs() *FollowServiceGetFollowerListArgs {
-	return &FollowServiceGetFollowerListArgs{}
+func NewFollowServiceUnblockArgs() *FollowServiceUnblockArgs {
+	return &FollowServiceUnblockArgs{}
 }
 
-func (p *FollowServiceGetFollowerListArgs) InitDefault() {
+func (p *FollowServiceUnblockArgs) InitDefault() {
 }
 
-var FollowServiceGetFollowerListArgs_Req_DEFAULT *GetFollowerListRequest
+var FollowServiceUnblockArgs_Req_DEFAULT *RelationRequest
 
-func (p *FollowServiceGetFollowerListArgs) GetReq() (v *GetFollowerListRequest) {
+func (p *FollowServiceUnblockArgs) GetReq() (v *RelationRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceGetFollowerListArgs_Req_DEFAULT
+		return FollowServiceUnblockArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceGetFollowerListArgs) SetReq(val *GetFollowerListRequest) {
+func (p *FollowServiceUnblockArgs) SetReq(val *RelationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceGetFollowerListArgs = map[int16]string{
+var fieldIDToName_FollowServiceUnblockArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceGetFollowerListArgs) IsSetReq() bool {
+func (p *FollowServiceUnblockArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceGetFollowerListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6872,7 +10715,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnblockArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6882,8 +10725,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetFollowerListRequest()
+func (p *FollowServiceUnblockArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6891,10 +10734,10 @@ func (p *FollowServiceGetFollowerListArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *FollowServiceGetFollowerListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowerList_args"); err != nil {
+	if err = oprot.WriteStructBegin("Unblock_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6920,7 +10763,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6937,15 +10780,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListArgs) String() string {
+func (p *FollowServiceUnblockArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowerListArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceUnblockArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowerListArgs) DeepEqual(ano *FollowServiceGetFollowerListArgs) bool {
+func (p *FollowServiceUnblockArgs) DeepEqual(ano *FollowServiceUnblockArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -6957,7 +10800,7 @@ func (p *FollowServiceGetFollowerListArgs) DeepEqual(ano *FollowServiceGetFollow
 	return true
 }
 
-func (p *FollowServiceGetFollowerListArgs) Field1DeepEqual(src *GetFollowerListRequest) bool {
+func (p *FollowServiceUnblockArgs) Field1DeepEqual(src *RelationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -6965,38 +10808,38 @@ func (p *FollowServiceGetFollowerListArgs) Field1DeepEqual(src *GetFollowerListR
 	return true
 }
 
-type FollowServiceGetFollowerListResult struct {
-	Success *GetFollowerListResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowerListResponse" json:"success,omitempty"`
+type FollowServiceUnblockResult struct {
+	Success *RelationResponse `thrift:"success,0,optional" frugal:"0,optional,RelationResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceGetFollowerListResult() *FollowServiceGetFollowerListResult {
-	return &FollowServiceGetFollowerListResult{}
+func NewFollowServiceUnblockResult() *FollowServiceUnblockResult {
+	return &FollowServiceUnblockResult{}
 }
 
-func (p *FollowServiceGetFollowerListResult) InitDefault() {
+func (p *FollowServiceUnblockResult) InitDefault() {
 }
 
-var FollowServiceGetFollowerListResult_Success_DEFAULT *GetFollowerListResponse
+var FollowServiceUnblockResult_Success_DEFAULT *RelationResponse
 
-func (p *FollowServiceGetFollowerListResult) GetSuccess() (v *GetFollowerListResponse) {
+func (p *FollowServiceUnblockResult) GetSuccess() (v *RelationResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceGetFollowerListResult_Success_DEFAULT
+		return FollowServiceUnblockResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceGetFollowerListResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFollowerListResponse)
+func (p *FollowServiceUnblockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationResponse)
 }
 
-var fieldIDToName_FollowServiceGetFollowerListResult = map[int16]string{
+var fieldIDToName_FollowServiceUnblockResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceGetFollowerListResult) IsSetSuccess() bool {
+func (p *FollowServiceUnblockResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceGetFollowerListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7042,7 +10885,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnblockResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7052,8 +10895,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetFollowerListResponse()
+func (p *FollowServiceUnblockResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7061,10 +10904,10 @@ func (p *FollowServiceGetFollowerListResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *FollowServiceGetFollowerListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowerList_result"); err != nil {
+	if err = oprot.WriteStructBegin("Unblock_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7090,7 +10933,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnblockResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7109,15 +10952,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerListResult) String() string {
+func (p *FollowServiceUnblockResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowerListResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceUnblockResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowerListResult) DeepEqual(ano *FollowServiceGetFollowerListResult) bool {
+func (p *FollowServiceUnblockResult) DeepEqual(ano *FollowServiceUnblockResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7129,7 +10972,7 @@ func (p *FollowServiceGetFollowerListResult) DeepEqual(ano *FollowServiceGetFoll
 	return true
 }
 
-func (p *FollowServiceGetFollowerListResult) Field0DeepEqual(src *GetFollowerListResponse) bool {
+func (p *FollowServiceUnblockResult) Field0DeepEqual(src *RelationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7137,38 +10980,38 @@ func (p *FollowServiceGetFollowerListResult) Field0DeepEqual(src *GetFollowerLis
 	return true
 }
 
-type FollowServiceCheckFollowStatusArgs struct {
-	Req *CheckFollowStatusRequest `thrift:"req,1" frugal:"1,default,CheckFollowStatusRequest" json:"req"`
+type FollowServiceGetBlockListArgs struct {
+	Req *GetRelationListRequest `thrift:"req,1" frugal:"1,default,GetRelationListRequest" json:"req"`
 }
 
-func NewFollowServiceCheckFollowStatusArgs() *FollowServiceCheckFollowStatusArgs {
-	return &FollowServiceCheckFollowStatusArgs{}
+func NewFollowServiceGetBlockListArgs() *FollowServiceGetBlockListArgs {
+	return &FollowServiceGetBlockListArgs{}
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) InitDefault() {
+func (p *FollowServiceGetBlockListArgs) InitDefault() {
 }
 
-var FollowServiceCheckFollowStatusArgs_Req_DEFAULT *CheckFollowStatusRequest
+var FollowServiceGetBlockListArgs_Req_DEFAULT *GetRelationListRequest
 
-func (p *FollowServiceCheckFollowStatusArgs) GetReq() (v *CheckFollowStatusRequest) {
+func (p *FollowServiceGetBlockListArgs) GetReq() (v *GetRelationListRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceCheckFollowStatusArgs_Req_DEFAULT
+		return FollowServiceGetBlockListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceCheckFollowStatusArgs) SetReq(val *CheckFollowStatusRequest) {
+func (p *FollowServiceGetBlockListArgs) SetReq(val *GetRelationListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceCheckFollowStatusArgs = map[int16]string{
+var fieldIDToName_FollowServiceGetBlockListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) IsSetReq() bool {
+func (p *FollowServiceGetBlockListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7214,7 +11057,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceCheckFollowStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetBlockListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7224,8 +11067,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCheckFollowStatusRequest()
+func (p *FollowServiceGetBlockListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRelationListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7233,10 +11076,10 @@ func (p *FollowServiceCheckFollowStatusArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckFollowStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetBlockList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7262,7 +11105,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7279,15 +11122,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) String() string {
+func (p *FollowServiceGetBlockListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceCheckFollowStatusArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetBlockListArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) DeepEqual(ano *FollowServiceCheckFollowStatusArgs) bool {
+func (p *FollowServiceGetBlockListArgs) DeepEqual(ano *FollowServiceGetBlockListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7299,7 +11142,7 @@ func (p *FollowServiceCheckFollowStatusArgs) DeepEqual(ano *FollowServiceCheckFo
 	return true
 }
 
-func (p *FollowServiceCheckFollowStatusArgs) Field1DeepEqual(src *CheckFollowStatusRequest) bool {
+func (p *FollowServiceGetBlockListArgs) Field1DeepEqual(src *GetRelationListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7307,38 +11150,38 @@ func (p *FollowServiceCheckFollowStatusArgs) Field1DeepEqual(src *CheckFollowSta
 	return true
 }
 
-type FollowServiceCheckFollowStatusResult struct {
-	Success *CheckFollowStatusResponse `thrift:"success,0,optional" frugal:"0,optional,CheckFollowStatusResponse" json:"success,omitempty"`
+type FollowServiceGetBlockListResult struct {
+	Success *GetRelationListResponse `thrift:"success,0,optional" frugal:"0,optional,GetRelationListResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceCheckFollowStatusResult() *FollowServiceCheckFollowStatusResult {
-	return &FollowServiceCheckFollowStatusResult{}
+func NewFollowServiceGetBlockListResult() *FollowServiceGetBlockListResult {
+	return &FollowServiceGetBlockListResult{}
 }
 
-func (p *FollowServiceCheckFollowStatusResult) InitDefault() {
+func (p *FollowServiceGetBlockListResult) InitDefault() {
 }
 
-var FollowServiceCheckFollowStatusResult_Success_DEFAULT *CheckFollowStatusResponse
+var FollowServiceGetBlockListResult_Success_DEFAULT *GetRelationListResponse
 
-func (p *FollowServiceCheckFollowStatusResult) GetSuccess() (v *CheckFollowStatusResponse) {
+func (p *FollowServiceGetBlockListResult) GetSuccess() (v *GetRelationListResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceCheckFollowStatusResult_Success_DEFAULT
+		return FollowServiceGetBlockListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceCheckFollowStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CheckFollowStatusResponse)
+func (p *FollowServiceGetBlockListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetRelationListResponse)
 }
 
-var fieldIDToName_FollowServiceCheckFollowStatusResult = map[int16]string{
+var fieldIDToName_FollowServiceGetBlockListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceCheckFollowStatusResult) IsSetSuccess() bool {
+func (p *FollowServiceGetBlockListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceCheckFollowStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7384,7 +11227,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceCheckFollowStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetBlockListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7394,8 +11237,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewCheckFollowStatusResponse()
+func (p *FollowServiceGetBlockListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRelationListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7403,10 +11246,10 @@ func (p *FollowServiceCheckFollowStatusResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *FollowServiceCheckFollowStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CheckFollowStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetBlockList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7432,7 +11275,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetBlockListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7451,15 +11294,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceCheckFollowStatusResult) String() string {
+func (p *FollowServiceGetBlockListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceCheckFollowStatusResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetBlockListResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceCheckFollowStatusResult) DeepEqual(ano *FollowServiceCheckFollowStatusResult) bool {
+func (p *FollowServiceGetBlockListResult) DeepEqual(ano *FollowServiceGetBlockListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7471,7 +11314,7 @@ func (p *FollowServiceCheckFollowStatusResult) DeepEqual(ano *FollowServiceCheck
 	return true
 }
 
-func (p *FollowServiceCheckFollowStatusResult) Field0DeepEqual(src *CheckFollowStatusResponse) bool {
+func (p *FollowServiceGetBlockListResult) Field0DeepEqual(src *GetRelationListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7479,38 +11322,38 @@ func (p *FollowServiceCheckFollowStatusResult) Field0DeepEqual(src *CheckFollowS
 	return true
 }
 
-type FollowServiceGetFollowCountArgs struct {
-	Req *GetFollowCountRequest `thrift:"req,1" frugal:"1,default,GetFollowCountRequest" json:"req"`
+type FollowServiceMuteArgs struct {
+	Req *RelationRequest `thrift:"req,1" frugal:"1,default,RelationRequest" json:"req"`
 }
 
-func NewFollowServiceGetFollowCountArgs() *FollowServiceGetFollowCountArgs {
-	return &FollowServiceGetFollowCountArgs{}
+func NewFollowServiceMuteArgs() *FollowServiceMuteArgs {
+	return &FollowServiceMuteArgs{}
 }
 
-func (p *FollowServiceGetFollowCountArgs) InitDefault() {
+func (p *FollowServiceMuteArgs) InitDefault() {
 }
 
-var FollowServiceGetFollowCountArgs_Req_DEFAULT *GetFollowCountRequest
+var FollowServiceMuteArgs_Req_DEFAULT *RelationRequest
 
-func (p *FollowServiceGetFollowCountArgs) GetReq() (v *GetFollowCountRequest) {
+func (p *FollowServiceMuteArgs) GetReq() (v *RelationRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceGetFollowCountArgs_Req_DEFAULT
+		return FollowServiceMuteArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceGetFollowCountArgs) SetReq(val *GetFollowCountRequest) {
+func (p *FollowServiceMuteArgs) SetReq(val *RelationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceGetFollowCountArgs = map[int16]string{
+var fieldIDToName_FollowServiceMuteArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceGetFollowCountArgs) IsSetReq() bool {
+func (p *FollowServiceMuteArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceGetFollowCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7556,7 +11399,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceMuteArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7566,8 +11409,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetFollowCountRequest()
+func (p *FollowServiceMuteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7575,10 +11418,10 @@ func (p *FollowServiceGetFollowCountArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *FollowServiceGetFollowCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("Mute_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7604,7 +11447,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7621,15 +11464,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountArgs) String() string {
+func (p *FollowServiceMuteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowCountArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceMuteArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowCountArgs) DeepEqual(ano *FollowServiceGetFollowCountArgs) bool {
+func (p *FollowServiceMuteArgs) DeepEqual(ano *FollowServiceMuteArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7641,7 +11484,7 @@ func (p *FollowServiceGetFollowCountArgs) DeepEqual(ano *FollowServiceGetFollowC
 	return true
 }
 
-func (p *FollowServiceGetFollowCountArgs) Field1DeepEqual(src *GetFollowCountRequest) bool {
+func (p *FollowServiceMuteArgs) Field1DeepEqual(src *RelationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7649,38 +11492,38 @@ func (p *FollowServiceGetFollowCountArgs) Field1DeepEqual(src *GetFollowCountReq
 	return true
 }
 
-type FollowServiceGetFollowCountResult struct {
-	Success *GetFollowCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowCountResponse" json:"success,omitempty"`
+type FollowServiceMuteResult struct {
+	Success *RelationResponse `thrift:"success,0,optional" frugal:"0,optional,RelationResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceGetFollowCountResult() *FollowServiceGetFollowCountResult {
-	return &FollowServiceGetFollowCountResult{}
+func NewFollowServiceMuteResult() *FollowServiceMuteResult {
+	return &FollowServiceMuteResult{}
 }
 
-func (p *FollowServiceGetFollowCountResult) InitDefault() {
+func (p *FollowServiceMuteResult) InitDefault() {
 }
 
-var FollowServiceGetFollowCountResult_Success_DEFAULT *GetFollowCountResponse
+var FollowServiceMuteResult_Success_DEFAULT *RelationResponse
 
-func (p *FollowServiceGetFollowCountResult) GetSuccess() (v *GetFollowCountResponse) {
+func (p *FollowServiceMuteResult) GetSuccess() (v *RelationResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceGetFollowCountResult_Success_DEFAULT
+		return FollowServiceMuteResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceGetFollowCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFollowCountResponse)
+func (p *FollowServiceMuteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationResponse)
 }
 
-var fieldIDToName_FollowServiceGetFollowCountResult = map[int16]string{
+var fieldIDToName_FollowServiceMuteResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceGetFollowCountResult) IsSetSuccess() bool {
+func (p *FollowServiceMuteResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceGetFollowCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7726,7 +11569,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceMuteResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7736,8 +11579,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetFollowCountResponse()
+func (p *FollowServiceMuteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7745,10 +11588,10 @@ func (p *FollowServiceGetFollowCountResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *FollowServiceGetFollowCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("Mute_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7774,7 +11617,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceMuteResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7793,15 +11636,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowCountResult) String() string {
+func (p *FollowServiceMuteResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowCountResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceMuteResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowCountResult) DeepEqual(ano *FollowServiceGetFollowCountResult) bool {
+func (p *FollowServiceMuteResult) DeepEqual(ano *FollowServiceMuteResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7813,7 +11656,7 @@ func (p *FollowServiceGetFollowCountResult) DeepEqual(ano *FollowServiceGetFollo
 	return true
 }
 
-func (p *FollowServiceGetFollowCountResult) Field0DeepEqual(src *GetFollowCountResponse) bool {
+func (p *FollowServiceMuteResult) Field0DeepEqual(src *RelationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -7821,38 +11664,38 @@ func (p *FollowServiceGetFollowCountResult) Field0DeepEqual(src *GetFollowCountR
 	return true
 }
 
-type FollowServiceGetFollowerCountArgs struct {
-	Req *GetFollowerCountRequest `thrift:"req,1" frugal:"1,default,GetFollowerCountRequest" json:"req"`
+type FollowServiceUnmuteArgs struct {
+	Req *RelationRequest `thrift:"req,1" frugal:"1,default,RelationRequest" json:"req"`
 }
 
-func NewFollowServiceGetFollowerCountArgs() *FollowServiceGetFollowerCountArgs {
-	return &FollowServiceGetFollowerCountArgs{}
+func NewFollowServiceUnmuteArgs() *FollowServiceUnmuteArgs {
+	return &FollowServiceUnmuteArgs{}
 }
 
-func (p *FollowServiceGetFollowerCountArgs) InitDefault() {
+func (p *FollowServiceUnmuteArgs) InitDefault() {
 }
 
-var FollowServiceGetFollowerCountArgs_Req_DEFAULT *GetFollowerCountRequest
+var FollowServiceUnmuteArgs_Req_DEFAULT *RelationRequest
 
-func (p *FollowServiceGetFollowerCountArgs) GetReq() (v *GetFollowerCountRequest) {
+func (p *FollowServiceUnmuteArgs) GetReq() (v *RelationRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceGetFollowerCountArgs_Req_DEFAULT
+		return FollowServiceUnmuteArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceGetFollowerCountArgs) SetReq(val *GetFollowerCountRequest) {
+func (p *FollowServiceUnmuteArgs) SetReq(val *RelationRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceGetFollowerCountArgs = map[int16]string{
+var fieldIDToName_FollowServiceUnmuteArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceGetFollowerCountArgs) IsSetReq() bool {
+func (p *FollowServiceUnmuteArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceGetFollowerCountArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7898,7 +11741,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerCountArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnmuteArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7908,8 +11751,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetFollowerCountRequest()
+func (p *FollowServiceUnmuteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRelationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7917,10 +11760,10 @@ func (p *FollowServiceGetFollowerCountArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *FollowServiceGetFollowerCountArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowerCount_args"); err != nil {
+	if err = oprot.WriteStructBegin("Unmute_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7946,7 +11789,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7963,15 +11806,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountArgs) String() string {
+func (p *FollowServiceUnmuteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowerCountArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceUnmuteArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowerCountArgs) DeepEqual(ano *FollowServiceGetFollowerCountArgs) bool {
+func (p *FollowServiceUnmuteArgs) DeepEqual(ano *FollowServiceUnmuteArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -7983,7 +11826,7 @@ func (p *FollowServiceGetFollowerCountArgs) DeepEqual(ano *FollowServiceGetFollo
 	return true
 }
 
-func (p *FollowServiceGetFollowerCountArgs) Field1DeepEqual(src *GetFollowerCountRequest) bool {
+func (p *FollowServiceUnmuteArgs) Field1DeepEqual(src *RelationRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -7991,38 +11834,38 @@ func (p *FollowServiceGetFollowerCountArgs) Field1DeepEqual(src *GetFollowerCoun
 	return true
 }
 
-type FollowServiceGetFollowerCountResult struct {
-	Success *GetFollowerCountResponse `thrift:"success,0,optional" frugal:"0,optional,GetFollowerCountResponse" json:"success,omitempty"`
+type FollowServiceUnmuteResult struct {
+	Success *RelationResponse `thrift:"success,0,optional" frugal:"0,optional,RelationResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceGetFollowerCountResult() *FollowServiceGetFollowerCountResult {
-	return &FollowServiceGetFollowerCountResult{}
+func NewFollowServiceUnmuteResult() *FollowServiceUnmuteResult {
+	return &FollowServiceUnmuteResult{}
 }
 
-func (p *FollowServiceGetFollowerCountResult) InitDefault() {
+func (p *FollowServiceUnmuteResult) InitDefault() {
 }
 
-var FollowServiceGetFollowerCountResult_Success_DEFAULT *GetFollowerCountResponse
+var FollowServiceUnmuteResult_Success_DEFAULT *RelationResponse
 
-func (p *FollowServiceGetFollowerCountResult) GetSuccess() (v *GetFollowerCountResponse) {
+func (p *FollowServiceUnmuteResult) GetSuccess() (v *RelationResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceGetFollowerCountResult_Success_DEFAULT
+		return FollowServiceUnmuteResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceGetFollowerCountResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetFollowerCountResponse)
+func (p *FollowServiceUnmuteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelationResponse)
 }
 
-var fieldIDToName_FollowServiceGetFollowerCountResult = map[int16]string{
+var fieldIDToName_FollowServiceUnmuteResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceGetFollowerCountResult) IsSetSuccess() bool {
+func (p *FollowServiceUnmuteResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceGetFollowerCountResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8068,7 +11911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetFollowerCountResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceUnmuteResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8078,8 +11921,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetFollowerCountResponse()
+func (p *FollowServiceUnmuteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRelationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8087,10 +11930,10 @@ func (p *FollowServiceGetFollowerCountResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *FollowServiceGetFollowerCountResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowerCount_result"); err != nil {
+	if err = oprot.WriteStructBegin("Unmute_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8116,7 +11959,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceUnmuteResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8135,15 +11978,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceGetFollowerCountResult) String() string {
+func (p *FollowServiceUnmuteResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetFollowerCountResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceUnmuteResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetFollowerCountResult) DeepEqual(ano *FollowServiceGetFollowerCountResult) bool {
+func (p *FollowServiceUnmuteResult) DeepEqual(ano *FollowServiceUnmuteResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8155,7 +11998,7 @@ func (p *FollowServiceGetFollowerCountResult) DeepEqual(ano *FollowServiceGetFol
 	return true
 }
 
-func (p *FollowServiceGetFollowerCountResult) Field0DeepEqual(src *GetFollowerCountResponse) bool {
+func (p *FollowServiceUnmuteResult) Field0DeepEqual(src *RelationResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -8163,38 +12006,38 @@ func (p *FollowServiceGetFollowerCountResult) Field0DeepEqual(src *GetFollowerCo
 	return true
 }
 
-type FollowServiceGetMutualFollowsArgs struct {
-	Req *GetMutualFollowsRequest `thrift:"req,1" frugal:"1,default,GetMutualFollowsRequest" json:"req"`
+type FollowServiceGetMuteListArgs struct {
+	Req *GetRelationListRequest `thrift:"req,1" frugal:"1,default,GetRelationListRequest" json:"req"`
 }
 
-func NewFollowServiceGetMutualFollowsArgs() *FollowServiceGetMutualFollowsArgs {
-	return &FollowServiceGetMutualFollowsArgs{}
+func NewFollowServiceGetMuteListArgs() *FollowServiceGetMuteListArgs {
+	return &FollowServiceGetMuteListArgs{}
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) InitDefault() {
+func (p *FollowServiceGetMuteListArgs) InitDefault() {
 }
 
-var FollowServiceGetMutualFollowsArgs_Req_DEFAULT *GetMutualFollowsRequest
+var FollowServiceGetMuteListArgs_Req_DEFAULT *GetRelationListRequest
 
-func (p *FollowServiceGetMutualFollowsArgs) GetReq() (v *GetMutualFollowsRequest) {
+func (p *FollowServiceGetMuteListArgs) GetReq() (v *GetRelationListRequest) {
 	if !p.IsSetReq() {
-		return FollowServiceGetMutualFollowsArgs_Req_DEFAULT
+		return FollowServiceGetMuteListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *FollowServiceGetMutualFollowsArgs) SetReq(val *GetMutualFollowsRequest) {
+func (p *FollowServiceGetMuteListArgs) SetReq(val *GetRelationListRequest) {
 	p.Req = val
 }
 
-var fieldIDToName_FollowServiceGetMutualFollowsArgs = map[int16]string{
+var fieldIDToName_FollowServiceGetMuteListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) IsSetReq() bool {
+func (p *FollowServiceGetMuteListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8240,7 +12083,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMutualFollowsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMuteListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8250,8 +12093,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetMutualFollowsRequest()
+func (p *FollowServiceGetMuteListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRelationListRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8259,10 +12102,10 @@ func (p *FollowServiceGetMutualFollowsArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetMutualFollows_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetMuteList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8288,7 +12131,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8305,15 +12148,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) String() string {
+func (p *FollowServiceGetMuteListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetMutualFollowsArgs(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetMuteListArgs(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) DeepEqual(ano *FollowServiceGetMutualFollowsArgs) bool {
+func (p *FollowServiceGetMuteListArgs) DeepEqual(ano *FollowServiceGetMuteListArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8325,7 +12168,7 @@ func (p *FollowServiceGetMutualFollowsArgs) DeepEqual(ano *FollowServiceGetMutua
 	return true
 }
 
-func (p *FollowServiceGetMutualFollowsArgs) Field1DeepEqual(src *GetMutualFollowsRequest) bool {
+func (p *FollowServiceGetMuteListArgs) Field1DeepEqual(src *GetRelationListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -8333,38 +12176,38 @@ func (p *FollowServiceGetMutualFollowsArgs) Field1DeepEqual(src *GetMutualFollow
 	return true
 }
 
-type FollowServiceGetMutualFollowsResult struct {
-	Success *GetMutualFollowsResponse `thrift:"success,0,optional" frugal:"0,optional,GetMutualFollowsResponse" json:"success,omitempty"`
+type FollowServiceGetMuteListResult struct {
+	Success *GetRelationListResponse `thrift:"success,0,optional" frugal:"0,optional,GetRelationListResponse" json:"success,omitempty"`
 }
 
-func NewFollowServiceGetMutualFollowsResult() *FollowServiceGetMutualFollowsResult {
-	return &FollowServiceGetMutualFollowsResult{}
+func NewFollowServiceGetMuteListResult() *FollowServiceGetMuteListResult {
+	return &FollowServiceGetMuteListResult{}
 }
 
-func (p *FollowServiceGetMutualFollowsResult) InitDefault() {
+func (p *FollowServiceGetMuteListResult) InitDefault() {
 }
 
-var FollowServiceGetMutualFollowsResult_Success_DEFAULT *GetMutualFollowsResponse
+var FollowServiceGetMuteListResult_Success_DEFAULT *GetRelationListResponse
 
-func (p *FollowServiceGetMutualFollowsResult) GetSuccess() (v *GetMutualFollowsResponse) {
+func (p *FollowServiceGetMuteListResult) GetSuccess() (v *GetRelationListResponse) {
 	if !p.IsSetSuccess() {
-		return FollowServiceGetMutualFollowsResult_Success_DEFAULT
+		return FollowServiceGetMuteListResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *FollowServiceGetMutualFollowsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetMutualFollowsResponse)
+func (p *FollowServiceGetMuteListResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetRelationListResponse)
 }
 
-var fieldIDToName_FollowServiceGetMutualFollowsResult = map[int16]string{
+var fieldIDToName_FollowServiceGetMuteListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *FollowServiceGetMutualFollowsResult) IsSetSuccess() bool {
+func (p *FollowServiceGetMuteListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *FollowServiceGetMutualFollowsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8410,7 +12253,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMutualFollowsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowServiceGetMuteListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8420,8 +12263,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetMutualFollowsResponse()
+func (p *FollowServiceGetMuteListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRelationListResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8429,10 +12272,10 @@ func (p *FollowServiceGetMutualFollowsResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *FollowServiceGetMutualFollowsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetMutualFollows_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetMuteList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8458,7 +12301,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *FollowServiceGetMuteListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8477,15 +12320,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *FollowServiceGetMutualFollowsResult) String() string {
+func (p *FollowServiceGetMuteListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FollowServiceGetMutualFollowsResult(%+v)", *p)
+	return fmt.Sprintf("FollowServiceGetMuteListResult(%+v)", *p)
 
 }
 
-func (p *FollowServiceGetMutualFollowsResult) DeepEqual(ano *FollowServiceGetMutualFollowsResult) bool {
+func (p *FollowServiceGetMuteListResult) DeepEqual(ano *FollowServiceGetMuteListResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -8497,7 +12340,7 @@ func (p *FollowServiceGetMutualFollowsResult) DeepEqual(ano *FollowServiceGetMut
 	return true
 }
 
-func (p *FollowServiceGetMutualFollowsResult) Field0DeepEqual(src *GetMutualFollowsResponse) bool {
+func (p *FollowServiceGetMuteListResult) Field0DeepEqual(src *GetRelationListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
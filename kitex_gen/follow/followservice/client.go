@@ -20,6 +20,12 @@ type Client interface {
 	GetFollowCount(ctx context.Context, req *follow.GetFollowCountRequest, callOptions ...callopt.Option) (r *follow.GetFollowCountResponse, err error)
 	GetFollowerCount(ctx context.Context, req *follow.GetFollowerCountRequest, callOptions ...callopt.Option) (r *follow.GetFollowerCountResponse, err error)
 	GetMutualFollows(ctx context.Context, req *follow.GetMutualFollowsRequest, callOptions ...callopt.Option) (r *follow.GetMutualFollowsResponse, err error)
+	Block(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error)
+	Unblock(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error)
+	GetBlockList(ctx context.Context, req *follow.GetRelationListRequest, callOptions ...callopt.Option) (r *follow.GetRelationListResponse, err error)
+	Mute(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error)
+	Unmute(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error)
+	GetMuteList(ctx context.Context, req *follow.GetRelationListRequest, callOptions ...callopt.Option) (r *follow.GetRelationListResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -95,3 +101,33 @@ func (p *kFollowServiceClient) GetMutualFollows(ctx context.Context, req *follow
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetMutualFollows(ctx, req)
 }
+
+func (p *kFollowServiceClient) Block(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Block(ctx, req)
+}
+
+func (p *kFollowServiceClient) Unblock(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unblock(ctx, req)
+}
+
+func (p *kFollowServiceClient) GetBlockList(ctx context.Context, req *follow.GetRelationListRequest, callOptions ...callopt.Option) (r *follow.GetRelationListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlockList(ctx, req)
+}
+
+func (p *kFollowServiceClient) Mute(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Mute(ctx, req)
+}
+
+func (p *kFollowServiceClient) Unmute(ctx context.Context, req *follow.RelationRequest, callOptions ...callopt.Option) (r *follow.RelationResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Unmute(ctx, req)
+}
+
+func (p *kFollowServiceClient) GetMuteList(ctx context.Context, req *follow.GetRelationListRequest, callOptions ...callopt.Option) (r *follow.GetRelationListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMuteList(ctx, req)
+}
@@ -76,6 +76,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"Block": kitex.NewMethodInfo(
+		blockHandler,
+		newFollowServiceBlockArgs,
+		newFollowServiceBlockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Unblock": kitex.NewMethodInfo(
+		unblockHandler,
+		newFollowServiceUnblockArgs,
+		newFollowServiceUnblockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBlockList": kitex.NewMethodInfo(
+		getBlockListHandler,
+		newFollowServiceGetBlockListArgs,
+		newFollowServiceGetBlockListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Mute": kitex.NewMethodInfo(
+		muteHandler,
+		newFollowServiceMuteArgs,
+		newFollowServiceMuteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Unmute": kitex.NewMethodInfo(
+		unmuteHandler,
+		newFollowServiceUnmuteArgs,
+		newFollowServiceUnmuteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMuteList": kitex.NewMethodInfo(
+		getMuteListHandler,
+		newFollowServiceGetMuteListArgs,
+		newFollowServiceGetMuteListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -304,6 +346,114 @@ func newFollowServiceGetMutualFollowsResult() interface{} {
 	return follow.NewFollowServiceGetMutualFollowsResult()
 }
 
+func blockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceBlockArgs)
+	realResult := result.(*follow.FollowServiceBlockResult)
+	success, err := handler.(follow.FollowService).Block(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceBlockArgs() interface{} {
+	return follow.NewFollowServiceBlockArgs()
+}
+
+func newFollowServiceBlockResult() interface{} {
+	return follow.NewFollowServiceBlockResult()
+}
+
+func unblockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceUnblockArgs)
+	realResult := result.(*follow.FollowServiceUnblockResult)
+	success, err := handler.(follow.FollowService).Unblock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceUnblockArgs() interface{} {
+	return follow.NewFollowServiceUnblockArgs()
+}
+
+func newFollowServiceUnblockResult() interface{} {
+	return follow.NewFollowServiceUnblockResult()
+}
+
+func getBlockListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceGetBlockListArgs)
+	realResult := result.(*follow.FollowServiceGetBlockListResult)
+	success, err := handler.(follow.FollowService).GetBlockList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceGetBlockListArgs() interface{} {
+	return follow.NewFollowServiceGetBlockListArgs()
+}
+
+func newFollowServiceGetBlockListResult() interface{} {
+	return follow.NewFollowServiceGetBlockListResult()
+}
+
+func muteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceMuteArgs)
+	realResult := result.(*follow.FollowServiceMuteResult)
+	success, err := handler.(follow.FollowService).Mute(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceMuteArgs() interface{} {
+	return follow.NewFollowServiceMuteArgs()
+}
+
+func newFollowServiceMuteResult() interface{} {
+	return follow.NewFollowServiceMuteResult()
+}
+
+func unmuteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceUnmuteArgs)
+	realResult := result.(*follow.FollowServiceUnmuteResult)
+	success, err := handler.(follow.FollowService).Unmute(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceUnmuteArgs() interface{} {
+	return follow.NewFollowServiceUnmuteArgs()
+}
+
+func newFollowServiceUnmuteResult() interface{} {
+	return follow.NewFollowServiceUnmuteResult()
+}
+
+func getMuteListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*follow.FollowServiceGetMuteListArgs)
+	realResult := result.(*follow.FollowServiceGetMuteListResult)
+	success, err := handler.(follow.FollowService).GetMuteList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newFollowServiceGetMuteListArgs() interface{} {
+	return follow.NewFollowServiceGetMuteListArgs()
+}
+
+func newFollowServiceGetMuteListResult() interface{} {
+	return follow.NewFollowServiceGetMuteListResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -403,3 +553,63 @@ func (p *kClient) GetMutualFollows(ctx context.Context, req *follow.GetMutualFol
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Block(ctx context.Context, req *follow.RelationRequest) (r *follow.RelationResponse, err error) {
+	var _args follow.FollowServiceBlockArgs
+	_args.Req = req
+	var _result follow.FollowServiceBlockResult
+	if err = p.c.Call(ctx, "Block", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unblock(ctx context.Context, req *follow.RelationRequest) (r *follow.RelationResponse, err error) {
+	var _args follow.FollowServiceUnblockArgs
+	_args.Req = req
+	var _result follow.FollowServiceUnblockResult
+	if err = p.c.Call(ctx, "Unblock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlockList(ctx context.Context, req *follow.GetRelationListRequest) (r *follow.GetRelationListResponse, err error) {
+	var _args follow.FollowServiceGetBlockListArgs
+	_args.Req = req
+	var _result follow.FollowServiceGetBlockListResult
+	if err = p.c.Call(ctx, "GetBlockList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Mute(ctx context.Context, req *follow.RelationRequest) (r *follow.RelationResponse, err error) {
+	var _args follow.FollowServiceMuteArgs
+	_args.Req = req
+	var _result follow.FollowServiceMuteResult
+	if err = p.c.Call(ctx, "Mute", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Unmute(ctx context.Context, req *follow.RelationRequest) (r *follow.RelationResponse, err error) {
+	var _args follow.FollowServiceUnmuteArgs
+	_args.Req = req
+	var _result follow.FollowServiceUnmuteResult
+	if err = p.c.Call(ctx, "Unmute", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMuteList(ctx context.Context, req *follow.GetRelationListRequest) (r *follow.GetRelationListResponse, err error) {
+	var _args follow.FollowServiceGetMuteListArgs
+	_args.Req = req
+	var _result follow.FollowServiceGetMuteListResult
+	if err = p.c.Call(ctx, "GetMuteList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -1754,6 +1754,20 @@ func (p *GetLikeUsersRequest) FastRead(buf []byte) (int, error) {
 					goto SkipFieldError
 				}
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				l, err = p.FastReadField5(buf[offset:])
+				offset += l
+				if err != nil {
+					goto ReadFieldError
+				}
+			} else {
+				l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
+				offset += l
+				if err != nil {
+					goto SkipFieldError
+				}
+			}
 		default:
 			l, err = thrift.Binary.Skip(buf[offset:], fieldTypeId)
 			offset += l
@@ -1828,6 +1842,20 @@ func (p *GetLikeUsersRequest) FastReadField4(buf []byte) (int, error) {
 	return offset, nil
 }
 
+func (p *GetLikeUsersRequest) FastReadField5(buf []byte) (int, error) {
+	offset := 0
+
+	var _field *string
+	if v, l, err := thrift.Binary.ReadString(buf[offset:]); err != nil {
+		return offset, err
+	} else {
+		offset += l
+		_field = &v
+	}
+	p.ViewerId = _field
+	return offset, nil
+}
+
 func (p *GetLikeUsersRequest) FastWrite(buf []byte) int {
 	return p.FastWriteNocopy(buf, nil)
 }
@@ -1839,6 +1867,7 @@ func (p *GetLikeUsersRequest) FastWriteNocopy(buf []byte, w thrift.NocopyWriter)
 		offset += p.fastWriteField4(buf[offset:], w)
 		offset += p.fastWriteField1(buf[offset:], w)
 		offset += p.fastWriteField2(buf[offset:], w)
+		offset += p.fastWriteField5(buf[offset:], w)
 	}
 	offset += thrift.Binary.WriteFieldStop(buf[offset:])
 	return offset
@@ -1851,6 +1880,7 @@ func (p *GetLikeUsersRequest) BLength() int {
 		l += p.field2Length()
 		l += p.field3Length()
 		l += p.field4Length()
+		l += p.field5Length()
 	}
 	l += thrift.Binary.FieldStopLength()
 	return l
@@ -1884,6 +1914,15 @@ func (p *GetLikeUsersRequest) fastWriteField4(buf []byte, w thrift.NocopyWriter)
 	return offset
 }
 
+func (p *GetLikeUsersRequest) fastWriteField5(buf []byte, w thrift.NocopyWriter) int {
+	offset := 0
+	if p.IsSetViewerId() {
+		offset += thrift.Binary.WriteFieldBegin(buf[offset:], thrift.STRING, 5)
+		offset += thrift.Binary.WriteStringNocopy(buf[offset:], w, *p.ViewerId)
+	}
+	return offset
+}
+
 func (p *GetLikeUsersRequest) field1Length() int {
 	l := 0
 	l += thrift.Binary.FieldBeginLength()
@@ -1912,6 +1951,15 @@ func (p *GetLikeUsersRequest) field4Length() int {
 	return l
 }
 
+func (p *GetLikeUsersRequest) field5Length() int {
+	l := 0
+	if p.IsSetViewerId() {
+		l += thrift.Binary.FieldBeginLength()
+		l += thrift.Binary.StringLengthNocopy(*p.ViewerId)
+	}
+	return l
+}
+
 func (p *LikeUser) FastRead(buf []byte) (int, error) {
 
 	var err error
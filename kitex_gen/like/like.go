@@ -2515,10 +2515,11 @@ func (p *GetLikeCountResponse) Field3DeepEqual(src int64) bool {
 }
 
 type GetLikeUsersRequest struct {
-	TargetId   string `thrift:"target_id,1" frugal:"1,default,string" json:"target_id"`
-	TargetType string `thrift:"target_type,2" frugal:"2,default,string" json:"target_type"`
-	Page       int32  `thrift:"page,3" frugal:"3,default,i32" json:"page"`
-	PageSize   int32  `thrift:"page_size,4" frugal:"4,default,i32" json:"page_size"`
+	TargetId   string  `thrift:"target_id,1" frugal:"1,default,string" json:"target_id"`
+	TargetType string  `thrift:"target_type,2" frugal:"2,default,string" json:"target_type"`
+	Page       int32   `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize   int32   `thrift:"page_size,4" frugal:"4,default,i32" json:"page_size"`
+	ViewerId   *string `thrift:"viewer_id,5,optional" frugal:"5,optional,string" json:"viewer_id,omitempty"`
 }
 
 func NewGetLikeUsersRequest() *GetLikeUsersRequest {
@@ -2543,6 +2544,15 @@ func (p *GetLikeUsersRequest) GetPage() (v int32) {
 func (p *GetLikeUsersRequest) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetLikeUsersRequest_ViewerId_DEFAULT string
+
+func (p *GetLikeUsersRequest) GetViewerId() (v string) {
+	if !p.IsSetViewerId() {
+		return GetLikeUsersRequest_ViewerId_DEFAULT
+	}
+	return *p.ViewerId
+}
 func (p *GetLikeUsersRequest) SetTargetId(val string) {
 	p.TargetId = val
 }
@@ -2555,12 +2565,20 @@ func (p *GetLikeUsersRequest) SetPage(val int32) {
 func (p *GetLikeUsersRequest) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetLikeUsersRequest) SetViewerId(val *string) {
+	p.ViewerId = val
+}
 
 var fieldIDToName_GetLikeUsersRequest = map[int16]string{
 	1: "target_id",
 	2: "target_type",
 	3: "page",
 	4: "page_size",
+	5: "viewer_id",
+}
+
+func (p *GetLikeUsersRequest) IsSetViewerId() bool {
+	return p.ViewerId != nil
 }
 
 func (p *GetLikeUsersRequest) Read(iprot thrift.TProtocol) (err error) {
@@ -2614,6 +2632,14 @@ func (p *GetLikeUsersRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2687,6 +2713,17 @@ func (p *GetLikeUsersRequest) ReadField4(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *GetLikeUsersRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ViewerId = _field
+	return nil
+}
 
 func (p *GetLikeUsersRequest) Write(oprot thrift.TProtocol) (err error) {
 
@@ -2711,6 +2748,10 @@ func (p *GetLikeUsersRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2797,6 +2838,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *GetLikeUsersRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetViewerId() {
+		if err = oprot.WriteFieldBegin("viewer_id", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ViewerId); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *GetLikeUsersRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2823,6 +2883,9 @@ func (p *GetLikeUsersRequest) DeepEqual(ano *GetLikeUsersRequest) bool {
 	if !p.Field4DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.ViewerId) {
+		return false
+	}
 	return true
 }
 
@@ -2854,6 +2917,18 @@ func (p *GetLikeUsersRequest) Field4DeepEqual(src int32) bool {
 	}
 	return true
 }
+func (p *GetLikeUsersRequest) Field5DeepEqual(src *string) bool {
+
+	if p.ViewerId == src {
+		return true
+	} else if p.ViewerId == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ViewerId, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type LikeUser struct {
 	UserId    string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
@@ -1799,9 +1799,10 @@ func (p *CreateNotificationResponse) Field3DeepEqual(src *Notification) bool {
 }
 
 type GetNotificationListRequest struct {
-	UserId   string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
-	Page     int32  `thrift:"page,2" frugal:"2,default,i32" json:"page"`
-	PageSize int32  `thrift:"page_size,3" frugal:"3,default,i32" json:"page_size"`
+	UserId   string  `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
+	Page     int32   `thrift:"page,2" frugal:"2,default,i32" json:"page"`
+	PageSize int32   `thrift:"page_size,3" frugal:"3,default,i32" json:"page_size"`
+	Cursor   *string `thrift:"cursor,4,optional" frugal:"4,optional,string" json:"cursor,omitempty"`
 }
 
 func NewGetNotificationListRequest() *GetNotificationListRequest {
@@ -1822,6 +1823,15 @@ func (p *GetNotificationListRequest) GetPage() (v int32) {
 func (p *GetNotificationListRequest) GetPageSize() (v int32) {
 	return p.PageSize
 }
+
+var GetNotificationListRequest_Cursor_DEFAULT string
+
+func (p *GetNotificationListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return GetNotificationListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *GetNotificationListRequest) SetUserId(val string) {
 	p.UserId = val
 }
@@ -1831,11 +1841,19 @@ func (p *GetNotificationListRequest) SetPage(val int32) {
 func (p *GetNotificationListRequest) SetPageSize(val int32) {
 	p.PageSize = val
 }
+func (p *GetNotificationListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
 
 var fieldIDToName_GetNotificationListRequest = map[int16]string{
 	1: "user_id",
 	2: "page",
 	3: "page_size",
+	4: "cursor",
+}
+
+func (p *GetNotificationListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
 }
 
 func (p *GetNotificationListRequest) Read(iprot thrift.TProtocol) (err error) {
@@ -1881,6 +1899,14 @@ func (p *GetNotificationListRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1943,6 +1969,17 @@ func (p *GetNotificationListRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *GetNotificationListRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Cursor = _field
+	return nil
+}
 
 func (p *GetNotificationListRequest) Write(oprot thrift.TProtocol) (err error) {
 
@@ -1963,6 +2000,10 @@ func (p *GetNotificationListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2032,6 +2073,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *GetNotificationListRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Cursor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *GetNotificationListRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2055,6 +2115,9 @@ func (p *GetNotificationListRequest) DeepEqual(ano *GetNotificationListRequest)
 	if !p.Field3DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -2079,12 +2142,26 @@ func (p *GetNotificationListRequest) Field3DeepEqual(src int32) bool {
 	}
 	return true
 }
+func (p *GetNotificationListRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 type GetNotificationListResponse struct {
 	Code          int32           `thrift:"code,1" frugal:"1,default,i32" json:"code"`
 	Message       string          `thrift:"message,2" frugal:"2,default,string" json:"message"`
 	Notifications []*Notification `thrift:"notifications,3" frugal:"3,default,list<Notification>" json:"notifications"`
 	Total         int32           `thrift:"total,4" frugal:"4,default,i32" json:"total"`
+	HasMore       bool            `thrift:"has_more,5" frugal:"5,default,bool" json:"has_more"`
+	NextCursor    string          `thrift:"next_cursor,6" frugal:"6,default,string" json:"next_cursor"`
 }
 
 func NewGetNotificationListResponse() *GetNotificationListResponse {
@@ -2109,6 +2186,14 @@ func (p *GetNotificationListResponse) GetNotifications() (v []*Notification) {
 func (p *GetNotificationListResponse) GetTotal() (v int32) {
 	return p.Total
 }
+
+func (p *GetNotificationListResponse) GetHasMore() (v bool) {
+	return p.HasMore
+}
+
+func (p *GetNotificationListResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
 func (p *GetNotificationListResponse) SetCode(val int32) {
 	p.Code = val
 }
@@ -2121,12 +2206,20 @@ func (p *GetNotificationListResponse) SetNotifications(val []*Notification) {
 func (p *GetNotificationListResponse) SetTotal(val int32) {
 	p.Total = val
 }
+func (p *GetNotificationListResponse) SetHasMore(val bool) {
+	p.HasMore = val
+}
+func (p *GetNotificationListResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
 
 var fieldIDToName_GetNotificationListResponse = map[int16]string{
 	1: "code",
 	2: "message",
 	3: "notifications",
 	4: "total",
+	5: "has_more",
+	6: "next_cursor",
 }
 
 func (p *GetNotificationListResponse) Read(iprot thrift.TProtocol) (err error) {
@@ -2180,6 +2273,22 @@ func (p *GetNotificationListResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2265,6 +2374,28 @@ func (p *GetNotificationListResponse) ReadField4(iprot thrift.TProtocol) error {
 	p.Total = _field
 	return nil
 }
+func (p *GetNotificationListResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *GetNotificationListResponse) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
 
 func (p *GetNotificationListResponse) Write(oprot thrift.TProtocol) (err error) {
 
@@ -2289,6 +2420,14 @@ func (p *GetNotificationListResponse) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2383,6 +2522,40 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *GetNotificationListResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *GetNotificationListResponse) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.NextCursor); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *GetNotificationListResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2409,6 +2582,12 @@ func (p *GetNotificationListResponse) DeepEqual(ano *GetNotificationListResponse
 	if !p.Field4DeepEqual(ano.Total) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.HasMore) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -2446,6 +2625,20 @@ func (p *GetNotificationListResponse) Field4DeepEqual(src int32) bool {
 	}
 	return true
 }
+func (p *GetNotificationListResponse) Field5DeepEqual(src bool) bool {
+
+	if p.HasMore != src {
+		return false
+	}
+	return true
+}
+func (p *GetNotificationListResponse) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.NextCursor, src) != 0 {
+		return false
+	}
+	return true
+}
 
 type MarkNotificationReadRequest struct {
 	UserId         string `thrift:"user_id,1" frugal:"1,default,string" json:"user_id"`
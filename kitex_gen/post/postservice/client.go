@@ -16,12 +16,44 @@ type Client interface {
 	GetPostList(ctx context.Context, req *post.GetPostListRequest, callOptions ...callopt.Option) (r *post.GetPostListResponse, err error)
 	UpdatePost(ctx context.Context, req *post.UpdatePostRequest, callOptions ...callopt.Option) (r *post.UpdatePostResponse, err error)
 	DeletePost(ctx context.Context, req *post.DeletePostRequest, callOptions ...callopt.Option) (r *post.DeletePostResponse, err error)
+	GetPostRevisions(ctx context.Context, req *post.GetPostRevisionsRequest, callOptions ...callopt.Option) (r *post.GetPostRevisionsResponse, err error)
+	GetPostRevision(ctx context.Context, req *post.GetPostRevisionRequest, callOptions ...callopt.Option) (r *post.GetPostRevisionResponse, err error)
+	CreateDraft(ctx context.Context, req *post.CreateDraftRequest, callOptions ...callopt.Option) (r *post.CreateDraftResponse, err error)
+	UpdateDraft(ctx context.Context, req *post.UpdateDraftRequest, callOptions ...callopt.Option) (r *post.UpdateDraftResponse, err error)
+	GetDraft(ctx context.Context, req *post.GetDraftRequest, callOptions ...callopt.Option) (r *post.GetDraftResponse, err error)
+	GetDraftList(ctx context.Context, req *post.GetDraftListRequest, callOptions ...callopt.Option) (r *post.GetDraftListResponse, err error)
+	DeleteDraft(ctx context.Context, req *post.DeleteDraftRequest, callOptions ...callopt.Option) (r *post.DeleteDraftResponse, err error)
+	PublishDraft(ctx context.Context, req *post.PublishDraftRequest, callOptions ...callopt.Option) (r *post.PublishDraftResponse, err error)
+	GetBrowsingHistory(ctx context.Context, req *post.GetBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.GetBrowsingHistoryResponse, err error)
+	ClearBrowsingHistory(ctx context.Context, req *post.ClearBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.ClearBrowsingHistoryResponse, err error)
+	RemoveBrowsingHistory(ctx context.Context, req *post.RemoveBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.RemoveBrowsingHistoryResponse, err error)
+	GetScheduledPosts(ctx context.Context, req *post.GetScheduledPostsRequest, callOptions ...callopt.Option) (r *post.GetScheduledPostsResponse, err error)
+	ReschedulePost(ctx context.Context, req *post.ReschedulePostRequest, callOptions ...callopt.Option) (r *post.ReschedulePostResponse, err error)
+	CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest, callOptions ...callopt.Option) (r *post.CancelScheduledPostResponse, err error)
+	VotePoll(ctx context.Context, req *post.VotePollRequest, callOptions ...callopt.Option) (r *post.VotePollResponse, err error)
+	ChangePollVote(ctx context.Context, req *post.ChangePollVoteRequest, callOptions ...callopt.Option) (r *post.ChangePollVoteResponse, err error)
+	GetTrendingTags(ctx context.Context, req *post.GetTrendingTagsRequest, callOptions ...callopt.Option) (r *post.GetTrendingTagsResponse, err error)
+	GetTag(ctx context.Context, req *post.GetTagRequest, callOptions ...callopt.Option) (r *post.GetTagResponse, err error)
+	GetDeletedPosts(ctx context.Context, req *post.GetDeletedPostsRequest, callOptions ...callopt.Option) (r *post.GetDeletedPostsResponse, err error)
+	RestorePost(ctx context.Context, req *post.RestorePostRequest, callOptions ...callopt.Option) (r *post.RestorePostResponse, err error)
+	GetModerationReviews(ctx context.Context, req *post.GetModerationReviewsRequest, callOptions ...callopt.Option) (r *post.GetModerationReviewsResponse, err error)
+	ResolveModerationReview(ctx context.Context, req *post.ResolveModerationReviewRequest, callOptions ...callopt.Option) (r *post.ResolveModerationReviewResponse, err error)
+	CreateReport(ctx context.Context, req *post.CreateReportRequest, callOptions ...callopt.Option) (r *post.CreateReportResponse, err error)
+	GetReportCases(ctx context.Context, req *post.GetReportCasesRequest, callOptions ...callopt.Option) (r *post.GetReportCasesResponse, err error)
+	ResolveReportCase(ctx context.Context, req *post.ResolveReportCaseRequest, callOptions ...callopt.Option) (r *post.ResolveReportCaseResponse, err error)
+	CreateAppeal(ctx context.Context, req *post.CreateAppealRequest, callOptions ...callopt.Option) (r *post.CreateAppealResponse, err error)
+	GetMyAppeals(ctx context.Context, req *post.GetMyAppealsRequest, callOptions ...callopt.Option) (r *post.GetMyAppealsResponse, err error)
+	GetAppeals(ctx context.Context, req *post.GetAppealsRequest, callOptions ...callopt.Option) (r *post.GetAppealsResponse, err error)
+	ResolveAppeal(ctx context.Context, req *post.ResolveAppealRequest, callOptions ...callopt.Option) (r *post.ResolveAppealResponse, err error)
 	GetRecommendPosts(ctx context.Context, req *post.GetRecommendPostsRequest, callOptions ...callopt.Option) (r *post.GetRecommendPostsResponse, err error)
 	GetHotPosts(ctx context.Context, req *post.GetHotPostsRequest, callOptions ...callopt.Option) (r *post.GetHotPostsResponse, err error)
 	GetHighScorePosts(ctx context.Context, req *post.GetHighScorePostsRequest, callOptions ...callopt.Option) (r *post.GetHighScorePostsResponse, err error)
 	GetLowScorePosts(ctx context.Context, req *post.GetLowScorePostsRequest, callOptions ...callopt.Option) (r *post.GetLowScorePostsResponse, err error)
 	GetControversialPosts(ctx context.Context, req *post.GetControversialPostsRequest, callOptions ...callopt.Option) (r *post.GetControversialPostsResponse, err error)
 	SearchPosts(ctx context.Context, req *post.SearchPostsRequest, callOptions ...callopt.Option) (r *post.SearchPostsResponse, err error)
+	GetSearchSuggestions(ctx context.Context, req *post.GetSearchSuggestionsRequest, callOptions ...callopt.Option) (r *post.GetSearchSuggestionsResponse, err error)
+	GetTrendingSearches(ctx context.Context, req *post.GetTrendingSearchesRequest, callOptions ...callopt.Option) (r *post.GetTrendingSearchesResponse, err error)
+	GetRelatedPosts(ctx context.Context, req *post.GetRelatedPostsRequest, callOptions ...callopt.Option) (r *post.GetRelatedPostsResponse, err error)
 	CreateTopic(ctx context.Context, req *post.CreateTopicRequest, callOptions ...callopt.Option) (r *post.CreateTopicResponse, err error)
 	GetTopic(ctx context.Context, req *post.GetTopicRequest, callOptions ...callopt.Option) (r *post.GetTopicResponse, err error)
 	GetTopicList(ctx context.Context, req *post.GetTopicListRequest, callOptions ...callopt.Option) (r *post.GetTopicListResponse, err error)
@@ -29,14 +61,25 @@ type Client interface {
 	GetTopicCategories(ctx context.Context, req *post.GetTopicCategoriesRequest, callOptions ...callopt.Option) (r *post.GetTopicCategoriesResponse, err error)
 	SearchTopics(ctx context.Context, req *post.SearchTopicsRequest, callOptions ...callopt.Option) (r *post.SearchTopicsResponse, err error)
 	ShareTopic(ctx context.Context, req *post.ShareTopicRequest, callOptions ...callopt.Option) (r *post.ShareTopicResponse, err error)
+	SubscribeTopic(ctx context.Context, req *post.SubscribeTopicRequest, callOptions ...callopt.Option) (r *post.SubscribeTopicResponse, err error)
+	UnsubscribeTopic(ctx context.Context, req *post.UnsubscribeTopicRequest, callOptions ...callopt.Option) (r *post.UnsubscribeTopicResponse, err error)
+	GetSubscribedTopics(ctx context.Context, req *post.GetSubscribedTopicsRequest, callOptions ...callopt.Option) (r *post.GetSubscribedTopicsResponse, err error)
+	GetSubscribedTopicFeed(ctx context.Context, req *post.GetSubscribedTopicFeedRequest, callOptions ...callopt.Option) (r *post.GetSubscribedTopicFeedResponse, err error)
 	CollectPost(ctx context.Context, req *post.CollectPostRequest, callOptions ...callopt.Option) (r *post.CollectPostResponse, err error)
 	UncollectPost(ctx context.Context, req *post.UncollectPostRequest, callOptions ...callopt.Option) (r *post.UncollectPostResponse, err error)
 	GetCollectedPosts(ctx context.Context, req *post.GetCollectedPostsRequest, callOptions ...callopt.Option) (r *post.GetCollectedPostsResponse, err error)
+	GetFavoriteFolders(ctx context.Context, req *post.GetFavoriteFoldersRequest, callOptions ...callopt.Option) (r *post.GetFavoriteFoldersResponse, err error)
+	CreateFavoriteFolder(ctx context.Context, req *post.CreateFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.CreateFavoriteFolderResponse, err error)
+	UpdateFavoriteFolder(ctx context.Context, req *post.UpdateFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.UpdateFavoriteFolderResponse, err error)
+	DeleteFavoriteFolder(ctx context.Context, req *post.DeleteFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.DeleteFavoriteFolderResponse, err error)
+	GetFavoriteFolderPosts(ctx context.Context, req *post.GetFavoriteFolderPostsRequest, callOptions ...callopt.Option) (r *post.GetFavoriteFolderPostsResponse, err error)
+	MoveFavorites(ctx context.Context, req *post.MoveFavoritesRequest, callOptions ...callopt.Option) (r *post.MoveFavoritesResponse, err error)
 	RatePost(ctx context.Context, req *post.RatePostRequest, callOptions ...callopt.Option) (r *post.RatePostResponse, err error)
 	GetUserRating(ctx context.Context, req *post.GetUserRatingRequest, callOptions ...callopt.Option) (r *post.GetUserRatingResponse, err error)
 	UpdateRating(ctx context.Context, req *post.UpdateRatingRequest, callOptions ...callopt.Option) (r *post.UpdateRatingResponse, err error)
 	DeleteRating(ctx context.Context, req *post.DeleteRatingRequest, callOptions ...callopt.Option) (r *post.DeleteRatingResponse, err error)
 	GetRatingRank(ctx context.Context, req *post.GetRatingRankRequest, callOptions ...callopt.Option) (r *post.GetRatingRankResponse, err error)
+	GetPostRatingDistribution(ctx context.Context, req *post.GetPostRatingDistributionRequest, callOptions ...callopt.Option) (r *post.GetPostRatingDistributionResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -93,6 +136,151 @@ func (p *kPostServiceClient) DeletePost(ctx context.Context, req *post.DeletePos
 	return p.kClient.DeletePost(ctx, req)
 }
 
+func (p *kPostServiceClient) GetPostRevisions(ctx context.Context, req *post.GetPostRevisionsRequest, callOptions ...callopt.Option) (r *post.GetPostRevisionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPostRevisions(ctx, req)
+}
+
+func (p *kPostServiceClient) GetPostRevision(ctx context.Context, req *post.GetPostRevisionRequest, callOptions ...callopt.Option) (r *post.GetPostRevisionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPostRevision(ctx, req)
+}
+
+func (p *kPostServiceClient) CreateDraft(ctx context.Context, req *post.CreateDraftRequest, callOptions ...callopt.Option) (r *post.CreateDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateDraft(ctx, req)
+}
+
+func (p *kPostServiceClient) UpdateDraft(ctx context.Context, req *post.UpdateDraftRequest, callOptions ...callopt.Option) (r *post.UpdateDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateDraft(ctx, req)
+}
+
+func (p *kPostServiceClient) GetDraft(ctx context.Context, req *post.GetDraftRequest, callOptions ...callopt.Option) (r *post.GetDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDraft(ctx, req)
+}
+
+func (p *kPostServiceClient) GetDraftList(ctx context.Context, req *post.GetDraftListRequest, callOptions ...callopt.Option) (r *post.GetDraftListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDraftList(ctx, req)
+}
+
+func (p *kPostServiceClient) DeleteDraft(ctx context.Context, req *post.DeleteDraftRequest, callOptions ...callopt.Option) (r *post.DeleteDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteDraft(ctx, req)
+}
+
+func (p *kPostServiceClient) PublishDraft(ctx context.Context, req *post.PublishDraftRequest, callOptions ...callopt.Option) (r *post.PublishDraftResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PublishDraft(ctx, req)
+}
+
+func (p *kPostServiceClient) GetBrowsingHistory(ctx context.Context, req *post.GetBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.GetBrowsingHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBrowsingHistory(ctx, req)
+}
+
+func (p *kPostServiceClient) ClearBrowsingHistory(ctx context.Context, req *post.ClearBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.ClearBrowsingHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClearBrowsingHistory(ctx, req)
+}
+
+func (p *kPostServiceClient) RemoveBrowsingHistory(ctx context.Context, req *post.RemoveBrowsingHistoryRequest, callOptions ...callopt.Option) (r *post.RemoveBrowsingHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RemoveBrowsingHistory(ctx, req)
+}
+
+func (p *kPostServiceClient) GetScheduledPosts(ctx context.Context, req *post.GetScheduledPostsRequest, callOptions ...callopt.Option) (r *post.GetScheduledPostsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetScheduledPosts(ctx, req)
+}
+
+func (p *kPostServiceClient) ReschedulePost(ctx context.Context, req *post.ReschedulePostRequest, callOptions ...callopt.Option) (r *post.ReschedulePostResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReschedulePost(ctx, req)
+}
+
+func (p *kPostServiceClient) CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest, callOptions ...callopt.Option) (r *post.CancelScheduledPostResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelScheduledPost(ctx, req)
+}
+
+func (p *kPostServiceClient) VotePoll(ctx context.Context, req *post.VotePollRequest, callOptions ...callopt.Option) (r *post.VotePollResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VotePoll(ctx, req)
+}
+
+func (p *kPostServiceClient) ChangePollVote(ctx context.Context, req *post.ChangePollVoteRequest, callOptions ...callopt.Option) (r *post.ChangePollVoteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ChangePollVote(ctx, req)
+}
+
+func (p *kPostServiceClient) GetTrendingTags(ctx context.Context, req *post.GetTrendingTagsRequest, callOptions ...callopt.Option) (r *post.GetTrendingTagsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTrendingTags(ctx, req)
+}
+
+func (p *kPostServiceClient) GetTag(ctx context.Context, req *post.GetTagRequest, callOptions ...callopt.Option) (r *post.GetTagResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTag(ctx, req)
+}
+
+func (p *kPostServiceClient) GetDeletedPosts(ctx context.Context, req *post.GetDeletedPostsRequest, callOptions ...callopt.Option) (r *post.GetDeletedPostsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDeletedPosts(ctx, req)
+}
+
+func (p *kPostServiceClient) RestorePost(ctx context.Context, req *post.RestorePostRequest, callOptions ...callopt.Option) (r *post.RestorePostResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestorePost(ctx, req)
+}
+
+func (p *kPostServiceClient) GetModerationReviews(ctx context.Context, req *post.GetModerationReviewsRequest, callOptions ...callopt.Option) (r *post.GetModerationReviewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetModerationReviews(ctx, req)
+}
+
+func (p *kPostServiceClient) ResolveModerationReview(ctx context.Context, req *post.ResolveModerationReviewRequest, callOptions ...callopt.Option) (r *post.ResolveModerationReviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveModerationReview(ctx, req)
+}
+
+func (p *kPostServiceClient) CreateReport(ctx context.Context, req *post.CreateReportRequest, callOptions ...callopt.Option) (r *post.CreateReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReport(ctx, req)
+}
+
+func (p *kPostServiceClient) GetReportCases(ctx context.Context, req *post.GetReportCasesRequest, callOptions ...callopt.Option) (r *post.GetReportCasesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetReportCases(ctx, req)
+}
+
+func (p *kPostServiceClient) ResolveReportCase(ctx context.Context, req *post.ResolveReportCaseRequest, callOptions ...callopt.Option) (r *post.ResolveReportCaseResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveReportCase(ctx, req)
+}
+
+func (p *kPostServiceClient) CreateAppeal(ctx context.Context, req *post.CreateAppealRequest, callOptions ...callopt.Option) (r *post.CreateAppealResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAppeal(ctx, req)
+}
+
+func (p *kPostServiceClient) GetMyAppeals(ctx context.Context, req *post.GetMyAppealsRequest, callOptions ...callopt.Option) (r *post.GetMyAppealsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMyAppeals(ctx, req)
+}
+
+func (p *kPostServiceClient) GetAppeals(ctx context.Context, req *post.GetAppealsRequest, callOptions ...callopt.Option) (r *post.GetAppealsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAppeals(ctx, req)
+}
+
+func (p *kPostServiceClient) ResolveAppeal(ctx context.Context, req *post.ResolveAppealRequest, callOptions ...callopt.Option) (r *post.ResolveAppealResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResolveAppeal(ctx, req)
+}
+
 func (p *kPostServiceClient) GetRecommendPosts(ctx context.Context, req *post.GetRecommendPostsRequest, callOptions ...callopt.Option) (r *post.GetRecommendPostsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetRecommendPosts(ctx, req)
@@ -123,6 +311,21 @@ func (p *kPostServiceClient) SearchPosts(ctx context.Context, req *post.SearchPo
 	return p.kClient.SearchPosts(ctx, req)
 }
 
+func (p *kPostServiceClient) GetSearchSuggestions(ctx context.Context, req *post.GetSearchSuggestionsRequest, callOptions ...callopt.Option) (r *post.GetSearchSuggestionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSearchSuggestions(ctx, req)
+}
+
+func (p *kPostServiceClient) GetTrendingSearches(ctx context.Context, req *post.GetTrendingSearchesRequest, callOptions ...callopt.Option) (r *post.GetTrendingSearchesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTrendingSearches(ctx, req)
+}
+
+func (p *kPostServiceClient) GetRelatedPosts(ctx context.Context, req *post.GetRelatedPostsRequest, callOptions ...callopt.Option) (r *post.GetRelatedPostsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRelatedPosts(ctx, req)
+}
+
 func (p *kPostServiceClient) CreateTopic(ctx context.Context, req *post.CreateTopicRequest, callOptions ...callopt.Option) (r *post.CreateTopicResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateTopic(ctx, req)
@@ -158,6 +361,26 @@ func (p *kPostServiceClient) ShareTopic(ctx context.Context, req *post.ShareTopi
 	return p.kClient.ShareTopic(ctx, req)
 }
 
+func (p *kPostServiceClient) SubscribeTopic(ctx context.Context, req *post.SubscribeTopicRequest, callOptions ...callopt.Option) (r *post.SubscribeTopicResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeTopic(ctx, req)
+}
+
+func (p *kPostServiceClient) UnsubscribeTopic(ctx context.Context, req *post.UnsubscribeTopicRequest, callOptions ...callopt.Option) (r *post.UnsubscribeTopicResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnsubscribeTopic(ctx, req)
+}
+
+func (p *kPostServiceClient) GetSubscribedTopics(ctx context.Context, req *post.GetSubscribedTopicsRequest, callOptions ...callopt.Option) (r *post.GetSubscribedTopicsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSubscribedTopics(ctx, req)
+}
+
+func (p *kPostServiceClient) GetSubscribedTopicFeed(ctx context.Context, req *post.GetSubscribedTopicFeedRequest, callOptions ...callopt.Option) (r *post.GetSubscribedTopicFeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSubscribedTopicFeed(ctx, req)
+}
+
 func (p *kPostServiceClient) CollectPost(ctx context.Context, req *post.CollectPostRequest, callOptions ...callopt.Option) (r *post.CollectPostResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CollectPost(ctx, req)
@@ -173,6 +396,36 @@ func (p *kPostServiceClient) GetCollectedPosts(ctx context.Context, req *post.Ge
 	return p.kClient.GetCollectedPosts(ctx, req)
 }
 
+func (p *kPostServiceClient) GetFavoriteFolders(ctx context.Context, req *post.GetFavoriteFoldersRequest, callOptions ...callopt.Option) (r *post.GetFavoriteFoldersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFavoriteFolders(ctx, req)
+}
+
+func (p *kPostServiceClient) CreateFavoriteFolder(ctx context.Context, req *post.CreateFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.CreateFavoriteFolderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateFavoriteFolder(ctx, req)
+}
+
+func (p *kPostServiceClient) UpdateFavoriteFolder(ctx context.Context, req *post.UpdateFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.UpdateFavoriteFolderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateFavoriteFolder(ctx, req)
+}
+
+func (p *kPostServiceClient) DeleteFavoriteFolder(ctx context.Context, req *post.DeleteFavoriteFolderRequest, callOptions ...callopt.Option) (r *post.DeleteFavoriteFolderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteFavoriteFolder(ctx, req)
+}
+
+func (p *kPostServiceClient) GetFavoriteFolderPosts(ctx context.Context, req *post.GetFavoriteFolderPostsRequest, callOptions ...callopt.Option) (r *post.GetFavoriteFolderPostsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFavoriteFolderPosts(ctx, req)
+}
+
+func (p *kPostServiceClient) MoveFavorites(ctx context.Context, req *post.MoveFavoritesRequest, callOptions ...callopt.Option) (r *post.MoveFavoritesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MoveFavorites(ctx, req)
+}
+
 func (p *kPostServiceClient) RatePost(ctx context.Context, req *post.RatePostRequest, callOptions ...callopt.Option) (r *post.RatePostResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.RatePost(ctx, req)
@@ -197,3 +450,8 @@ func (p *kPostServiceClient) GetRatingRank(ctx context.Context, req *post.GetRat
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetRatingRank(ctx, req)
 }
+
+func (p *kPostServiceClient) GetPostRatingDistribution(ctx context.Context, req *post.GetPostRatingDistributionRequest, callOptions ...callopt.Option) (r *post.GetPostRatingDistributionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPostRatingDistribution(ctx, req)
+}
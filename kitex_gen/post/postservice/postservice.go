@@ -48,6 +48,209 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetPostRevisions": kitex.NewMethodInfo(
+		getPostRevisionsHandler,
+		newPostServiceGetPostRevisionsArgs,
+		newPostServiceGetPostRevisionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPostRevision": kitex.NewMethodInfo(
+		getPostRevisionHandler,
+		newPostServiceGetPostRevisionArgs,
+		newPostServiceGetPostRevisionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateDraft": kitex.NewMethodInfo(
+		createDraftHandler,
+		newPostServiceCreateDraftArgs,
+		newPostServiceCreateDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateDraft": kitex.NewMethodInfo(
+		updateDraftHandler,
+		newPostServiceUpdateDraftArgs,
+		newPostServiceUpdateDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDraft": kitex.NewMethodInfo(
+		getDraftHandler,
+		newPostServiceGetDraftArgs,
+		newPostServiceGetDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDraftList": kitex.NewMethodInfo(
+		getDraftListHandler,
+		newPostServiceGetDraftListArgs,
+		newPostServiceGetDraftListResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteDraft": kitex.NewMethodInfo(
+		deleteDraftHandler,
+		newPostServiceDeleteDraftArgs,
+		newPostServiceDeleteDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PublishDraft": kitex.NewMethodInfo(
+		publishDraftHandler,
+		newPostServicePublishDraftArgs,
+		newPostServicePublishDraftResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetBrowsingHistory": kitex.NewMethodInfo(
+		getBrowsingHistoryHandler,
+		newPostServiceGetBrowsingHistoryArgs,
+		newPostServiceGetBrowsingHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ClearBrowsingHistory": kitex.NewMethodInfo(
+		clearBrowsingHistoryHandler,
+		newPostServiceClearBrowsingHistoryArgs,
+		newPostServiceClearBrowsingHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RemoveBrowsingHistory": kitex.NewMethodInfo(
+		removeBrowsingHistoryHandler,
+		newPostServiceRemoveBrowsingHistoryArgs,
+		newPostServiceRemoveBrowsingHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetScheduledPosts": kitex.NewMethodInfo(
+		getScheduledPostsHandler,
+		newPostServiceGetScheduledPostsArgs,
+		newPostServiceGetScheduledPostsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReschedulePost": kitex.NewMethodInfo(
+		reschedulePostHandler,
+		newPostServiceReschedulePostArgs,
+		newPostServiceReschedulePostResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelScheduledPost": kitex.NewMethodInfo(
+		cancelScheduledPostHandler,
+		newPostServiceCancelScheduledPostArgs,
+		newPostServiceCancelScheduledPostResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"VotePoll": kitex.NewMethodInfo(
+		votePollHandler,
+		newPostServiceVotePollArgs,
+		newPostServiceVotePollResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ChangePollVote": kitex.NewMethodInfo(
+		changePollVoteHandler,
+		newPostServiceChangePollVoteArgs,
+		newPostServiceChangePollVoteResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTrendingTags": kitex.NewMethodInfo(
+		getTrendingTagsHandler,
+		newPostServiceGetTrendingTagsArgs,
+		newPostServiceGetTrendingTagsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTag": kitex.NewMethodInfo(
+		getTagHandler,
+		newPostServiceGetTagArgs,
+		newPostServiceGetTagResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDeletedPosts": kitex.NewMethodInfo(
+		getDeletedPostsHandler,
+		newPostServiceGetDeletedPostsArgs,
+		newPostServiceGetDeletedPostsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RestorePost": kitex.NewMethodInfo(
+		restorePostHandler,
+		newPostServiceRestorePostArgs,
+		newPostServiceRestorePostResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetModerationReviews": kitex.NewMethodInfo(
+		getModerationReviewsHandler,
+		newPostServiceGetModerationReviewsArgs,
+		newPostServiceGetModerationReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveModerationReview": kitex.NewMethodInfo(
+		resolveModerationReviewHandler,
+		newPostServiceResolveModerationReviewArgs,
+		newPostServiceResolveModerationReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateReport": kitex.NewMethodInfo(
+		createReportHandler,
+		newPostServiceCreateReportArgs,
+		newPostServiceCreateReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetReportCases": kitex.NewMethodInfo(
+		getReportCasesHandler,
+		newPostServiceGetReportCasesArgs,
+		newPostServiceGetReportCasesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveReportCase": kitex.NewMethodInfo(
+		resolveReportCaseHandler,
+		newPostServiceResolveReportCaseArgs,
+		newPostServiceResolveReportCaseResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateAppeal": kitex.NewMethodInfo(
+		createAppealHandler,
+		newPostServiceCreateAppealArgs,
+		newPostServiceCreateAppealResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetMyAppeals": kitex.NewMethodInfo(
+		getMyAppealsHandler,
+		newPostServiceGetMyAppealsArgs,
+		newPostServiceGetMyAppealsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetAppeals": kitex.NewMethodInfo(
+		getAppealsHandler,
+		newPostServiceGetAppealsArgs,
+		newPostServiceGetAppealsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResolveAppeal": kitex.NewMethodInfo(
+		resolveAppealHandler,
+		newPostServiceResolveAppealArgs,
+		newPostServiceResolveAppealResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"GetRecommendPosts": kitex.NewMethodInfo(
 		getRecommendPostsHandler,
 		newPostServiceGetRecommendPostsArgs,
@@ -90,6 +293,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetSearchSuggestions": kitex.NewMethodInfo(
+		getSearchSuggestionsHandler,
+		newPostServiceGetSearchSuggestionsArgs,
+		newPostServiceGetSearchSuggestionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetTrendingSearches": kitex.NewMethodInfo(
+		getTrendingSearchesHandler,
+		newPostServiceGetTrendingSearchesArgs,
+		newPostServiceGetTrendingSearchesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetRelatedPosts": kitex.NewMethodInfo(
+		getRelatedPostsHandler,
+		newPostServiceGetRelatedPostsArgs,
+		newPostServiceGetRelatedPostsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CreateTopic": kitex.NewMethodInfo(
 		createTopicHandler,
 		newPostServiceCreateTopicArgs,
@@ -139,6 +363,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"SubscribeTopic": kitex.NewMethodInfo(
+		subscribeTopicHandler,
+		newPostServiceSubscribeTopicArgs,
+		newPostServiceSubscribeTopicResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UnsubscribeTopic": kitex.NewMethodInfo(
+		unsubscribeTopicHandler,
+		newPostServiceUnsubscribeTopicArgs,
+		newPostServiceUnsubscribeTopicResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSubscribedTopics": kitex.NewMethodInfo(
+		getSubscribedTopicsHandler,
+		newPostServiceGetSubscribedTopicsArgs,
+		newPostServiceGetSubscribedTopicsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetSubscribedTopicFeed": kitex.NewMethodInfo(
+		getSubscribedTopicFeedHandler,
+		newPostServiceGetSubscribedTopicFeedArgs,
+		newPostServiceGetSubscribedTopicFeedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CollectPost": kitex.NewMethodInfo(
 		collectPostHandler,
 		newPostServiceCollectPostArgs,
@@ -160,6 +412,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetFavoriteFolders": kitex.NewMethodInfo(
+		getFavoriteFoldersHandler,
+		newPostServiceGetFavoriteFoldersArgs,
+		newPostServiceGetFavoriteFoldersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateFavoriteFolder": kitex.NewMethodInfo(
+		createFavoriteFolderHandler,
+		newPostServiceCreateFavoriteFolderArgs,
+		newPostServiceCreateFavoriteFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateFavoriteFolder": kitex.NewMethodInfo(
+		updateFavoriteFolderHandler,
+		newPostServiceUpdateFavoriteFolderArgs,
+		newPostServiceUpdateFavoriteFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteFavoriteFolder": kitex.NewMethodInfo(
+		deleteFavoriteFolderHandler,
+		newPostServiceDeleteFavoriteFolderArgs,
+		newPostServiceDeleteFavoriteFolderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFavoriteFolderPosts": kitex.NewMethodInfo(
+		getFavoriteFolderPostsHandler,
+		newPostServiceGetFavoriteFolderPostsArgs,
+		newPostServiceGetFavoriteFolderPostsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MoveFavorites": kitex.NewMethodInfo(
+		moveFavoritesHandler,
+		newPostServiceMoveFavoritesArgs,
+		newPostServiceMoveFavoritesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"RatePost": kitex.NewMethodInfo(
 		ratePostHandler,
 		newPostServiceRatePostArgs,
@@ -195,6 +489,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"GetPostRatingDistribution": kitex.NewMethodInfo(
+		getPostRatingDistributionHandler,
+		newPostServiceGetPostRatingDistributionArgs,
+		newPostServiceGetPostRatingDistributionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -351,439 +652,1503 @@ func newPostServiceDeletePostResult() interface{} {
 	return post.NewPostServiceDeletePostResult()
 }
 
-func getRecommendPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetRecommendPostsArgs)
-	realResult := result.(*post.PostServiceGetRecommendPostsResult)
-	success, err := handler.(post.PostService).GetRecommendPosts(ctx, realArg.Req)
+func getPostRevisionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetPostRevisionsArgs)
+	realResult := result.(*post.PostServiceGetPostRevisionsResult)
+	success, err := handler.(post.PostService).GetPostRevisions(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetRecommendPostsArgs() interface{} {
-	return post.NewPostServiceGetRecommendPostsArgs()
+func newPostServiceGetPostRevisionsArgs() interface{} {
+	return post.NewPostServiceGetPostRevisionsArgs()
 }
 
-func newPostServiceGetRecommendPostsResult() interface{} {
-	return post.NewPostServiceGetRecommendPostsResult()
+func newPostServiceGetPostRevisionsResult() interface{} {
+	return post.NewPostServiceGetPostRevisionsResult()
 }
 
-func getHotPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetHotPostsArgs)
-	realResult := result.(*post.PostServiceGetHotPostsResult)
-	success, err := handler.(post.PostService).GetHotPosts(ctx, realArg.Req)
+func getPostRevisionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetPostRevisionArgs)
+	realResult := result.(*post.PostServiceGetPostRevisionResult)
+	success, err := handler.(post.PostService).GetPostRevision(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetHotPostsArgs() interface{} {
-	return post.NewPostServiceGetHotPostsArgs()
+func newPostServiceGetPostRevisionArgs() interface{} {
+	return post.NewPostServiceGetPostRevisionArgs()
 }
 
-func newPostServiceGetHotPostsResult() interface{} {
-	return post.NewPostServiceGetHotPostsResult()
+func newPostServiceGetPostRevisionResult() interface{} {
+	return post.NewPostServiceGetPostRevisionResult()
 }
 
-func getHighScorePostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetHighScorePostsArgs)
-	realResult := result.(*post.PostServiceGetHighScorePostsResult)
-	success, err := handler.(post.PostService).GetHighScorePosts(ctx, realArg.Req)
+func createDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCreateDraftArgs)
+	realResult := result.(*post.PostServiceCreateDraftResult)
+	success, err := handler.(post.PostService).CreateDraft(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetHighScorePostsArgs() interface{} {
-	return post.NewPostServiceGetHighScorePostsArgs()
+func newPostServiceCreateDraftArgs() interface{} {
+	return post.NewPostServiceCreateDraftArgs()
 }
 
-func newPostServiceGetHighScorePostsResult() interface{} {
-	return post.NewPostServiceGetHighScorePostsResult()
+func newPostServiceCreateDraftResult() interface{} {
+	return post.NewPostServiceCreateDraftResult()
 }
 
-func getLowScorePostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetLowScorePostsArgs)
-	realResult := result.(*post.PostServiceGetLowScorePostsResult)
-	success, err := handler.(post.PostService).GetLowScorePosts(ctx, realArg.Req)
+func updateDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceUpdateDraftArgs)
+	realResult := result.(*post.PostServiceUpdateDraftResult)
+	success, err := handler.(post.PostService).UpdateDraft(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetLowScorePostsArgs() interface{} {
-	return post.NewPostServiceGetLowScorePostsArgs()
+func newPostServiceUpdateDraftArgs() interface{} {
+	return post.NewPostServiceUpdateDraftArgs()
 }
 
-func newPostServiceGetLowScorePostsResult() interface{} {
-	return post.NewPostServiceGetLowScorePostsResult()
+func newPostServiceUpdateDraftResult() interface{} {
+	return post.NewPostServiceUpdateDraftResult()
 }
 
-func getControversialPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetControversialPostsArgs)
-	realResult := result.(*post.PostServiceGetControversialPostsResult)
-	success, err := handler.(post.PostService).GetControversialPosts(ctx, realArg.Req)
+func getDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetDraftArgs)
+	realResult := result.(*post.PostServiceGetDraftResult)
+	success, err := handler.(post.PostService).GetDraft(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetControversialPostsArgs() interface{} {
-	return post.NewPostServiceGetControversialPostsArgs()
+func newPostServiceGetDraftArgs() interface{} {
+	return post.NewPostServiceGetDraftArgs()
 }
 
-func newPostServiceGetControversialPostsResult() interface{} {
-	return post.NewPostServiceGetControversialPostsResult()
+func newPostServiceGetDraftResult() interface{} {
+	return post.NewPostServiceGetDraftResult()
 }
 
-func searchPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceSearchPostsArgs)
-	realResult := result.(*post.PostServiceSearchPostsResult)
-	success, err := handler.(post.PostService).SearchPosts(ctx, realArg.Req)
+func getDraftListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetDraftListArgs)
+	realResult := result.(*post.PostServiceGetDraftListResult)
+	success, err := handler.(post.PostService).GetDraftList(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceSearchPostsArgs() interface{} {
-	return post.NewPostServiceSearchPostsArgs()
+func newPostServiceGetDraftListArgs() interface{} {
+	return post.NewPostServiceGetDraftListArgs()
 }
 
-func newPostServiceSearchPostsResult() interface{} {
-	return post.NewPostServiceSearchPostsResult()
+func newPostServiceGetDraftListResult() interface{} {
+	return post.NewPostServiceGetDraftListResult()
 }
 
-func createTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceCreateTopicArgs)
-	realResult := result.(*post.PostServiceCreateTopicResult)
-	success, err := handler.(post.PostService).CreateTopic(ctx, realArg.Req)
+func deleteDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceDeleteDraftArgs)
+	realResult := result.(*post.PostServiceDeleteDraftResult)
+	success, err := handler.(post.PostService).DeleteDraft(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceCreateTopicArgs() interface{} {
-	return post.NewPostServiceCreateTopicArgs()
+func newPostServiceDeleteDraftArgs() interface{} {
+	return post.NewPostServiceDeleteDraftArgs()
 }
 
-func newPostServiceCreateTopicResult() interface{} {
-	return post.NewPostServiceCreateTopicResult()
+func newPostServiceDeleteDraftResult() interface{} {
+	return post.NewPostServiceDeleteDraftResult()
 }
 
-func getTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetTopicArgs)
-	realResult := result.(*post.PostServiceGetTopicResult)
-	success, err := handler.(post.PostService).GetTopic(ctx, realArg.Req)
+func publishDraftHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServicePublishDraftArgs)
+	realResult := result.(*post.PostServicePublishDraftResult)
+	success, err := handler.(post.PostService).PublishDraft(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetTopicArgs() interface{} {
-	return post.NewPostServiceGetTopicArgs()
+func newPostServicePublishDraftArgs() interface{} {
+	return post.NewPostServicePublishDraftArgs()
 }
 
-func newPostServiceGetTopicResult() interface{} {
-	return post.NewPostServiceGetTopicResult()
+func newPostServicePublishDraftResult() interface{} {
+	return post.NewPostServicePublishDraftResult()
 }
 
-func getTopicListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetTopicListArgs)
-	realResult := result.(*post.PostServiceGetTopicListResult)
-	success, err := handler.(post.PostService).GetTopicList(ctx, realArg.Req)
+func getBrowsingHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetBrowsingHistoryArgs)
+	realResult := result.(*post.PostServiceGetBrowsingHistoryResult)
+	success, err := handler.(post.PostService).GetBrowsingHistory(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetTopicListArgs() interface{} {
-	return post.NewPostServiceGetTopicListArgs()
+func newPostServiceGetBrowsingHistoryArgs() interface{} {
+	return post.NewPostServiceGetBrowsingHistoryArgs()
 }
 
-func newPostServiceGetTopicListResult() interface{} {
-	return post.NewPostServiceGetTopicListResult()
+func newPostServiceGetBrowsingHistoryResult() interface{} {
+	return post.NewPostServiceGetBrowsingHistoryResult()
 }
 
-func getHotTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetHotTopicsArgs)
-	realResult := result.(*post.PostServiceGetHotTopicsResult)
-	success, err := handler.(post.PostService).GetHotTopics(ctx, realArg.Req)
+func clearBrowsingHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceClearBrowsingHistoryArgs)
+	realResult := result.(*post.PostServiceClearBrowsingHistoryResult)
+	success, err := handler.(post.PostService).ClearBrowsingHistory(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetHotTopicsArgs() interface{} {
-	return post.NewPostServiceGetHotTopicsArgs()
+func newPostServiceClearBrowsingHistoryArgs() interface{} {
+	return post.NewPostServiceClearBrowsingHistoryArgs()
 }
 
-func newPostServiceGetHotTopicsResult() interface{} {
-	return post.NewPostServiceGetHotTopicsResult()
+func newPostServiceClearBrowsingHistoryResult() interface{} {
+	return post.NewPostServiceClearBrowsingHistoryResult()
 }
 
-func getTopicCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetTopicCategoriesArgs)
-	realResult := result.(*post.PostServiceGetTopicCategoriesResult)
-	success, err := handler.(post.PostService).GetTopicCategories(ctx, realArg.Req)
+func removeBrowsingHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceRemoveBrowsingHistoryArgs)
+	realResult := result.(*post.PostServiceRemoveBrowsingHistoryResult)
+	success, err := handler.(post.PostService).RemoveBrowsingHistory(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetTopicCategoriesArgs() interface{} {
-	return post.NewPostServiceGetTopicCategoriesArgs()
+func newPostServiceRemoveBrowsingHistoryArgs() interface{} {
+	return post.NewPostServiceRemoveBrowsingHistoryArgs()
 }
 
-func newPostServiceGetTopicCategoriesResult() interface{} {
-	return post.NewPostServiceGetTopicCategoriesResult()
+func newPostServiceRemoveBrowsingHistoryResult() interface{} {
+	return post.NewPostServiceRemoveBrowsingHistoryResult()
 }
 
-func searchTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceSearchTopicsArgs)
-	realResult := result.(*post.PostServiceSearchTopicsResult)
-	success, err := handler.(post.PostService).SearchTopics(ctx, realArg.Req)
+func getScheduledPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetScheduledPostsArgs)
+	realResult := result.(*post.PostServiceGetScheduledPostsResult)
+	success, err := handler.(post.PostService).GetScheduledPosts(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceSearchTopicsArgs() interface{} {
-	return post.NewPostServiceSearchTopicsArgs()
+func newPostServiceGetScheduledPostsArgs() interface{} {
+	return post.NewPostServiceGetScheduledPostsArgs()
 }
 
-func newPostServiceSearchTopicsResult() interface{} {
-	return post.NewPostServiceSearchTopicsResult()
+func newPostServiceGetScheduledPostsResult() interface{} {
+	return post.NewPostServiceGetScheduledPostsResult()
 }
 
-func shareTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceShareTopicArgs)
-	realResult := result.(*post.PostServiceShareTopicResult)
-	success, err := handler.(post.PostService).ShareTopic(ctx, realArg.Req)
+func reschedulePostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceReschedulePostArgs)
+	realResult := result.(*post.PostServiceReschedulePostResult)
+	success, err := handler.(post.PostService).ReschedulePost(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceShareTopicArgs() interface{} {
-	return post.NewPostServiceShareTopicArgs()
+func newPostServiceReschedulePostArgs() interface{} {
+	return post.NewPostServiceReschedulePostArgs()
 }
 
-func newPostServiceShareTopicResult() interface{} {
-	return post.NewPostServiceShareTopicResult()
+func newPostServiceReschedulePostResult() interface{} {
+	return post.NewPostServiceReschedulePostResult()
 }
 
-func collectPostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceCollectPostArgs)
-	realResult := result.(*post.PostServiceCollectPostResult)
-	success, err := handler.(post.PostService).CollectPost(ctx, realArg.Req)
+func cancelScheduledPostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCancelScheduledPostArgs)
+	realResult := result.(*post.PostServiceCancelScheduledPostResult)
+	success, err := handler.(post.PostService).CancelScheduledPost(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceCollectPostArgs() interface{} {
-	return post.NewPostServiceCollectPostArgs()
+func newPostServiceCancelScheduledPostArgs() interface{} {
+	return post.NewPostServiceCancelScheduledPostArgs()
 }
 
-func newPostServiceCollectPostResult() interface{} {
-	return post.NewPostServiceCollectPostResult()
+func newPostServiceCancelScheduledPostResult() interface{} {
+	return post.NewPostServiceCancelScheduledPostResult()
 }
 
-func uncollectPostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceUncollectPostArgs)
-	realResult := result.(*post.PostServiceUncollectPostResult)
-	success, err := handler.(post.PostService).UncollectPost(ctx, realArg.Req)
+func votePollHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceVotePollArgs)
+	realResult := result.(*post.PostServiceVotePollResult)
+	success, err := handler.(post.PostService).VotePoll(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceUncollectPostArgs() interface{} {
-	return post.NewPostServiceUncollectPostArgs()
+func newPostServiceVotePollArgs() interface{} {
+	return post.NewPostServiceVotePollArgs()
 }
 
-func newPostServiceUncollectPostResult() interface{} {
-	return post.NewPostServiceUncollectPostResult()
+func newPostServiceVotePollResult() interface{} {
+	return post.NewPostServiceVotePollResult()
 }
 
-func getCollectedPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetCollectedPostsArgs)
-	realResult := result.(*post.PostServiceGetCollectedPostsResult)
-	success, err := handler.(post.PostService).GetCollectedPosts(ctx, realArg.Req)
+func changePollVoteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceChangePollVoteArgs)
+	realResult := result.(*post.PostServiceChangePollVoteResult)
+	success, err := handler.(post.PostService).ChangePollVote(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newPostServiceGetCollectedPostsArgs() interface{} {
-	return post.NewPostServiceGetCollectedPostsArgs()
+func newPostServiceChangePollVoteArgs() interface{} {
+	return post.NewPostServiceChangePollVoteArgs()
 }
 
-func newPostServiceGetCollectedPostsResult() interface{} {
+func newPostServiceChangePollVoteResult() interface{} {
+	return post.NewPostServiceChangePollVoteResult()
+}
+
+func getTrendingTagsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTrendingTagsArgs)
+	realResult := result.(*post.PostServiceGetTrendingTagsResult)
+	success, err := handler.(post.PostService).GetTrendingTags(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTrendingTagsArgs() interface{} {
+	return post.NewPostServiceGetTrendingTagsArgs()
+}
+
+func newPostServiceGetTrendingTagsResult() interface{} {
+	return post.NewPostServiceGetTrendingTagsResult()
+}
+
+func getTagHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTagArgs)
+	realResult := result.(*post.PostServiceGetTagResult)
+	success, err := handler.(post.PostService).GetTag(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTagArgs() interface{} {
+	return post.NewPostServiceGetTagArgs()
+}
+
+func newPostServiceGetTagResult() interface{} {
+	return post.NewPostServiceGetTagResult()
+}
+
+func getDeletedPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetDeletedPostsArgs)
+	realResult := result.(*post.PostServiceGetDeletedPostsResult)
+	success, err := handler.(post.PostService).GetDeletedPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetDeletedPostsArgs() interface{} {
+	return post.NewPostServiceGetDeletedPostsArgs()
+}
+
+func newPostServiceGetDeletedPostsResult() interface{} {
+	return post.NewPostServiceGetDeletedPostsResult()
+}
+
+func restorePostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceRestorePostArgs)
+	realResult := result.(*post.PostServiceRestorePostResult)
+	success, err := handler.(post.PostService).RestorePost(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceRestorePostArgs() interface{} {
+	return post.NewPostServiceRestorePostArgs()
+}
+
+func newPostServiceRestorePostResult() interface{} {
+	return post.NewPostServiceRestorePostResult()
+}
+
+func getModerationReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetModerationReviewsArgs)
+	realResult := result.(*post.PostServiceGetModerationReviewsResult)
+	success, err := handler.(post.PostService).GetModerationReviews(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetModerationReviewsArgs() interface{} {
+	return post.NewPostServiceGetModerationReviewsArgs()
+}
+
+func newPostServiceGetModerationReviewsResult() interface{} {
+	return post.NewPostServiceGetModerationReviewsResult()
+}
+
+func resolveModerationReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceResolveModerationReviewArgs)
+	realResult := result.(*post.PostServiceResolveModerationReviewResult)
+	success, err := handler.(post.PostService).ResolveModerationReview(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceResolveModerationReviewArgs() interface{} {
+	return post.NewPostServiceResolveModerationReviewArgs()
+}
+
+func newPostServiceResolveModerationReviewResult() interface{} {
+	return post.NewPostServiceResolveModerationReviewResult()
+}
+
+func createReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCreateReportArgs)
+	realResult := result.(*post.PostServiceCreateReportResult)
+	success, err := handler.(post.PostService).CreateReport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceCreateReportArgs() interface{} {
+	return post.NewPostServiceCreateReportArgs()
+}
+
+func newPostServiceCreateReportResult() interface{} {
+	return post.NewPostServiceCreateReportResult()
+}
+
+func getReportCasesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetReportCasesArgs)
+	realResult := result.(*post.PostServiceGetReportCasesResult)
+	success, err := handler.(post.PostService).GetReportCases(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetReportCasesArgs() interface{} {
+	return post.NewPostServiceGetReportCasesArgs()
+}
+
+func newPostServiceGetReportCasesResult() interface{} {
+	return post.NewPostServiceGetReportCasesResult()
+}
+
+func resolveReportCaseHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceResolveReportCaseArgs)
+	realResult := result.(*post.PostServiceResolveReportCaseResult)
+	success, err := handler.(post.PostService).ResolveReportCase(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceResolveReportCaseArgs() interface{} {
+	return post.NewPostServiceResolveReportCaseArgs()
+}
+
+func newPostServiceResolveReportCaseResult() interface{} {
+	return post.NewPostServiceResolveReportCaseResult()
+}
+
+func createAppealHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCreateAppealArgs)
+	realResult := result.(*post.PostServiceCreateAppealResult)
+	success, err := handler.(post.PostService).CreateAppeal(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceCreateAppealArgs() interface{} {
+	return post.NewPostServiceCreateAppealArgs()
+}
+
+func newPostServiceCreateAppealResult() interface{} {
+	return post.NewPostServiceCreateAppealResult()
+}
+
+func getMyAppealsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetMyAppealsArgs)
+	realResult := result.(*post.PostServiceGetMyAppealsResult)
+	success, err := handler.(post.PostService).GetMyAppeals(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetMyAppealsArgs() interface{} {
+	return post.NewPostServiceGetMyAppealsArgs()
+}
+
+func newPostServiceGetMyAppealsResult() interface{} {
+	return post.NewPostServiceGetMyAppealsResult()
+}
+
+func getAppealsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetAppealsArgs)
+	realResult := result.(*post.PostServiceGetAppealsResult)
+	success, err := handler.(post.PostService).GetAppeals(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetAppealsArgs() interface{} {
+	return post.NewPostServiceGetAppealsArgs()
+}
+
+func newPostServiceGetAppealsResult() interface{} {
+	return post.NewPostServiceGetAppealsResult()
+}
+
+func resolveAppealHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceResolveAppealArgs)
+	realResult := result.(*post.PostServiceResolveAppealResult)
+	success, err := handler.(post.PostService).ResolveAppeal(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceResolveAppealArgs() interface{} {
+	return post.NewPostServiceResolveAppealArgs()
+}
+
+func newPostServiceResolveAppealResult() interface{} {
+	return post.NewPostServiceResolveAppealResult()
+}
+
+func getRecommendPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetRecommendPostsArgs)
+	realResult := result.(*post.PostServiceGetRecommendPostsResult)
+	success, err := handler.(post.PostService).GetRecommendPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetRecommendPostsArgs() interface{} {
+	return post.NewPostServiceGetRecommendPostsArgs()
+}
+
+func newPostServiceGetRecommendPostsResult() interface{} {
+	return post.NewPostServiceGetRecommendPostsResult()
+}
+
+func getHotPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetHotPostsArgs)
+	realResult := result.(*post.PostServiceGetHotPostsResult)
+	success, err := handler.(post.PostService).GetHotPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetHotPostsArgs() interface{} {
+	return post.NewPostServiceGetHotPostsArgs()
+}
+
+func newPostServiceGetHotPostsResult() interface{} {
+	return post.NewPostServiceGetHotPostsResult()
+}
+
+func getHighScorePostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetHighScorePostsArgs)
+	realResult := result.(*post.PostServiceGetHighScorePostsResult)
+	success, err := handler.(post.PostService).GetHighScorePosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetHighScorePostsArgs() interface{} {
+	return post.NewPostServiceGetHighScorePostsArgs()
+}
+
+func newPostServiceGetHighScorePostsResult() interface{} {
+	return post.NewPostServiceGetHighScorePostsResult()
+}
+
+func getLowScorePostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetLowScorePostsArgs)
+	realResult := result.(*post.PostServiceGetLowScorePostsResult)
+	success, err := handler.(post.PostService).GetLowScorePosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetLowScorePostsArgs() interface{} {
+	return post.NewPostServiceGetLowScorePostsArgs()
+}
+
+func newPostServiceGetLowScorePostsResult() interface{} {
+	return post.NewPostServiceGetLowScorePostsResult()
+}
+
+func getControversialPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetControversialPostsArgs)
+	realResult := result.(*post.PostServiceGetControversialPostsResult)
+	success, err := handler.(post.PostService).GetControversialPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetControversialPostsArgs() interface{} {
+	return post.NewPostServiceGetControversialPostsArgs()
+}
+
+func newPostServiceGetControversialPostsResult() interface{} {
+	return post.NewPostServiceGetControversialPostsResult()
+}
+
+func searchPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceSearchPostsArgs)
+	realResult := result.(*post.PostServiceSearchPostsResult)
+	success, err := handler.(post.PostService).SearchPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceSearchPostsArgs() interface{} {
+	return post.NewPostServiceSearchPostsArgs()
+}
+
+func newPostServiceSearchPostsResult() interface{} {
+	return post.NewPostServiceSearchPostsResult()
+}
+
+func getSearchSuggestionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetSearchSuggestionsArgs)
+	realResult := result.(*post.PostServiceGetSearchSuggestionsResult)
+	success, err := handler.(post.PostService).GetSearchSuggestions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetSearchSuggestionsArgs() interface{} {
+	return post.NewPostServiceGetSearchSuggestionsArgs()
+}
+
+func newPostServiceGetSearchSuggestionsResult() interface{} {
+	return post.NewPostServiceGetSearchSuggestionsResult()
+}
+
+func getTrendingSearchesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTrendingSearchesArgs)
+	realResult := result.(*post.PostServiceGetTrendingSearchesResult)
+	success, err := handler.(post.PostService).GetTrendingSearches(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTrendingSearchesArgs() interface{} {
+	return post.NewPostServiceGetTrendingSearchesArgs()
+}
+
+func newPostServiceGetTrendingSearchesResult() interface{} {
+	return post.NewPostServiceGetTrendingSearchesResult()
+}
+
+func getRelatedPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetRelatedPostsArgs)
+	realResult := result.(*post.PostServiceGetRelatedPostsResult)
+	success, err := handler.(post.PostService).GetRelatedPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetRelatedPostsArgs() interface{} {
+	return post.NewPostServiceGetRelatedPostsArgs()
+}
+
+func newPostServiceGetRelatedPostsResult() interface{} {
+	return post.NewPostServiceGetRelatedPostsResult()
+}
+
+func createTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCreateTopicArgs)
+	realResult := result.(*post.PostServiceCreateTopicResult)
+	success, err := handler.(post.PostService).CreateTopic(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceCreateTopicArgs() interface{} {
+	return post.NewPostServiceCreateTopicArgs()
+}
+
+func newPostServiceCreateTopicResult() interface{} {
+	return post.NewPostServiceCreateTopicResult()
+}
+
+func getTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTopicArgs)
+	realResult := result.(*post.PostServiceGetTopicResult)
+	success, err := handler.(post.PostService).GetTopic(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTopicArgs() interface{} {
+	return post.NewPostServiceGetTopicArgs()
+}
+
+func newPostServiceGetTopicResult() interface{} {
+	return post.NewPostServiceGetTopicResult()
+}
+
+func getTopicListHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTopicListArgs)
+	realResult := result.(*post.PostServiceGetTopicListResult)
+	success, err := handler.(post.PostService).GetTopicList(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTopicListArgs() interface{} {
+	return post.NewPostServiceGetTopicListArgs()
+}
+
+func newPostServiceGetTopicListResult() interface{} {
+	return post.NewPostServiceGetTopicListResult()
+}
+
+func getHotTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetHotTopicsArgs)
+	realResult := result.(*post.PostServiceGetHotTopicsResult)
+	success, err := handler.(post.PostService).GetHotTopics(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetHotTopicsArgs() interface{} {
+	return post.NewPostServiceGetHotTopicsArgs()
+}
+
+func newPostServiceGetHotTopicsResult() interface{} {
+	return post.NewPostServiceGetHotTopicsResult()
+}
+
+func getTopicCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetTopicCategoriesArgs)
+	realResult := result.(*post.PostServiceGetTopicCategoriesResult)
+	success, err := handler.(post.PostService).GetTopicCategories(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetTopicCategoriesArgs() interface{} {
+	return post.NewPostServiceGetTopicCategoriesArgs()
+}
+
+func newPostServiceGetTopicCategoriesResult() interface{} {
+	return post.NewPostServiceGetTopicCategoriesResult()
+}
+
+func searchTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceSearchTopicsArgs)
+	realResult := result.(*post.PostServiceSearchTopicsResult)
+	success, err := handler.(post.PostService).SearchTopics(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceSearchTopicsArgs() interface{} {
+	return post.NewPostServiceSearchTopicsArgs()
+}
+
+func newPostServiceSearchTopicsResult() interface{} {
+	return post.NewPostServiceSearchTopicsResult()
+}
+
+func shareTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceShareTopicArgs)
+	realResult := result.(*post.PostServiceShareTopicResult)
+	success, err := handler.(post.PostService).ShareTopic(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceShareTopicArgs() interface{} {
+	return post.NewPostServiceShareTopicArgs()
+}
+
+func newPostServiceShareTopicResult() interface{} {
+	return post.NewPostServiceShareTopicResult()
+}
+
+func subscribeTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceSubscribeTopicArgs)
+	realResult := result.(*post.PostServiceSubscribeTopicResult)
+	success, err := handler.(post.PostService).SubscribeTopic(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceSubscribeTopicArgs() interface{} {
+	return post.NewPostServiceSubscribeTopicArgs()
+}
+
+func newPostServiceSubscribeTopicResult() interface{} {
+	return post.NewPostServiceSubscribeTopicResult()
+}
+
+func unsubscribeTopicHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceUnsubscribeTopicArgs)
+	realResult := result.(*post.PostServiceUnsubscribeTopicResult)
+	success, err := handler.(post.PostService).UnsubscribeTopic(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceUnsubscribeTopicArgs() interface{} {
+	return post.NewPostServiceUnsubscribeTopicArgs()
+}
+
+func newPostServiceUnsubscribeTopicResult() interface{} {
+	return post.NewPostServiceUnsubscribeTopicResult()
+}
+
+func getSubscribedTopicsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetSubscribedTopicsArgs)
+	realResult := result.(*post.PostServiceGetSubscribedTopicsResult)
+	success, err := handler.(post.PostService).GetSubscribedTopics(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetSubscribedTopicsArgs() interface{} {
+	return post.NewPostServiceGetSubscribedTopicsArgs()
+}
+
+func newPostServiceGetSubscribedTopicsResult() interface{} {
+	return post.NewPostServiceGetSubscribedTopicsResult()
+}
+
+func getSubscribedTopicFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetSubscribedTopicFeedArgs)
+	realResult := result.(*post.PostServiceGetSubscribedTopicFeedResult)
+	success, err := handler.(post.PostService).GetSubscribedTopicFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetSubscribedTopicFeedArgs() interface{} {
+	return post.NewPostServiceGetSubscribedTopicFeedArgs()
+}
+
+func newPostServiceGetSubscribedTopicFeedResult() interface{} {
+	return post.NewPostServiceGetSubscribedTopicFeedResult()
+}
+
+func collectPostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCollectPostArgs)
+	realResult := result.(*post.PostServiceCollectPostResult)
+	success, err := handler.(post.PostService).CollectPost(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceCollectPostArgs() interface{} {
+	return post.NewPostServiceCollectPostArgs()
+}
+
+func newPostServiceCollectPostResult() interface{} {
+	return post.NewPostServiceCollectPostResult()
+}
+
+func uncollectPostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceUncollectPostArgs)
+	realResult := result.(*post.PostServiceUncollectPostResult)
+	success, err := handler.(post.PostService).UncollectPost(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceUncollectPostArgs() interface{} {
+	return post.NewPostServiceUncollectPostArgs()
+}
+
+func newPostServiceUncollectPostResult() interface{} {
+	return post.NewPostServiceUncollectPostResult()
+}
+
+func getCollectedPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetCollectedPostsArgs)
+	realResult := result.(*post.PostServiceGetCollectedPostsResult)
+	success, err := handler.(post.PostService).GetCollectedPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetCollectedPostsArgs() interface{} {
+	return post.NewPostServiceGetCollectedPostsArgs()
+}
+
+func newPostServiceGetCollectedPostsResult() interface{} {
 	return post.NewPostServiceGetCollectedPostsResult()
 }
 
-func ratePostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceRatePostArgs)
-	realResult := result.(*post.PostServiceRatePostResult)
-	success, err := handler.(post.PostService).RatePost(ctx, realArg.Req)
-	if err != nil {
-		return err
+func getFavoriteFoldersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetFavoriteFoldersArgs)
+	realResult := result.(*post.PostServiceGetFavoriteFoldersResult)
+	success, err := handler.(post.PostService).GetFavoriteFolders(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetFavoriteFoldersArgs() interface{} {
+	return post.NewPostServiceGetFavoriteFoldersArgs()
+}
+
+func newPostServiceGetFavoriteFoldersResult() interface{} {
+	return post.NewPostServiceGetFavoriteFoldersResult()
+}
+
+func createFavoriteFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceCreateFavoriteFolderArgs)
+	realResult := result.(*post.PostServiceCreateFavoriteFolderResult)
+	success, err := handler.(post.PostService).CreateFavoriteFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceCreateFavoriteFolderArgs() interface{} {
+	return post.NewPostServiceCreateFavoriteFolderArgs()
+}
+
+func newPostServiceCreateFavoriteFolderResult() interface{} {
+	return post.NewPostServiceCreateFavoriteFolderResult()
+}
+
+func updateFavoriteFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceUpdateFavoriteFolderArgs)
+	realResult := result.(*post.PostServiceUpdateFavoriteFolderResult)
+	success, err := handler.(post.PostService).UpdateFavoriteFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceUpdateFavoriteFolderArgs() interface{} {
+	return post.NewPostServiceUpdateFavoriteFolderArgs()
+}
+
+func newPostServiceUpdateFavoriteFolderResult() interface{} {
+	return post.NewPostServiceUpdateFavoriteFolderResult()
+}
+
+func deleteFavoriteFolderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceDeleteFavoriteFolderArgs)
+	realResult := result.(*post.PostServiceDeleteFavoriteFolderResult)
+	success, err := handler.(post.PostService).DeleteFavoriteFolder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceDeleteFavoriteFolderArgs() interface{} {
+	return post.NewPostServiceDeleteFavoriteFolderArgs()
+}
+
+func newPostServiceDeleteFavoriteFolderResult() interface{} {
+	return post.NewPostServiceDeleteFavoriteFolderResult()
+}
+
+func getFavoriteFolderPostsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetFavoriteFolderPostsArgs)
+	realResult := result.(*post.PostServiceGetFavoriteFolderPostsResult)
+	success, err := handler.(post.PostService).GetFavoriteFolderPosts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetFavoriteFolderPostsArgs() interface{} {
+	return post.NewPostServiceGetFavoriteFolderPostsArgs()
+}
+
+func newPostServiceGetFavoriteFolderPostsResult() interface{} {
+	return post.NewPostServiceGetFavoriteFolderPostsResult()
+}
+
+func moveFavoritesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceMoveFavoritesArgs)
+	realResult := result.(*post.PostServiceMoveFavoritesResult)
+	success, err := handler.(post.PostService).MoveFavorites(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceMoveFavoritesArgs() interface{} {
+	return post.NewPostServiceMoveFavoritesArgs()
+}
+
+func newPostServiceMoveFavoritesResult() interface{} {
+	return post.NewPostServiceMoveFavoritesResult()
+}
+
+func ratePostHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceRatePostArgs)
+	realResult := result.(*post.PostServiceRatePostResult)
+	success, err := handler.(post.PostService).RatePost(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceRatePostArgs() interface{} {
+	return post.NewPostServiceRatePostArgs()
+}
+
+func newPostServiceRatePostResult() interface{} {
+	return post.NewPostServiceRatePostResult()
+}
+
+func getUserRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetUserRatingArgs)
+	realResult := result.(*post.PostServiceGetUserRatingResult)
+	success, err := handler.(post.PostService).GetUserRating(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetUserRatingArgs() interface{} {
+	return post.NewPostServiceGetUserRatingArgs()
+}
+
+func newPostServiceGetUserRatingResult() interface{} {
+	return post.NewPostServiceGetUserRatingResult()
+}
+
+func updateRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceUpdateRatingArgs)
+	realResult := result.(*post.PostServiceUpdateRatingResult)
+	success, err := handler.(post.PostService).UpdateRating(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceUpdateRatingArgs() interface{} {
+	return post.NewPostServiceUpdateRatingArgs()
+}
+
+func newPostServiceUpdateRatingResult() interface{} {
+	return post.NewPostServiceUpdateRatingResult()
+}
+
+func deleteRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceDeleteRatingArgs)
+	realResult := result.(*post.PostServiceDeleteRatingResult)
+	success, err := handler.(post.PostService).DeleteRating(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceDeleteRatingArgs() interface{} {
+	return post.NewPostServiceDeleteRatingArgs()
+}
+
+func newPostServiceDeleteRatingResult() interface{} {
+	return post.NewPostServiceDeleteRatingResult()
+}
+
+func getRatingRankHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetRatingRankArgs)
+	realResult := result.(*post.PostServiceGetRatingRankResult)
+	success, err := handler.(post.PostService).GetRatingRank(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetRatingRankArgs() interface{} {
+	return post.NewPostServiceGetRatingRankArgs()
+}
+
+func newPostServiceGetRatingRankResult() interface{} {
+	return post.NewPostServiceGetRatingRankResult()
+}
+
+func getPostRatingDistributionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*post.PostServiceGetPostRatingDistributionArgs)
+	realResult := result.(*post.PostServiceGetPostRatingDistributionResult)
+	success, err := handler.(post.PostService).GetPostRatingDistribution(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newPostServiceGetPostRatingDistributionArgs() interface{} {
+	return post.NewPostServiceGetPostRatingDistributionArgs()
+}
+
+func newPostServiceGetPostRatingDistributionResult() interface{} {
+	return post.NewPostServiceGetPostRatingDistributionResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreatePost(ctx context.Context, req *post.CreatePostRequest) (r *post.CreatePostResponse, err error) {
+	var _args post.PostServiceCreatePostArgs
+	_args.Req = req
+	var _result post.PostServiceCreatePostResult
+	if err = p.c.Call(ctx, "CreatePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPost(ctx context.Context, req *post.GetPostRequest) (r *post.GetPostResponse, err error) {
+	var _args post.PostServiceGetPostArgs
+	_args.Req = req
+	var _result post.PostServiceGetPostResult
+	if err = p.c.Call(ctx, "GetPost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPostList(ctx context.Context, req *post.GetPostListRequest) (r *post.GetPostListResponse, err error) {
+	var _args post.PostServiceGetPostListArgs
+	_args.Req = req
+	var _result post.PostServiceGetPostListResult
+	if err = p.c.Call(ctx, "GetPostList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePost(ctx context.Context, req *post.UpdatePostRequest) (r *post.UpdatePostResponse, err error) {
+	var _args post.PostServiceUpdatePostArgs
+	_args.Req = req
+	var _result post.PostServiceUpdatePostResult
+	if err = p.c.Call(ctx, "UpdatePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeletePost(ctx context.Context, req *post.DeletePostRequest) (r *post.DeletePostResponse, err error) {
+	var _args post.PostServiceDeletePostArgs
+	_args.Req = req
+	var _result post.PostServiceDeletePostResult
+	if err = p.c.Call(ctx, "DeletePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPostRevisions(ctx context.Context, req *post.GetPostRevisionsRequest) (r *post.GetPostRevisionsResponse, err error) {
+	var _args post.PostServiceGetPostRevisionsArgs
+	_args.Req = req
+	var _result post.PostServiceGetPostRevisionsResult
+	if err = p.c.Call(ctx, "GetPostRevisions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPostRevision(ctx context.Context, req *post.GetPostRevisionRequest) (r *post.GetPostRevisionResponse, err error) {
+	var _args post.PostServiceGetPostRevisionArgs
+	_args.Req = req
+	var _result post.PostServiceGetPostRevisionResult
+	if err = p.c.Call(ctx, "GetPostRevision", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateDraft(ctx context.Context, req *post.CreateDraftRequest) (r *post.CreateDraftResponse, err error) {
+	var _args post.PostServiceCreateDraftArgs
+	_args.Req = req
+	var _result post.PostServiceCreateDraftResult
+	if err = p.c.Call(ctx, "CreateDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateDraft(ctx context.Context, req *post.UpdateDraftRequest) (r *post.UpdateDraftResponse, err error) {
+	var _args post.PostServiceUpdateDraftArgs
+	_args.Req = req
+	var _result post.PostServiceUpdateDraftResult
+	if err = p.c.Call(ctx, "UpdateDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDraft(ctx context.Context, req *post.GetDraftRequest) (r *post.GetDraftResponse, err error) {
+	var _args post.PostServiceGetDraftArgs
+	_args.Req = req
+	var _result post.PostServiceGetDraftResult
+	if err = p.c.Call(ctx, "GetDraft", &_args, &_result); err != nil {
+		return
 	}
-	realResult.Success = success
-	return nil
+	return _result.GetSuccess(), nil
 }
-func newPostServiceRatePostArgs() interface{} {
-	return post.NewPostServiceRatePostArgs()
+
+func (p *kClient) GetDraftList(ctx context.Context, req *post.GetDraftListRequest) (r *post.GetDraftListResponse, err error) {
+	var _args post.PostServiceGetDraftListArgs
+	_args.Req = req
+	var _result post.PostServiceGetDraftListResult
+	if err = p.c.Call(ctx, "GetDraftList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newPostServiceRatePostResult() interface{} {
-	return post.NewPostServiceRatePostResult()
+func (p *kClient) DeleteDraft(ctx context.Context, req *post.DeleteDraftRequest) (r *post.DeleteDraftResponse, err error) {
+	var _args post.PostServiceDeleteDraftArgs
+	_args.Req = req
+	var _result post.PostServiceDeleteDraftResult
+	if err = p.c.Call(ctx, "DeleteDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func getUserRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetUserRatingArgs)
-	realResult := result.(*post.PostServiceGetUserRatingResult)
-	success, err := handler.(post.PostService).GetUserRating(ctx, realArg.Req)
-	if err != nil {
-		return err
+func (p *kClient) PublishDraft(ctx context.Context, req *post.PublishDraftRequest) (r *post.PublishDraftResponse, err error) {
+	var _args post.PostServicePublishDraftArgs
+	_args.Req = req
+	var _result post.PostServicePublishDraftResult
+	if err = p.c.Call(ctx, "PublishDraft", &_args, &_result); err != nil {
+		return
 	}
-	realResult.Success = success
-	return nil
+	return _result.GetSuccess(), nil
 }
-func newPostServiceGetUserRatingArgs() interface{} {
-	return post.NewPostServiceGetUserRatingArgs()
+
+func (p *kClient) GetBrowsingHistory(ctx context.Context, req *post.GetBrowsingHistoryRequest) (r *post.GetBrowsingHistoryResponse, err error) {
+	var _args post.PostServiceGetBrowsingHistoryArgs
+	_args.Req = req
+	var _result post.PostServiceGetBrowsingHistoryResult
+	if err = p.c.Call(ctx, "GetBrowsingHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newPostServiceGetUserRatingResult() interface{} {
-	return post.NewPostServiceGetUserRatingResult()
+func (p *kClient) ClearBrowsingHistory(ctx context.Context, req *post.ClearBrowsingHistoryRequest) (r *post.ClearBrowsingHistoryResponse, err error) {
+	var _args post.PostServiceClearBrowsingHistoryArgs
+	_args.Req = req
+	var _result post.PostServiceClearBrowsingHistoryResult
+	if err = p.c.Call(ctx, "ClearBrowsingHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func updateRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceUpdateRatingArgs)
-	realResult := result.(*post.PostServiceUpdateRatingResult)
-	success, err := handler.(post.PostService).UpdateRating(ctx, realArg.Req)
-	if err != nil {
-		return err
+func (p *kClient) RemoveBrowsingHistory(ctx context.Context, req *post.RemoveBrowsingHistoryRequest) (r *post.RemoveBrowsingHistoryResponse, err error) {
+	var _args post.PostServiceRemoveBrowsingHistoryArgs
+	_args.Req = req
+	var _result post.PostServiceRemoveBrowsingHistoryResult
+	if err = p.c.Call(ctx, "RemoveBrowsingHistory", &_args, &_result); err != nil {
+		return
 	}
-	realResult.Success = success
-	return nil
+	return _result.GetSuccess(), nil
 }
-func newPostServiceUpdateRatingArgs() interface{} {
-	return post.NewPostServiceUpdateRatingArgs()
+
+func (p *kClient) GetScheduledPosts(ctx context.Context, req *post.GetScheduledPostsRequest) (r *post.GetScheduledPostsResponse, err error) {
+	var _args post.PostServiceGetScheduledPostsArgs
+	_args.Req = req
+	var _result post.PostServiceGetScheduledPostsResult
+	if err = p.c.Call(ctx, "GetScheduledPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newPostServiceUpdateRatingResult() interface{} {
-	return post.NewPostServiceUpdateRatingResult()
+func (p *kClient) ReschedulePost(ctx context.Context, req *post.ReschedulePostRequest) (r *post.ReschedulePostResponse, err error) {
+	var _args post.PostServiceReschedulePostArgs
+	_args.Req = req
+	var _result post.PostServiceReschedulePostResult
+	if err = p.c.Call(ctx, "ReschedulePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func deleteRatingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceDeleteRatingArgs)
-	realResult := result.(*post.PostServiceDeleteRatingResult)
-	success, err := handler.(post.PostService).DeleteRating(ctx, realArg.Req)
-	if err != nil {
-		return err
+func (p *kClient) CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest) (r *post.CancelScheduledPostResponse, err error) {
+	var _args post.PostServiceCancelScheduledPostArgs
+	_args.Req = req
+	var _result post.PostServiceCancelScheduledPostResult
+	if err = p.c.Call(ctx, "CancelScheduledPost", &_args, &_result); err != nil {
+		return
 	}
-	realResult.Success = success
-	return nil
+	return _result.GetSuccess(), nil
 }
-func newPostServiceDeleteRatingArgs() interface{} {
-	return post.NewPostServiceDeleteRatingArgs()
+
+func (p *kClient) VotePoll(ctx context.Context, req *post.VotePollRequest) (r *post.VotePollResponse, err error) {
+	var _args post.PostServiceVotePollArgs
+	_args.Req = req
+	var _result post.PostServiceVotePollResult
+	if err = p.c.Call(ctx, "VotePoll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newPostServiceDeleteRatingResult() interface{} {
-	return post.NewPostServiceDeleteRatingResult()
+func (p *kClient) ChangePollVote(ctx context.Context, req *post.ChangePollVoteRequest) (r *post.ChangePollVoteResponse, err error) {
+	var _args post.PostServiceChangePollVoteArgs
+	_args.Req = req
+	var _result post.PostServiceChangePollVoteResult
+	if err = p.c.Call(ctx, "ChangePollVote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func getRatingRankHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*post.PostServiceGetRatingRankArgs)
-	realResult := result.(*post.PostServiceGetRatingRankResult)
-	success, err := handler.(post.PostService).GetRatingRank(ctx, realArg.Req)
-	if err != nil {
-		return err
+func (p *kClient) GetTrendingTags(ctx context.Context, req *post.GetTrendingTagsRequest) (r *post.GetTrendingTagsResponse, err error) {
+	var _args post.PostServiceGetTrendingTagsArgs
+	_args.Req = req
+	var _result post.PostServiceGetTrendingTagsResult
+	if err = p.c.Call(ctx, "GetTrendingTags", &_args, &_result); err != nil {
+		return
 	}
-	realResult.Success = success
-	return nil
+	return _result.GetSuccess(), nil
 }
-func newPostServiceGetRatingRankArgs() interface{} {
-	return post.NewPostServiceGetRatingRankArgs()
+
+func (p *kClient) GetTag(ctx context.Context, req *post.GetTagRequest) (r *post.GetTagResponse, err error) {
+	var _args post.PostServiceGetTagArgs
+	_args.Req = req
+	var _result post.PostServiceGetTagResult
+	if err = p.c.Call(ctx, "GetTag", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newPostServiceGetRatingRankResult() interface{} {
-	return post.NewPostServiceGetRatingRankResult()
+func (p *kClient) GetDeletedPosts(ctx context.Context, req *post.GetDeletedPostsRequest) (r *post.GetDeletedPostsResponse, err error) {
+	var _args post.PostServiceGetDeletedPostsArgs
+	_args.Req = req
+	var _result post.PostServiceGetDeletedPostsResult
+	if err = p.c.Call(ctx, "GetDeletedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-type kClient struct {
-	c client.Client
+func (p *kClient) RestorePost(ctx context.Context, req *post.RestorePostRequest) (r *post.RestorePostResponse, err error) {
+	var _args post.PostServiceRestorePostArgs
+	_args.Req = req
+	var _result post.PostServiceRestorePostResult
+	if err = p.c.Call(ctx, "RestorePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func (p *kClient) GetModerationReviews(ctx context.Context, req *post.GetModerationReviewsRequest) (r *post.GetModerationReviewsResponse, err error) {
+	var _args post.PostServiceGetModerationReviewsArgs
+	_args.Req = req
+	var _result post.PostServiceGetModerationReviewsResult
+	if err = p.c.Call(ctx, "GetModerationReviews", &_args, &_result); err != nil {
+		return
 	}
+	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) CreatePost(ctx context.Context, req *post.CreatePostRequest) (r *post.CreatePostResponse, err error) {
-	var _args post.PostServiceCreatePostArgs
+func (p *kClient) ResolveModerationReview(ctx context.Context, req *post.ResolveModerationReviewRequest) (r *post.ResolveModerationReviewResponse, err error) {
+	var _args post.PostServiceResolveModerationReviewArgs
 	_args.Req = req
-	var _result post.PostServiceCreatePostResult
-	if err = p.c.Call(ctx, "CreatePost", &_args, &_result); err != nil {
+	var _result post.PostServiceResolveModerationReviewResult
+	if err = p.c.Call(ctx, "ResolveModerationReview", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) GetPost(ctx context.Context, req *post.GetPostRequest) (r *post.GetPostResponse, err error) {
-	var _args post.PostServiceGetPostArgs
+func (p *kClient) CreateReport(ctx context.Context, req *post.CreateReportRequest) (r *post.CreateReportResponse, err error) {
+	var _args post.PostServiceCreateReportArgs
 	_args.Req = req
-	var _result post.PostServiceGetPostResult
-	if err = p.c.Call(ctx, "GetPost", &_args, &_result); err != nil {
+	var _result post.PostServiceCreateReportResult
+	if err = p.c.Call(ctx, "CreateReport", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) GetPostList(ctx context.Context, req *post.GetPostListRequest) (r *post.GetPostListResponse, err error) {
-	var _args post.PostServiceGetPostListArgs
+func (p *kClient) GetReportCases(ctx context.Context, req *post.GetReportCasesRequest) (r *post.GetReportCasesResponse, err error) {
+	var _args post.PostServiceGetReportCasesArgs
 	_args.Req = req
-	var _result post.PostServiceGetPostListResult
-	if err = p.c.Call(ctx, "GetPostList", &_args, &_result); err != nil {
+	var _result post.PostServiceGetReportCasesResult
+	if err = p.c.Call(ctx, "GetReportCases", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) UpdatePost(ctx context.Context, req *post.UpdatePostRequest) (r *post.UpdatePostResponse, err error) {
-	var _args post.PostServiceUpdatePostArgs
+func (p *kClient) ResolveReportCase(ctx context.Context, req *post.ResolveReportCaseRequest) (r *post.ResolveReportCaseResponse, err error) {
+	var _args post.PostServiceResolveReportCaseArgs
 	_args.Req = req
-	var _result post.PostServiceUpdatePostResult
-	if err = p.c.Call(ctx, "UpdatePost", &_args, &_result); err != nil {
+	var _result post.PostServiceResolveReportCaseResult
+	if err = p.c.Call(ctx, "ResolveReportCase", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) DeletePost(ctx context.Context, req *post.DeletePostRequest) (r *post.DeletePostResponse, err error) {
-	var _args post.PostServiceDeletePostArgs
+func (p *kClient) CreateAppeal(ctx context.Context, req *post.CreateAppealRequest) (r *post.CreateAppealResponse, err error) {
+	var _args post.PostServiceCreateAppealArgs
 	_args.Req = req
-	var _result post.PostServiceDeletePostResult
-	if err = p.c.Call(ctx, "DeletePost", &_args, &_result); err != nil {
+	var _result post.PostServiceCreateAppealResult
+	if err = p.c.Call(ctx, "CreateAppeal", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMyAppeals(ctx context.Context, req *post.GetMyAppealsRequest) (r *post.GetMyAppealsResponse, err error) {
+	var _args post.PostServiceGetMyAppealsArgs
+	_args.Req = req
+	var _result post.PostServiceGetMyAppealsResult
+	if err = p.c.Call(ctx, "GetMyAppeals", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAppeals(ctx context.Context, req *post.GetAppealsRequest) (r *post.GetAppealsResponse, err error) {
+	var _args post.PostServiceGetAppealsArgs
+	_args.Req = req
+	var _result post.PostServiceGetAppealsResult
+	if err = p.c.Call(ctx, "GetAppeals", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResolveAppeal(ctx context.Context, req *post.ResolveAppealRequest) (r *post.ResolveAppealResponse, err error) {
+	var _args post.PostServiceResolveAppealArgs
+	_args.Req = req
+	var _result post.PostServiceResolveAppealResult
+	if err = p.c.Call(ctx, "ResolveAppeal", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
@@ -849,6 +2214,36 @@ func (p *kClient) SearchPosts(ctx context.Context, req *post.SearchPostsRequest)
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetSearchSuggestions(ctx context.Context, req *post.GetSearchSuggestionsRequest) (r *post.GetSearchSuggestionsResponse, err error) {
+	var _args post.PostServiceGetSearchSuggestionsArgs
+	_args.Req = req
+	var _result post.PostServiceGetSearchSuggestionsResult
+	if err = p.c.Call(ctx, "GetSearchSuggestions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTrendingSearches(ctx context.Context, req *post.GetTrendingSearchesRequest) (r *post.GetTrendingSearchesResponse, err error) {
+	var _args post.PostServiceGetTrendingSearchesArgs
+	_args.Req = req
+	var _result post.PostServiceGetTrendingSearchesResult
+	if err = p.c.Call(ctx, "GetTrendingSearches", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRelatedPosts(ctx context.Context, req *post.GetRelatedPostsRequest) (r *post.GetRelatedPostsResponse, err error) {
+	var _args post.PostServiceGetRelatedPostsArgs
+	_args.Req = req
+	var _result post.PostServiceGetRelatedPostsResult
+	if err = p.c.Call(ctx, "GetRelatedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateTopic(ctx context.Context, req *post.CreateTopicRequest) (r *post.CreateTopicResponse, err error) {
 	var _args post.PostServiceCreateTopicArgs
 	_args.Req = req
@@ -919,6 +2314,46 @@ func (p *kClient) ShareTopic(ctx context.Context, req *post.ShareTopicRequest) (
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) SubscribeTopic(ctx context.Context, req *post.SubscribeTopicRequest) (r *post.SubscribeTopicResponse, err error) {
+	var _args post.PostServiceSubscribeTopicArgs
+	_args.Req = req
+	var _result post.PostServiceSubscribeTopicResult
+	if err = p.c.Call(ctx, "SubscribeTopic", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnsubscribeTopic(ctx context.Context, req *post.UnsubscribeTopicRequest) (r *post.UnsubscribeTopicResponse, err error) {
+	var _args post.PostServiceUnsubscribeTopicArgs
+	_args.Req = req
+	var _result post.PostServiceUnsubscribeTopicResult
+	if err = p.c.Call(ctx, "UnsubscribeTopic", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSubscribedTopics(ctx context.Context, req *post.GetSubscribedTopicsRequest) (r *post.GetSubscribedTopicsResponse, err error) {
+	var _args post.PostServiceGetSubscribedTopicsArgs
+	_args.Req = req
+	var _result post.PostServiceGetSubscribedTopicsResult
+	if err = p.c.Call(ctx, "GetSubscribedTopics", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSubscribedTopicFeed(ctx context.Context, req *post.GetSubscribedTopicFeedRequest) (r *post.GetSubscribedTopicFeedResponse, err error) {
+	var _args post.PostServiceGetSubscribedTopicFeedArgs
+	_args.Req = req
+	var _result post.PostServiceGetSubscribedTopicFeedResult
+	if err = p.c.Call(ctx, "GetSubscribedTopicFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CollectPost(ctx context.Context, req *post.CollectPostRequest) (r *post.CollectPostResponse, err error) {
 	var _args post.PostServiceCollectPostArgs
 	_args.Req = req
@@ -949,6 +2384,66 @@ func (p *kClient) GetCollectedPosts(ctx context.Context, req *post.GetCollectedP
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetFavoriteFolders(ctx context.Context, req *post.GetFavoriteFoldersRequest) (r *post.GetFavoriteFoldersResponse, err error) {
+	var _args post.PostServiceGetFavoriteFoldersArgs
+	_args.Req = req
+	var _result post.PostServiceGetFavoriteFoldersResult
+	if err = p.c.Call(ctx, "GetFavoriteFolders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateFavoriteFolder(ctx context.Context, req *post.CreateFavoriteFolderRequest) (r *post.CreateFavoriteFolderResponse, err error) {
+	var _args post.PostServiceCreateFavoriteFolderArgs
+	_args.Req = req
+	var _result post.PostServiceCreateFavoriteFolderResult
+	if err = p.c.Call(ctx, "CreateFavoriteFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateFavoriteFolder(ctx context.Context, req *post.UpdateFavoriteFolderRequest) (r *post.UpdateFavoriteFolderResponse, err error) {
+	var _args post.PostServiceUpdateFavoriteFolderArgs
+	_args.Req = req
+	var _result post.PostServiceUpdateFavoriteFolderResult
+	if err = p.c.Call(ctx, "UpdateFavoriteFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteFavoriteFolder(ctx context.Context, req *post.DeleteFavoriteFolderRequest) (r *post.DeleteFavoriteFolderResponse, err error) {
+	var _args post.PostServiceDeleteFavoriteFolderArgs
+	_args.Req = req
+	var _result post.PostServiceDeleteFavoriteFolderResult
+	if err = p.c.Call(ctx, "DeleteFavoriteFolder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFavoriteFolderPosts(ctx context.Context, req *post.GetFavoriteFolderPostsRequest) (r *post.GetFavoriteFolderPostsResponse, err error) {
+	var _args post.PostServiceGetFavoriteFolderPostsArgs
+	_args.Req = req
+	var _result post.PostServiceGetFavoriteFolderPostsResult
+	if err = p.c.Call(ctx, "GetFavoriteFolderPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MoveFavorites(ctx context.Context, req *post.MoveFavoritesRequest) (r *post.MoveFavoritesResponse, err error) {
+	var _args post.PostServiceMoveFavoritesArgs
+	_args.Req = req
+	var _result post.PostServiceMoveFavoritesResult
+	if err = p.c.Call(ctx, "MoveFavorites", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) RatePost(ctx context.Context, req *post.RatePostRequest) (r *post.RatePostResponse, err error) {
 	var _args post.PostServiceRatePostArgs
 	_args.Req = req
@@ -998,3 +2493,13 @@ func (p *kClient) GetRatingRank(ctx context.Context, req *post.GetRatingRankRequ
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetPostRatingDistribution(ctx context.Context, req *post.GetPostRatingDistributionRequest) (r *post.GetPostRatingDistributionResponse, err error) {
+	var _args post.PostServiceGetPostRatingDistributionArgs
+	_args.Req = req
+	var _result post.PostServiceGetPostRatingDistributionResult
+	if err = p.c.Call(ctx, "GetPostRatingDistribution", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -6,19 +6,69 @@ import (
 	"hupu/kitex_gen/comment"
 	"hupu/services/comment/repository"
 	"hupu/shared/constants"
+	"hupu/shared/log"
 	"hupu/shared/middleware"
 	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/ranking"
+	"hupu/shared/relation"
+	"hupu/shared/storage"
 	"hupu/shared/utils"
 	"strings"
 )
 
 type CommentHandler struct {
-	db *repository.CommentRepository
+	db        *repository.CommentRepository
+	hot       *ranking.HotRanker
+	topics    *ranking.TopicRanker
+	moderator *moderation.Moderator
+	relations *relation.Relations
 }
 
 func NewCommentHandler() *CommentHandler {
 	return &CommentHandler{
-		db: repository.NewCommentRepository(),
+		db:        repository.NewCommentRepository(),
+		hot:       ranking.NewHotRanker(utils.GetDB(), utils.GetRedisClient()),
+		topics:    ranking.NewTopicRanker(utils.GetDB(), utils.GetRedisClient()),
+		moderator: moderation.NewModerator(utils.GetDB(), utils.GetRedisClient()),
+		relations: relation.NewRelations(utils.GetDB()),
+	}
+}
+
+// recordModeration 记录审核决定，失败只记录日志
+func (h *CommentHandler) recordModeration(ctx context.Context, decision *moderation.Decision, commentID string) {
+	if err := h.moderator.Record(ctx, decision, commentID); err != nil {
+		log.GetLogger().Warnf("record moderation of comment %s failed: %v", commentID, err)
+	}
+}
+
+// checkBlocked 校验评论人是否被帖子作者或被回复的评论作者拉黑，匿名发布的帖子和评论不校验
+func (h *CommentHandler) checkBlocked(ctx context.Context, req *comment.CreateCommentRequest) (int32, string) {
+	postOwnerID, err := h.relations.ContentOwner(ctx, constants.TargetTypePost, req.PostId)
+	if err != nil {
+		return constants.DatabaseErrorCode, fmt.Sprintf("failed to create comment: %s", err)
+	}
+	parentOwnerID := ""
+	if req.GetParentId() != "" {
+		if parentOwnerID, err = h.relations.ContentOwner(ctx, constants.TargetTypeComment, req.GetParentId()); err != nil {
+			return constants.DatabaseErrorCode, fmt.Sprintf("failed to create comment: %s", err)
+		}
+	}
+
+	blocked, err := h.relations.BlockedByAny(ctx, req.UserId, postOwnerID, parentOwnerID)
+	if err != nil {
+		return constants.DatabaseErrorCode, fmt.Sprintf("failed to create comment: %s", err)
+	}
+	if blocked {
+		return constants.UserBlockedCode, fmt.Sprintf("failed to create comment: %s", constants.GetErrorMessage(constants.UserBlockedCode))
+	}
+	return constants.SuccessCode, ""
+}
+
+// refreshPostHotScore 评论数变化后刷新帖子热度，失败只记录日志
+func (h *CommentHandler) refreshPostHotScore(ctx context.Context, postID string) {
+	if err := h.hot.Refresh(ctx, postID); err != nil {
+		log.GetLogger().Warnf("refresh hot score %s failed: %v", postID, err)
 	}
 }
 
@@ -31,6 +81,37 @@ func (h *CommentHandler) CreateComment(ctx context.Context, req *comment.CreateC
 		}, nil
 	}
 
+	// 被封禁或暂停使用的用户不能评论
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &comment.CreateCommentResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create comment: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	// 定时发布等未发布的帖子不能评论
+	published, err := h.db.IsPostPublished(req.PostId)
+	if err != nil {
+		return &comment.CreateCommentResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to create comment: %s", err),
+		}, nil
+	}
+	if !published {
+		return &comment.CreateCommentResponse{
+			Code:    constants.PostNotFoundCode,
+			Message: fmt.Sprintf("failed to create comment: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+		}, nil
+	}
+
+	// 被帖子作者或被回复的评论作者拉黑时不能评论
+	if code, message := h.checkBlocked(ctx, req); code != constants.SuccessCode {
+		return &comment.CreateCommentResponse{
+			Code:    code,
+			Message: message,
+		}, nil
+	}
+
 	// 创建评论
 	commentModel := &models.Comment{
 		PostID:      req.PostId,
@@ -51,11 +132,44 @@ func (h *CommentHandler) CreateComment(ctx context.Context, req *comment.CreateC
 		}
 	}
 
-	// 处理图片
+	// 处理图片，图片必须是评论人通过上传凭证上传的
 	if req.Images != nil {
-		commentModel.Images = models.StringArray(req.Images)
+		images := storage.NormalizeImages(req.Images)
+		store, err := storage.Default()
+		if err == nil {
+			err = storage.ValidateImages(ctx, store, req.UserId, images, nil)
+		}
+		if err != nil {
+			return &comment.CreateCommentResponse{
+				Code:    constants.ValidationErrorCode,
+				Message: fmt.Sprintf("参数验证失败: images: %s", err),
+			}, nil
+		}
+		commentModel.Images = models.StringArray(images)
 	}
 
+	// 内容审核：违规或重复的评论被拒绝，敏感词打码，需要复审的评论通过前不展示
+	decision := h.moderator.Check(ctx, &moderation.Content{
+		TargetType: constants.TargetTypeComment,
+		UserID:     req.UserId,
+		Body:       req.Content,
+	})
+	switch decision.Action {
+	case moderation.ActionReject:
+		h.recordModeration(ctx, decision, "")
+		code := int32(constants.CommentContentRejectedCode)
+		if decision.Reason == moderation.ReasonSpam {
+			code = constants.CommentContentSpamCode
+		}
+		return &comment.CreateCommentResponse{
+			Code:    code,
+			Message: fmt.Sprintf("创建评论失败: %s", constants.GetErrorMessage(code)),
+		}, nil
+	case moderation.ActionReview:
+		commentModel.Status = models.CommentStatusReviewing
+	}
+	commentModel.Content = decision.Body
+
 	newComment, err := h.db.CreateComment(commentModel)
 	if err != nil {
 		return &comment.CreateCommentResponse{
@@ -63,6 +177,15 @@ func (h *CommentHandler) CreateComment(ctx context.Context, req *comment.CreateC
 			Message: fmt.Sprintf("创建评论失败: %s", err),
 		}, nil
 	}
+	h.recordModeration(ctx, decision, newComment.ID)
+
+	// 审核中的评论不计入评论数，复审通过后再刷新热度
+	if newComment.Status == models.CommentStatusNormal {
+		h.refreshPostHotScore(ctx, newComment.PostID)
+		if err := h.topics.ParticipatePost(ctx, newComment.PostID, req.UserId); err != nil {
+			log.GetLogger().Warnf("participate topic of post %s failed: %v", newComment.PostID, err)
+		}
+	}
 
 	return &comment.CreateCommentResponse{
 		Code:    constants.SuccessCode,
@@ -80,7 +203,28 @@ func (h *CommentHandler) GetCommentList(ctx context.Context, req *comment.GetCom
 		}, nil
 	}
 
-	comments, err := h.db.GetCommentList(req.PostId, req.ParentId, req.Page, req.PageSize)
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	// 传入游标时按 (created_at, id) 翻页，忽略page
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return &comment.GetCommentListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get comment list: %s", err),
+		}, nil
+	}
+	offset := 0
+	if cursor == nil {
+		offset = int((req.Page - 1) * req.PageSize)
+	}
+
+	// 不返回当前用户拉黑和屏蔽的用户的评论
+	hiddenIDs, err := h.relations.HiddenIDs(ctx, req.GetViewerId())
 	if err != nil {
 		return &comment.GetCommentListResponse{
 			Code:    constants.DatabaseErrorCode,
@@ -88,6 +232,22 @@ func (h *CommentHandler) GetCommentList(ctx context.Context, req *comment.GetCom
 		}, nil
 	}
 
+	// 多取一条用于判断是否还有下一页
+	comments, err := h.db.GetCommentList(req.PostId, req.ParentId, hiddenIDs, cursor, offset, int(req.PageSize)+1)
+	if err != nil {
+		return &comment.GetCommentListResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("查询评论失败: %s", err),
+		}, nil
+	}
+	hasMore := len(comments) > int(req.PageSize)
+	nextCursor := ""
+	if hasMore {
+		comments = comments[:req.PageSize]
+		last := comments[len(comments)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+
 	// 转换为响应格式
 	var commentList []*comment.Comment
 	for _, c := range comments {
@@ -97,9 +257,10 @@ func (h *CommentHandler) GetCommentList(ctx context.Context, req *comment.GetCom
 		Code:    constants.SuccessCode,
 		Message: "查询成功",
 		Data: &comment.CommentListData{
-			List:    commentList,
-			Total:   int32(len(commentList)),
-			HasMore: false,
+			List:       commentList,
+			Total:      int32(len(commentList)),
+			HasMore:    hasMore,
+			NextCursor: nextCursor,
 		},
 	}, nil
 }
@@ -113,7 +274,15 @@ func (h *CommentHandler) DeleteComment(ctx context.Context, req *comment.DeleteC
 		}, nil
 	}
 
-	err := h.db.DeleteComment(req.CommentId, req.UserId)
+	existing, err := h.db.GetCommentDetail(req.CommentId)
+	if err != nil {
+		return &comment.DeleteCommentResponse{
+			Code:    constants.CommentNotFoundCode,
+			Message: fmt.Sprintf("failed to delete comment, err:%s", constants.GetErrorMessage(constants.CommentNotFoundCode)),
+		}, nil
+	}
+
+	err = h.db.DeleteComment(req.CommentId, req.UserId)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			return &comment.DeleteCommentResponse{
@@ -127,6 +296,8 @@ func (h *CommentHandler) DeleteComment(ctx context.Context, req *comment.DeleteC
 		}, nil
 	}
 
+	h.refreshPostHotScore(ctx, existing.PostID)
+
 	return &comment.DeleteCommentResponse{
 		Code:    constants.SuccessCode,
 		Message: "删除成功",
@@ -160,7 +331,7 @@ func (h *CommentHandler) GetComment(ctx context.Context, req *comment.GetComment
 
 	// 如果需要包含回复
 	if req.IncludeReplies {
-		replies, err := h.db.GetCommentList(commentModel.PostID, &commentModel.ID, 1, 100)
+		replies, err := h.db.GetCommentList(commentModel.PostID, &commentModel.ID, nil, nil, 0, 100)
 		if err == nil {
 			var replyList []*comment.Comment
 			for _, reply := range replies {
@@ -220,6 +391,7 @@ func (h *CommentHandler) convertToCommentResponse(c *models.Comment) *comment.Co
 		ReplyCount:  c.ReplyCount,
 		IsAnonymous: c.IsAnonymous,
 		IsDeleted:   c.IsDeleted,
+		Status:      int32(c.Status),
 		CreatedAt:   c.CreatedAt.Unix(),
 		UpdatedAt:   c.UpdatedAt.Unix(),
 	}
@@ -234,9 +406,7 @@ func (h *CommentHandler) convertToCommentResponse(c *models.Comment) *comment.Co
 		response.Location = c.Location
 	}
 	if len(c.Images) > 0 {
-		images := make([]string, len(c.Images))
-		copy(images, c.Images)
-		response.Images = images
+		response.Images = storage.ImageURLs(c.Images)
 	}
 
 	return response
@@ -247,7 +417,14 @@ func (c *CommentHandler) RateComment(ctx context.Context, req *comment.RateComme
 	if req.UserId == "" || req.CommentId == "" || req.Score < 1 || req.Score > 5 {
 		return &comment.RateCommentResponse{
 			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to rate comment, user id or comment id is empty, score is %d", req.Score),
+			Message: fmt.Sprintf("failed to rate comment, user id or comment id is empty, score is %v", req.Score),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能评分
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &comment.RateCommentResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to rate comment: %s", constants.GetErrorMessage(code)),
 		}, nil
 	}
 
@@ -280,7 +457,14 @@ func (c *CommentHandler) UpdateCommentRating(ctx context.Context, req *comment.U
 	if req.UserId == "" || req.CommentId == "" || req.Score < 1 || req.Score > 5 {
 		return &comment.UpdateCommentRatingResponse{
 			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to update comment rating, user id or comment id is empty, score is %d", req.Score),
+			Message: fmt.Sprintf("failed to update comment rating, user id or comment id is empty, score is %v", req.Score),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能评分
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &comment.UpdateCommentRatingResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update comment rating: %s", constants.GetErrorMessage(code)),
 		}, nil
 	}
 
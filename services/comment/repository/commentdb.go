@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 
 	"hupu/shared/models"
+	"hupu/shared/relation"
 	"hupu/shared/utils"
 )
 
@@ -31,9 +32,27 @@ func (cr *CommentRepository) CreateComment(comment *models.Comment) (*models.Com
 		UserID:   comment.UserID,
 		Content:  comment.Content,
 		ParentID: comment.ParentID,
+		Status:   comment.Status,
 	}
 
-	err := cr.db.Create(newComment).Error
+	// 评论与帖子评论数、父评论回复数在同一事务内维护，审核中的评论复审通过后再计数
+	err := cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newComment).Error; err != nil {
+			return err
+		}
+		if newComment.Status != models.CommentStatusNormal {
+			return nil
+		}
+		if err := tx.Model(&models.Post{}).Where("id = ?", newComment.PostID).
+			UpdateColumn("comment_count", gorm.Expr("comment_count + ?", 1)).Error; err != nil {
+			return err
+		}
+		if newComment.ParentID != nil && *newComment.ParentID != "" {
+			return tx.Model(&models.Comment{}).Where("id = ?", *newComment.ParentID).
+				UpdateColumn("reply_count", gorm.Expr("reply_count + ?", 1)).Error
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +60,10 @@ func (cr *CommentRepository) CreateComment(comment *models.Comment) (*models.Com
 	return newComment, nil
 }
 
-func (cr *CommentRepository) GetCommentList(postID string, parentID *string, page, pageSize int32) ([]*models.Comment, error) {
+// GetCommentList 获取评论列表，按 (created_at, id) 倒序，传入游标时从游标之后开始，不返回 excludeUserIDs 中的用户的非匿名评论
+func (cr *CommentRepository) GetCommentList(postID string, parentID *string, excludeUserIDs []string, cursor *utils.Cursor, offset, limit int) ([]*models.Comment, error) {
 	var comments []models.Comment
-	query := cr.db.Where("post_id = ?", postID)
+	query := cr.db.Scopes(models.NormalComments, relation.ExcludeNamedUsers("user_id", excludeUserIDs)).Where("post_id = ?", postID)
 
 	// 如果指定了父评论ID，则只查询子评论
 	if parentID != nil {
@@ -54,8 +74,8 @@ func (cr *CommentRepository) GetCommentList(postID string, parentID *string, pag
 	}
 
 	// 分页
-	offset := (page - 1) * pageSize
-	err := query.Offset(int(offset)).Limit(int(pageSize)).Order("created_at DESC").Find(&comments).Error
+	err := query.Scopes(utils.TimeCursorScope("created_at", "id", cursor)).
+		Offset(offset).Limit(limit).Order("created_at DESC, id DESC").Find(&comments).Error
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +109,32 @@ func (cr *CommentRepository) UpdateComment(comment *models.Comment) (*models.Com
 	return comment, nil
 }
 
+// DeleteComment 删除评论，同步减少帖子评论数和父评论回复数
 func (cr *CommentRepository) DeleteComment(commentID, userID string) error {
-	return cr.db.Where("id = ? AND user_id = ?", commentID, userID).Delete(&models.Comment{}).Error
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		var comment models.Comment
+		err := tx.Where("id = ? AND user_id = ?", commentID, userID).First(&comment).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+
+		result := tx.Where("id = ?", commentID).Delete(&models.Comment{})
+		if result.Error != nil || result.RowsAffected == 0 || comment.Status != models.CommentStatusNormal {
+			return result.Error
+		}
+		if err := tx.Model(&models.Post{}).Where("id = ? AND comment_count > 0", comment.PostID).
+			UpdateColumn("comment_count", gorm.Expr("comment_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		if comment.ParentID != nil && *comment.ParentID != "" {
+			return tx.Model(&models.Comment{}).Where("id = ? AND reply_count > 0", *comment.ParentID).
+				UpdateColumn("reply_count", gorm.Expr("reply_count - ?", 1)).Error
+		}
+		return nil
+	})
 }
 
 func (cr *CommentRepository) GetCommentDetail(commentID string) (*models.Comment, error) {
@@ -108,7 +152,7 @@ func (cr *CommentRepository) GetCommentDetail(commentID string) (*models.Comment
 func (cr *CommentRepository) GetUserCommentList(userID string, page, pageSize int32) ([]*models.Comment, error) {
 	var comments []models.Comment
 	offset := (page - 1) * pageSize
-	err := cr.db.Where("user_id = ?", userID).Offset(int(offset)).Limit(int(pageSize)).Order("created_at DESC").Find(&comments).Error
+	err := cr.db.Scopes(models.NormalComments).Where("user_id = ?", userID).Offset(int(offset)).Limit(int(pageSize)).Order("created_at DESC").Find(&comments).Error
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +173,7 @@ func (cr *CommentRepository) LikeComment(commentID, userID string) error {
 
 func (cr *CommentRepository) UnlikeComment(commentID, userID string) error {
 	// 减少评论点赞数
-	return cr.db.Model(&models.Comment{}).Where("id = ?", commentID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
+	return cr.db.Model(&models.Comment{}).Where("id = ? AND like_count > 0", commentID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
 }
 
 func (cr *CommentRepository) GetAnonymousAvatar(avatarID string) (*models.AnonymousAvatar, error) {
@@ -143,3 +187,10 @@ func (cr *CommentRepository) GetAnonymousAvatar(avatarID string) (*models.Anonym
 	}
 	return &avatar, nil
 }
+
+// IsPostPublished 帖子是否存在且已发布
+func (cr *CommentRepository) IsPostPublished(postID string) (bool, error) {
+	var count int64
+	err := cr.db.Model(&models.Post{}).Scopes(models.Published).Where("id = ?", postID).Count(&count).Error
+	return count > 0, err
+}
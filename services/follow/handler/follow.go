@@ -7,16 +7,22 @@ import (
 	"hupu/services/follow/repository"
 	"hupu/shared/constants"
 	"hupu/shared/middleware"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/relation"
+	"hupu/shared/utils"
 	"strings"
 )
 
 type FollowHandler struct {
-	db *repository.FollowRepository
+	db        *repository.FollowRepository
+	relations *relation.Relations
 }
 
 func NewFollowHandler() *FollowHandler {
 	return &FollowHandler{
-		db: repository.NewFollowRepository(),
+		db:        repository.NewFollowRepository(),
+		relations: relation.NewRelations(utils.GetDB()),
 	}
 }
 
@@ -29,7 +35,30 @@ func (h *FollowHandler) Follow(ctx context.Context, req *follow.FollowRequest) (
 		}, nil
 	}
 
-	err := h.db.Follow(ctx, req.FollowerId, req.FollowingId)
+	// 被封禁或暂停使用的用户不能关注
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.FollowerId); code != constants.SuccessCode {
+		return &follow.FollowResponse{
+			Code:    code,
+			Message: constants.GetErrorMessage(code),
+		}, nil
+	}
+
+	// 被对方拉黑时不能关注
+	blocked, err := h.relations.BlockedByAny(ctx, req.FollowerId, req.FollowingId)
+	if err != nil {
+		return &follow.FollowResponse{
+			Code:    constants.UserFollowFailCode,
+			Message: fmt.Sprintf("关注失败: %s", err),
+		}, nil
+	}
+	if blocked {
+		return &follow.FollowResponse{
+			Code:    constants.UserBlockedCode,
+			Message: constants.GetErrorMessage(constants.UserBlockedCode),
+		}, nil
+	}
+
+	err = h.db.Follow(ctx, req.FollowerId, req.FollowingId)
 	if err != nil {
 		if strings.Contains(err.Error(), "already following") {
 			return &follow.FollowResponse{
@@ -117,30 +146,40 @@ func (h *FollowHandler) GetFollowList(ctx context.Context, req *follow.GetFollow
 			Message: fmt.Sprintf("failed to validate user id: %s", req.UserId),
 		}, nil
 	}
+	cursor, offset, pageSize, err := parseFollowPage(req.Page, req.PageSize, req.GetCursor())
+	if err != nil {
+		return &follow.GetFollowListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get follow list: %s", err),
+		}, nil
+	}
 
-	followList, err := h.db.GetFollowList(ctx, req.UserId, req.Page, req.PageSize)
+	followList, err := h.db.GetFollowList(ctx, req.UserId, cursor, offset, pageSize+1)
 	if err != nil {
 		return &follow.GetFollowListResponse{
 			Code:    constants.DatabaseErrorCode,
 			Message: fmt.Sprintf("查询关注列表失败: %s", err),
 		}, nil
 	}
+	followList, hasMore, nextCursor := splitFollowPage(followList, pageSize)
 
 	// 转换为Follow结构体数组
 	follows := make([]*follow.Follow, len(followList))
-	for i, userID := range followList {
+	for i, f := range followList {
 		follows[i] = &follow.Follow{
 			FollowerId:  req.UserId,
-			FollowingId: userID,
+			FollowingId: f.FollowingID,
+			CreatedAt:   f.CreatedAt.Unix(),
 		}
 	}
 
 	return &follow.GetFollowListResponse{
-		Code:    constants.SuccessCode,
-		Message: "查询成功",
-		Follows: follows,
-		Total:   int32(len(follows)),
-		HasMore: len(follows) == int(req.PageSize),
+		Code:       constants.SuccessCode,
+		Message:    "查询成功",
+		Follows:    follows,
+		Total:      int32(len(follows)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -152,14 +191,22 @@ func (h *FollowHandler) GetFollowerList(ctx context.Context, req *follow.GetFoll
 			Message: fmt.Sprintf("failed to validate user id: %s", req.UserId),
 		}, nil
 	}
+	cursor, offset, pageSize, err := parseFollowPage(req.Page, req.PageSize, req.GetCursor())
+	if err != nil {
+		return &follow.GetFollowerListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get follower list: %s", err),
+		}, nil
+	}
 
-	follows, err := h.db.GetFollowerList(ctx, req.UserId, req.Page, req.PageSize)
+	follows, err := h.db.GetFollowerList(ctx, req.UserId, cursor, offset, pageSize+1)
 	if err != nil {
 		return &follow.GetFollowerListResponse{
 			Code:    constants.DatabaseErrorCode,
 			Message: fmt.Sprintf("查询粉丝列表失败: %s", err),
 		}, nil
 	}
+	follows, hasMore, nextCursor := splitFollowPage(follows, pageSize)
 
 	// 转换为响应格式
 	var followerList []*follow.Follow
@@ -172,12 +219,43 @@ func (h *FollowHandler) GetFollowerList(ctx context.Context, req *follow.GetFoll
 	}
 
 	return &follow.GetFollowerListResponse{
-		Code:      constants.SuccessCode,
-		Message:   "查询成功",
-		Followers: followerList,
+		Code:       constants.SuccessCode,
+		Message:    "查询成功",
+		Followers:  followerList,
+		Total:      int32(len(followerList)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
+// parseFollowPage 解析分页参数，传入游标时忽略page，返回游标、偏移量和每页条数
+func parseFollowPage(page, pageSize int32, rawCursor string) (*utils.Cursor, int, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	cursor, err := utils.DecodeCursor(rawCursor)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if cursor != nil {
+		return cursor, 0, int(pageSize), nil
+	}
+	return nil, int((page - 1) * pageSize), int(pageSize), nil
+}
+
+// splitFollowPage 截取一页数据，多取的一条用于判断是否还有下一页
+func splitFollowPage(follows []*models.Follow, pageSize int) ([]*models.Follow, bool, string) {
+	if len(follows) <= pageSize {
+		return follows, false, ""
+	}
+	follows = follows[:pageSize]
+	last := follows[len(follows)-1]
+	return follows, true, utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+}
+
 // GetFollowCount 获取关注数量
 func (h *FollowHandler) GetFollowCount(ctx context.Context, req *follow.GetFollowCountRequest) (*follow.GetFollowCountResponse, error) {
 	// 参数验证
@@ -238,6 +316,13 @@ func (h *FollowHandler) GetMutualFollows(ctx context.Context, req *follow.GetMut
 		}, nil
 	}
 
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
 	userIds, err := h.db.GetMutualFollows(ctx, req.UserId, req.TargetUserId, req.Page, req.PageSize)
 	if err != nil {
 		return &follow.GetMutualFollowsResponse{
@@ -246,6 +331,11 @@ func (h *FollowHandler) GetMutualFollows(ctx context.Context, req *follow.GetMut
 		}, nil
 	}
 
+	hasMore := len(userIds) > int(req.PageSize)
+	if hasMore {
+		userIds = userIds[:req.PageSize]
+	}
+
 	// 转换为Follow结构体数组
 	mutualFollows := make([]*follow.Follow, len(userIds))
 	for i, userID := range userIds {
@@ -261,7 +351,7 @@ func (h *FollowHandler) GetMutualFollows(ctx context.Context, req *follow.GetMut
 		Message:       "查询成功",
 		MutualFollows: mutualFollows,
 		Total:         int32(len(mutualFollows)),
-		HasMore:       len(mutualFollows) == int(req.PageSize),
+		HasMore:       hasMore,
 	}, nil
 }
 
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hupu/kitex_gen/follow"
+	"hupu/shared/constants"
+	"hupu/shared/middleware"
+	"hupu/shared/utils"
+)
+
+// relationErrorCode 拉黑和屏蔽操作的错误码
+func relationErrorCode(err error) int32 {
+	switch {
+	case strings.Contains(err.Error(), "yourself"):
+		return constants.UserBlockSelfCode
+	case strings.Contains(err.Error(), "already blocked"):
+		return constants.UserAlreadyBlockedCode
+	case strings.Contains(err.Error(), "not blocked"):
+		return constants.UserNotBlockedCode
+	case strings.Contains(err.Error(), "already muted"):
+		return constants.UserAlreadyMutedCode
+	case strings.Contains(err.Error(), "not muted"):
+		return constants.UserNotMutedCode
+	}
+	return constants.DatabaseErrorCode
+}
+
+// Block 拉黑用户，同时解除双方的关注关系
+func (h *FollowHandler) Block(ctx context.Context, req *follow.RelationRequest) (*follow.RelationResponse, error) {
+	return h.changeRelation(ctx, req, h.relations.Block, "拉黑成功", constants.MsgBlockFailed)
+}
+
+// Unblock 取消拉黑
+func (h *FollowHandler) Unblock(ctx context.Context, req *follow.RelationRequest) (*follow.RelationResponse, error) {
+	return h.changeRelation(ctx, req, h.relations.Unblock, "取消拉黑成功", constants.MsgUnblockFailed)
+}
+
+// Mute 屏蔽用户
+func (h *FollowHandler) Mute(ctx context.Context, req *follow.RelationRequest) (*follow.RelationResponse, error) {
+	return h.changeRelation(ctx, req, h.relations.Mute, "屏蔽成功", constants.MsgMuteFailed)
+}
+
+// Unmute 取消屏蔽
+func (h *FollowHandler) Unmute(ctx context.Context, req *follow.RelationRequest) (*follow.RelationResponse, error) {
+	return h.changeRelation(ctx, req, h.relations.Unmute, "取消屏蔽成功", constants.MsgUnmuteFailed)
+}
+
+// changeRelation 校验参数后修改拉黑或屏蔽关系
+func (h *FollowHandler) changeRelation(ctx context.Context, req *follow.RelationRequest,
+	change func(ctx context.Context, userID, targetID string) error, successMsg, failMsg string) (*follow.RelationResponse, error) {
+	if req.UserId != "" && req.UserId == req.TargetUserId {
+		return &follow.RelationResponse{
+			Code:    constants.UserBlockSelfCode,
+			Message: constants.GetErrorMessage(constants.UserBlockSelfCode),
+		}, nil
+	}
+	if err := middleware.ValidateFollowOperation(req.UserId, req.TargetUserId); err != nil {
+		return &follow.RelationResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: constants.GetErrorMessage(constants.ValidationErrorCode),
+		}, nil
+	}
+
+	if err := change(ctx, req.UserId, req.TargetUserId); err != nil {
+		code := relationErrorCode(err)
+		message := constants.GetErrorMessage(code)
+		if code == constants.DatabaseErrorCode {
+			message = fmt.Sprintf("%s: %s", failMsg, err)
+		}
+		return &follow.RelationResponse{
+			Code:    code,
+			Message: message,
+		}, nil
+	}
+
+	return &follow.RelationResponse{
+		Code:    constants.SuccessCode,
+		Message: successMsg,
+	}, nil
+}
+
+// GetBlockList 获取黑名单，按拉黑时间倒序
+func (h *FollowHandler) GetBlockList(ctx context.Context, req *follow.GetRelationListRequest) (*follow.GetRelationListResponse, error) {
+	if req.UserId == "" {
+		return &follow.GetRelationListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to validate user id: %s", req.UserId),
+		}, nil
+	}
+	cursor, offset, pageSize, err := parseFollowPage(req.Page, req.PageSize, req.GetCursor())
+	if err != nil {
+		return &follow.GetRelationListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get block list: %s", err),
+		}, nil
+	}
+
+	blocks, err := h.relations.ListBlocks(ctx, req.UserId, cursor, offset, pageSize+1)
+	if err != nil {
+		return &follow.GetRelationListResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("%s: %s", constants.MsgGetBlockListFailed, err),
+		}, nil
+	}
+
+	// 多取的一条用于判断是否还有下一页
+	hasMore, nextCursor := len(blocks) > pageSize, ""
+	if hasMore {
+		blocks = blocks[:pageSize]
+		last := blocks[len(blocks)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+	users := make([]*follow.RelationUser, 0, len(blocks))
+	for _, b := range blocks {
+		users = append(users, &follow.RelationUser{
+			UserId:    b.BlockedID,
+			CreatedAt: b.CreatedAt.Unix(),
+		})
+	}
+
+	return &follow.GetRelationListResponse{
+		Code:       constants.SuccessCode,
+		Message:    "查询成功",
+		Users:      users,
+		Total:      int32(len(users)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetMuteList 获取屏蔽列表，按屏蔽时间倒序
+func (h *FollowHandler) GetMuteList(ctx context.Context, req *follow.GetRelationListRequest) (*follow.GetRelationListResponse, error) {
+	if req.UserId == "" {
+		return &follow.GetRelationListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to validate user id: %s", req.UserId),
+		}, nil
+	}
+	cursor, offset, pageSize, err := parseFollowPage(req.Page, req.PageSize, req.GetCursor())
+	if err != nil {
+		return &follow.GetRelationListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get mute list: %s", err),
+		}, nil
+	}
+
+	mutes, err := h.relations.ListMutes(ctx, req.UserId, cursor, offset, pageSize+1)
+	if err != nil {
+		return &follow.GetRelationListResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("%s: %s", constants.MsgGetMuteListFailed, err),
+		}, nil
+	}
+
+	// 多取的一条用于判断是否还有下一页
+	hasMore, nextCursor := len(mutes) > pageSize, ""
+	if hasMore {
+		mutes = mutes[:pageSize]
+		last := mutes[len(mutes)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+	users := make([]*follow.RelationUser, 0, len(mutes))
+	for _, m := range mutes {
+		users = append(users, &follow.RelationUser{
+			UserId:    m.MutedID,
+			CreatedAt: m.CreatedAt.Unix(),
+		})
+	}
+
+	return &follow.GetRelationListResponse{
+		Code:       constants.SuccessCode,
+		Message:    "查询成功",
+		Users:      users,
+		Total:      int32(len(users)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
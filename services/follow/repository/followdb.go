@@ -39,11 +39,33 @@ func (fr *FollowRepository) Follow(ctx context.Context, followerID, followingID
 		FollowingID: followingID,
 	}
 
-	return fr.db.Create(&newFollow).Error
+	// 关注关系与双方的关注数、粉丝数在同一事务内维护
+	return fr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newFollow).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.User{}).Where("id = ?", followerID).
+			UpdateColumn("following_count", gorm.Expr("following_count + ?", 1)).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.User{}).Where("id = ?", followingID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count + ?", 1)).Error
+	})
 }
 
 func (fr *FollowRepository) Unfollow(ctx context.Context, followerID, followingID string) error {
-	return fr.db.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{}).Error
+	return fr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		if err := tx.Model(&models.User{}).Where("id = ? AND following_count > 0", followerID).
+			UpdateColumn("following_count", gorm.Expr("following_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.User{}).Where("id = ? AND follower_count > 0", followingID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count - ?", 1)).Error
+	})
 }
 
 func (fr *FollowRepository) IsFollowing(ctx context.Context, followerID, followingID string) (bool, error) {
@@ -58,38 +80,33 @@ func (fr *FollowRepository) IsFollowing(ctx context.Context, followerID, followi
 	return true, nil
 }
 
-func (fr *FollowRepository) GetFollowList(ctx context.Context, userID string, page, pageSize int32) ([]string, error) {
-	var follows []models.Follow
-	offset := (page - 1) * pageSize
-	err := fr.db.Where("follower_id = ?", userID).Offset(int(offset)).Limit(int(pageSize)).Find(&follows).Error
-	if err != nil {
-		return nil, err
-	}
-
-	// 转换为用户ID列表
-	var followList []string
-	for _, f := range follows {
-		followList = append(followList, f.FollowingID)
-	}
+// GetFollowList 获取用户的关注记录，按 (created_at, id) 倒序，传入游标时从游标之后开始
+func (fr *FollowRepository) GetFollowList(ctx context.Context, userID string, cursor *utils.Cursor, offset, limit int) ([]*models.Follow, error) {
+	return fr.listFollows(ctx, "follower_id = ?", userID, cursor, offset, limit)
+}
 
-	return followList, nil
+// GetFollowerList 获取用户的粉丝记录，排序和游标规则同 GetFollowList
+func (fr *FollowRepository) GetFollowerList(ctx context.Context, userID string, cursor *utils.Cursor, offset, limit int) ([]*models.Follow, error) {
+	return fr.listFollows(ctx, "following_id = ?", userID, cursor, offset, limit)
 }
 
-func (fr *FollowRepository) GetFollowerList(ctx context.Context, userID string, page, pageSize int32) ([]*models.Follow, error) {
+func (fr *FollowRepository) listFollows(ctx context.Context, condition, userID string, cursor *utils.Cursor, offset, limit int) ([]*models.Follow, error) {
 	var follows []models.Follow
-	offset := (page - 1) * pageSize
-	err := fr.db.Where("following_id = ?", userID).Offset(int(offset)).Limit(int(pageSize)).Find(&follows).Error
+	err := fr.db.WithContext(ctx).Where(condition, userID).
+		Scopes(utils.TimeCursorScope("created_at", "id", cursor)).
+		Order("created_at DESC, id DESC").
+		Offset(offset).Limit(limit).Find(&follows).Error
 	if err != nil {
 		return nil, err
 	}
 
 	// 转换为指针切片
-	var followerList []*models.Follow
+	followList := make([]*models.Follow, 0, len(follows))
 	for i := range follows {
-		followerList = append(followerList, &follows[i])
+		followList = append(followList, &follows[i])
 	}
 
-	return followerList, nil
+	return followList, nil
 }
 
 func (fr *FollowRepository) GetFollowCount(ctx context.Context, userID string) (int64, error) {
@@ -118,8 +135,9 @@ func (fr *FollowRepository) GetMutualFollows(ctx context.Context, userID1, userI
 	err := fr.db.Model(&models.Follow{}).
 		Select("following_id").
 		Where("follower_id = ? AND following_id IN (?)", subQuery1, subQuery2).
+		Order("following_id").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize)+1). // 多取一条用于判断 has_more
 		Pluck("following_id", &mutualFollows).Error
 
 	return mutualFollows, err
@@ -4,20 +4,95 @@ import (
 	"context"
 	"hupu/kitex_gen/like"
 	"hupu/services/like/repository"
+	"hupu/shared/cache"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/moderation"
+	"hupu/shared/ranking"
+	"hupu/shared/relation"
+	"hupu/shared/utils"
 )
 
 type LikeHandler struct {
-	db *repository.LikeRepository
+	db        *repository.LikeRepository
+	postCache *cache.PostCache
+	hot       *ranking.HotRanker
+	relations *relation.Relations
 }
 
 func NewLikeHandler() *LikeHandler {
 	return &LikeHandler{
-		db: repository.NewLikeRepository(),
+		db:        repository.NewLikeRepository(),
+		postCache: cache.NewPostCache(utils.GetRedisClient()),
+		hot:       ranking.NewHotRanker(utils.GetDB(), utils.GetRedisClient()),
+		relations: relation.NewRelations(utils.GetDB()),
+	}
+}
+
+// onPostLikeChanged 帖子点赞数变化后失效帖子列表缓存并刷新热度
+func (h *LikeHandler) onPostLikeChanged(ctx context.Context, targetID, targetType string) {
+	if targetType != constants.TargetTypePost {
+		return
+	}
+	if err := h.postCache.InvalidatePost(ctx, targetID); err != nil {
+		log.GetLogger().Warnf("InvalidatePost %s failed: %v", targetID, err)
+	}
+	if err := h.hot.Refresh(ctx, targetID); err != nil {
+		log.GetLogger().Warnf("refresh hot score %s failed: %v", targetID, err)
 	}
 }
 
 func (h *LikeHandler) Like(ctx context.Context, req *like.LikeRequest) (*like.LikeResponse, error) {
-	err := h.db.Like(ctx, req.UserId, req.TargetId, req.TargetType)
+	// 被封禁或暂停使用的用户不能点赞
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &like.LikeResponse{
+			Code:    code,
+			Message: constants.GetErrorMessage(code),
+		}, nil
+	}
+
+	// 定时发布等未发布的帖子及其评论不能点赞
+	published, err := h.db.TargetPublished(ctx, req.TargetId, req.TargetType)
+	if err != nil {
+		return &like.LikeResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: err.Error(),
+		}, nil
+	}
+	if !published {
+		code := int32(constants.PostNotFoundCode)
+		if req.TargetType == constants.TargetTypeComment {
+			code = constants.CommentNotFoundCode
+		}
+		return &like.LikeResponse{
+			Code:    code,
+			Message: constants.GetErrorMessage(code),
+		}, nil
+	}
+
+	// 被作者拉黑后不能点赞其帖子和评论，匿名内容不校验
+	ownerID, err := h.relations.ContentOwner(ctx, req.TargetType, req.TargetId)
+	if err != nil {
+		return &like.LikeResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: err.Error(),
+		}, nil
+	}
+	blocked, err := h.relations.BlockedByAny(ctx, req.UserId, ownerID)
+	if err != nil {
+		return &like.LikeResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: err.Error(),
+		}, nil
+	}
+	if blocked {
+		return &like.LikeResponse{
+			Code:    constants.UserBlockedCode,
+			Message: constants.GetErrorMessage(constants.UserBlockedCode),
+		}, nil
+	}
+
+	err = h.db.Like(ctx, req.UserId, req.TargetId, req.TargetType)
 	if err != nil {
 		return &like.LikeResponse{
 			Code:    400,
@@ -25,6 +100,8 @@ func (h *LikeHandler) Like(ctx context.Context, req *like.LikeRequest) (*like.Li
 		}, nil
 	}
 
+	h.onPostLikeChanged(ctx, req.TargetId, req.TargetType)
+
 	return &like.LikeResponse{
 		Code:    0,
 		Message: "点赞成功",
@@ -40,6 +117,8 @@ func (h *LikeHandler) Unlike(ctx context.Context, req *like.UnlikeRequest) (*lik
 		}, err
 	}
 
+	h.onPostLikeChanged(ctx, req.TargetId, req.TargetType)
+
 	return &like.UnlikeResponse{
 		Code:    0,
 		Message: "取消点赞成功",
@@ -114,7 +193,15 @@ func (h *LikeHandler) GetLikeCount(ctx context.Context, req *like.GetLikeCountRe
 
 // GetLikeUsers 获取点赞用户列表
 func (h *LikeHandler) GetLikeUsers(ctx context.Context, req *like.GetLikeUsersRequest) (*like.GetLikeUsersResponse, error) {
-	userIDs, err := h.db.GetLikeUsers(ctx, req.TargetId, req.TargetType, req.Page, req.PageSize)
+	// 不返回查看者拉黑和屏蔽的用户
+	hiddenIDs, err := h.relations.HiddenIDs(ctx, req.GetViewerId())
+	if err != nil {
+		return &like.GetLikeUsersResponse{
+			Code:    500,
+			Message: "查询点赞用户失败",
+		}, err
+	}
+	userIDs, err := h.db.GetLikeUsers(ctx, req.TargetId, req.TargetType, hiddenIDs, req.Page, req.PageSize)
 	if err != nil {
 		return &like.GetLikeUsersResponse{
 			Code:    500,
@@ -6,7 +6,9 @@ import (
 
 	"gorm.io/gorm"
 
+	"hupu/shared/constants"
 	"hupu/shared/models"
+	"hupu/shared/relation"
 	"hupu/shared/utils"
 )
 
@@ -20,6 +22,22 @@ func NewLikeRepository() *LikeRepository {
 	}
 }
 
+// TargetPublished 点赞对象是否存在且所属帖子已发布，评论按其所在的帖子判断
+func (lr *LikeRepository) TargetPublished(ctx context.Context, targetID, targetType string) (bool, error) {
+	var count int64
+	query := lr.db.WithContext(ctx).Model(&models.Post{}).Scopes(models.Published)
+	switch targetType {
+	case constants.TargetTypePost:
+		query = query.Where("posts.id = ?", targetID)
+	case constants.TargetTypeComment:
+		query = query.Joins("JOIN comments ON comments.post_id = posts.id").Where("comments.id = ?", targetID)
+	default:
+		return false, nil
+	}
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
 func (lr *LikeRepository) Like(ctx context.Context, userID, targetID, targetType string) error {
 	// 检查是否已经点赞
 	var existLike models.Like
@@ -65,17 +83,23 @@ func (lr *LikeRepository) Unlike(ctx context.Context, userID, targetID, targetTy
 	tx := lr.db.Begin()
 
 	// 删除点赞记录
-	err := tx.Where("user_id = ? AND target_id = ? AND target_type = ?", userID, targetID, targetType).Delete(&models.Like{}).Error
-	if err != nil {
+	result := tx.Where("user_id = ? AND target_id = ? AND target_type = ?", userID, targetID, targetType).Delete(&models.Like{})
+	if result.Error != nil {
 		tx.Rollback()
-		return err
+		return result.Error
+	}
+	// 没有点赞过时不减少点赞数
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return nil
 	}
 
-	// 更新目标对象的点赞数
+	// 更新目标对象的点赞数，不会减到负数
+	var err error
 	if targetType == "post" {
-		err = tx.Model(&models.Post{}).Where("id = ?", targetID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
+		err = tx.Model(&models.Post{}).Where("id = ? AND like_count > 0", targetID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
 	} else if targetType == "comment" {
-		err = tx.Model(&models.Comment{}).Where("id = ?", targetID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
+		err = tx.Model(&models.Comment{}).Where("id = ? AND like_count > 0", targetID).UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error
 	}
 
 	if err != nil {
@@ -122,12 +146,13 @@ func (lr *LikeRepository) GetLikeCount(ctx context.Context, targetID, targetType
 	return count, err
 }
 
-func (lr *LikeRepository) GetLikeUsers(ctx context.Context, targetID, targetType string, page, pageSize int32) ([]string, error) {
+func (lr *LikeRepository) GetLikeUsers(ctx context.Context, targetID, targetType string, excludeUserIDs []string, page, pageSize int32) ([]string, error) {
 	var userIDs []string
 	offset := (page - 1) * pageSize
 	err := lr.db.Model(&models.Like{}).
 		Select("user_id").
 		Where("target_id = ? AND target_type = ?", targetID, targetType).
+		Scopes(relation.ExcludeUsers("user_id", excludeUserIDs)).
 		Offset(int(offset)).
 		Limit(int(pageSize)).
 		Order("created_at DESC").
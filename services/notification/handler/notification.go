@@ -4,6 +4,7 @@ import (
 	"context"
 	"hupu/kitex_gen/notification"
 	"hupu/shared/models"
+	"hupu/shared/relation"
 	"hupu/shared/utils"
 
 	"github.com/rs/xid"
@@ -11,16 +12,43 @@ import (
 )
 
 type NotificationHandler struct {
-	db *gorm.DB
+	db        *gorm.DB
+	relations *relation.Relations
 }
 
 func NewNotificationHandler() *NotificationHandler {
 	return &NotificationHandler{
-		db: utils.GetDB(),
+		db:        utils.GetDB(),
+		relations: relation.NewRelations(utils.GetDB()),
+	}
+}
+
+// excludeSenders 排除指定用户触发的通知，没有发送者的系统通知不受影响
+func excludeSenders(senderIDs []string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(senderIDs) == 0 {
+			return db
+		}
+		return db.Where("(sender_id IS NULL OR sender_id NOT IN ?)", senderIDs)
 	}
 }
 
 func (h *NotificationHandler) CreateNotification(ctx context.Context, req *notification.CreateNotificationRequest) (*notification.CreateNotificationResponse, error) {
+	// 接收者拉黑了发送者时不发送通知
+	blocked, err := h.relations.BlockedByAny(ctx, req.GetSenderId(), req.UserId)
+	if err != nil {
+		return &notification.CreateNotificationResponse{
+			Code:    500,
+			Message: "创建通知失败",
+		}, err
+	}
+	if blocked {
+		return &notification.CreateNotificationResponse{
+			Code:    200,
+			Message: "接收者已拉黑发送者，通知未发送",
+		}, nil
+	}
+
 	// 生成通知ID
 	notificationID := xid.New().String()
 
@@ -32,10 +60,11 @@ func (h *NotificationHandler) CreateNotification(ctx context.Context, req *notif
 		Title:    req.Title,
 		Content:  req.Content,
 		TargetID: req.TargetId,
+		SenderID: req.SenderId,
 		IsRead:   false,
 	}
 
-	err := h.db.Create(&newNotification).Error
+	err = h.db.Create(&newNotification).Error
 	if err != nil {
 		return &notification.CreateNotificationResponse{
 			Code:    500,
@@ -44,28 +73,32 @@ func (h *NotificationHandler) CreateNotification(ctx context.Context, req *notif
 	}
 
 	return &notification.CreateNotificationResponse{
-		Code:    200,
-		Message: "创建成功",
-		Notification: &notification.Notification{
-			Id:        newNotification.ID,
-			UserId:    newNotification.UserID,
-			Type:      notification.NotificationType(newNotification.Type),
-			Title:     newNotification.Title,
-			Content:   newNotification.Content,
-			TargetId:  newNotification.TargetID,
-			IsRead:    newNotification.IsRead,
-			CreatedAt: newNotification.CreatedAt.Unix(),
-		},
+		Code:         200,
+		Message:      "创建成功",
+		Notification: h.convertToNotificationResponse(&newNotification),
 	}, nil
 }
 
 func (h *NotificationHandler) GetNotificationList(ctx context.Context, req *notification.GetNotificationListRequest) (*notification.GetNotificationListResponse, error) {
 	var notifications []models.Notification
-	offset := (req.Page - 1) * req.PageSize
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
 
-	query := h.db.Where("user_id = ?", req.UserId)
+	// 传入游标时按 (created_at, id) 翻页，忽略page
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return &notification.GetNotificationListResponse{
+			Code:    400,
+			Message: "游标无效",
+		}, nil
+	}
 
-	err := query.Offset(int(offset)).Limit(int(req.PageSize)).Order("created_at DESC").Find(&notifications).Error
+	// 不返回已拉黑用户触发的通知
+	blockedIDs, err := h.relations.BlockedIDs(ctx, req.UserId)
 	if err != nil {
 		return &notification.GetNotificationListResponse{
 			Code:    500,
@@ -73,9 +106,32 @@ func (h *NotificationHandler) GetNotificationList(ctx context.Context, req *noti
 		}, err
 	}
 
+	query := h.db.Where("user_id = ?", req.UserId).Scopes(excludeSenders(blockedIDs))
+	if cursor != nil {
+		query = query.Scopes(utils.TimeCursorScope("created_at", "id", cursor))
+	} else {
+		query = query.Offset(int((req.Page - 1) * req.PageSize))
+	}
+
+	// 多取一条用于判断是否还有下一页
+	err = query.Limit(int(req.PageSize) + 1).Order("created_at DESC, id DESC").Find(&notifications).Error
+	if err != nil {
+		return &notification.GetNotificationListResponse{
+			Code:    500,
+			Message: "查询通知列表失败",
+		}, err
+	}
+	hasMore := len(notifications) > int(req.PageSize)
+	nextCursor := ""
+	if hasMore {
+		notifications = notifications[:req.PageSize]
+		last := notifications[len(notifications)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+
 	// 获取总数
 	var total int64
-	h.db.Model(&models.Notification{}).Where("user_id = ?", req.UserId).Count(&total)
+	h.db.Model(&models.Notification{}).Where("user_id = ?", req.UserId).Scopes(excludeSenders(blockedIDs)).Count(&total)
 
 	// 转换为响应格式
 	var notificationList []*notification.Notification
@@ -88,6 +144,8 @@ func (h *NotificationHandler) GetNotificationList(ctx context.Context, req *noti
 		Message:       "查询成功",
 		Notifications: notificationList,
 		Total:         int32(total),
+		HasMore:       hasMore,
+		NextCursor:    nextCursor,
 	}, nil
 }
 
@@ -134,15 +192,24 @@ func (h *NotificationHandler) MarkAllNotificationsRead(ctx context.Context, req
 
 // GetUnreadCount 获取未读通知数量
 func (h *NotificationHandler) GetUnreadCount(ctx context.Context, req *notification.GetUnreadCountRequest) (*notification.GetUnreadCountResponse, error) {
+	blockedIDs, err := h.relations.BlockedIDs(ctx, req.UserId)
+	if err != nil {
+		return &notification.GetUnreadCountResponse{
+			Code:    500,
+			Message: "查询失败",
+		}, err
+	}
+
 	var count int64
-	query := h.db.Model(&models.Notification{}).Where("user_id = ? AND is_read = ?", req.UserId, false)
+	query := h.db.Model(&models.Notification{}).Where("user_id = ? AND is_read = ?", req.UserId, false).
+		Scopes(excludeSenders(blockedIDs))
 
 	// 如果指定了类型，只统计特定类型的通知
 	if req.Type != nil {
 		query = query.Where("type = ?", *req.Type)
 	}
 
-	err := query.Count(&count).Error
+	err = query.Count(&count).Error
 	if err != nil {
 		return &notification.GetUnreadCountResponse{
 			Code:    500,
@@ -184,5 +251,6 @@ func (h *NotificationHandler) convertToNotificationResponse(n *models.Notificati
 		TargetId:  n.TargetID,
 		IsRead:    n.IsRead,
 		CreatedAt: n.CreatedAt.Unix(),
+		SenderId:  n.SenderID,
 	}
 }
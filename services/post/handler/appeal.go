@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+)
+
+// appealStatementMaxLength 申诉理由的最大长度
+const appealStatementMaxLength = 1000
+
+// CreateAppeal 对被移除的帖子或被封禁、暂停使用的账号提交申诉
+func (h *PostHandler) CreateAppeal(ctx context.Context, req *post.CreateAppealRequest) (*post.CreateAppealResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("CreateAppeal req: %v", req)
+
+	// 申诉账号时对象为用户本人
+	targetID := req.GetTargetId()
+	if req.TargetType == constants.TargetTypeUser {
+		targetID = req.UserId
+	}
+	statement := strings.TrimSpace(req.Statement)
+	errorMsg := ""
+	switch {
+	case req.UserId == "":
+		errorMsg = "user_id: user_id is required; "
+	case req.TargetType != constants.TargetTypePost && req.TargetType != constants.TargetTypeUser:
+		errorMsg = "target_type: invalid target type; "
+	case targetID == "":
+		errorMsg = "target_id: target_id is required; "
+	case statement == "":
+		errorMsg = "statement: 申诉理由不能为空; "
+	case utf8.RuneCountInString(statement) > appealStatementMaxLength:
+		errorMsg = fmt.Sprintf("statement: 申诉理由不能超过%d个字; ", appealStatementMaxLength)
+	}
+	if errorMsg != "" {
+		return &post.CreateAppealResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: " + errorMsg,
+		}, nil
+	}
+
+	appeal, err := h.moderator.CreateAppeal(ctx, req.UserId, req.TargetType, targetID, statement)
+	if err != nil {
+		logger.Errorf("CreateAppeal failed: %s", err)
+		code := int32(constants.DatabaseErrorCode)
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			code = constants.PostNotFoundCode
+		case strings.Contains(err.Error(), "not allowed"):
+			code = constants.PostAppealNotAllowedCode
+		case strings.Contains(err.Error(), "already exists"):
+			code = constants.PostAppealExistsCode
+		case strings.Contains(err.Error(), "already upheld"):
+			code = constants.PostAppealUpheldCode
+		}
+		return &post.CreateAppealResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create appeal: %s", err),
+		}, nil
+	}
+
+	return &post.CreateAppealResponse{
+		Code:    constants.SuccessCode,
+		Message: "申诉已提交",
+		Appeal:  appealToKitex(appeal, false),
+	}, nil
+}
+
+// GetMyAppeals 获取当前用户的申诉
+func (h *PostHandler) GetMyAppeals(ctx context.Context, req *post.GetMyAppealsRequest) (*post.GetMyAppealsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetMyAppeals req: %v", req)
+
+	if req.UserId == "" {
+		return &post.GetMyAppealsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get appeals: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	appeals, total, err := h.moderator.ListUserAppeals(ctx, req.UserId, int(page), int(pageSize))
+	if err != nil {
+		logger.Errorf("GetMyAppeals failed: %s", err)
+		return &post.GetMyAppealsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get appeals: %s", err),
+		}, nil
+	}
+
+	appealList := make([]*post.Appeal, 0, len(appeals))
+	for _, a := range appeals {
+		appealList = append(appealList, appealToKitex(a, false))
+	}
+	return &post.GetMyAppealsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Appeals: appealList,
+		Total:   int32(total),
+		HasMore: int64(page)*int64(pageSize) < total,
+	}, nil
+}
+
+// GetAppeals 获取申诉队列，仅管理员
+func (h *PostHandler) GetAppeals(ctx context.Context, req *post.GetAppealsRequest) (*post.GetAppealsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetAppeals req: %v", req)
+
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.GetAppealsResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to get appeals: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+	status := req.GetStatus()
+	if status == "" {
+		status = models.AppealStatusOpen
+	}
+	if status != models.AppealStatusOpen && status != models.AppealStatusUpheld && status != models.AppealStatusOverturned {
+		return &post.GetAppealsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: status: invalid status; ",
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	appeals, total, err := h.moderator.ListAppeals(ctx, status, int(page), int(pageSize))
+	if err != nil {
+		logger.Errorf("GetAppeals failed: %s", err)
+		return &post.GetAppealsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get appeals: %s", err),
+		}, nil
+	}
+
+	appealList := make([]*post.Appeal, 0, len(appeals))
+	for _, a := range appeals {
+		appealList = append(appealList, appealToKitex(a, true))
+	}
+	return &post.GetAppealsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Appeals: appealList,
+		Total:   int32(total),
+		HasMore: int64(page)*int64(pageSize) < total,
+	}, nil
+}
+
+// ResolveAppeal 复核申诉，仅管理员，且不能是作出原处理的管理员
+// 撤销原处理时恢复帖子或账号，复核结果通知申诉人
+func (h *PostHandler) ResolveAppeal(ctx context.Context, req *post.ResolveAppealRequest) (*post.ResolveAppealResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ResolveAppeal req: %v", req)
+
+	if req.AppealId == "" || req.OperatorId == "" {
+		return &post.ResolveAppealResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to resolve appeal: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.ResolveAppealResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to resolve appeal: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+
+	appeal, restoredPostIDs, err := h.moderator.ResolveAppeal(ctx, req.AppealId, req.OperatorId, req.Overturn, strings.TrimSpace(req.GetNote()))
+	if err != nil {
+		logger.Errorf("ResolveAppeal failed: %s", err)
+		code := int32(constants.DatabaseErrorCode)
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			code = constants.PostAppealNotFoundCode
+		case strings.Contains(err.Error(), "already resolved"):
+			code = constants.PostAppealResolvedCode
+		case strings.Contains(err.Error(), "original decision"):
+			code = constants.PostAppealReviewerCode
+		}
+		return &post.ResolveAppealResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to resolve appeal: %s", err),
+		}, nil
+	}
+
+	// 恢复的帖子重新进入列表、热榜和搜索索引，恢复评论的帖子刷新评论数
+	for _, postID := range restoredPostIDs {
+		h.onPostChanged(ctx, postID)
+	}
+
+	return &post.ResolveAppealResponse{
+		Code:    constants.SuccessCode,
+		Message: "复核成功",
+		Appeal:  appealToKitex(appeal, true),
+	}, nil
+}
+
+// appealToKitex 转换申诉，作出原处理的管理员只返回给管理员
+func appealToKitex(a *models.Appeal, withDecider bool) *post.Appeal {
+	appeal := &post.Appeal{
+		Id:         a.ID,
+		UserId:     a.UserID,
+		TargetType: a.TargetType,
+		TargetId:   a.TargetID,
+		Statement:  a.Statement,
+		Status:     a.Status,
+		ReviewNote: a.ReviewNote,
+		CreatedAt:  a.CreatedAt.Unix(),
+		UpdatedAt:  a.UpdatedAt.Unix(),
+	}
+	if withDecider {
+		appeal.DeciderId = a.DeciderID
+		appeal.ReviewerId = a.ReviewerID
+	}
+	if a.ReviewedAt != nil {
+		reviewedAt := a.ReviewedAt.Unix()
+		appeal.ReviewedAt = &reviewedAt
+	}
+	return appeal
+}
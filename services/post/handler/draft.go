@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/middleware"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/storage"
+	"hupu/shared/utils"
+)
+
+// draftRetentionDays 草稿保留天数
+func draftRetentionDays() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.DraftRetentionDays > 0 {
+		return config.GlobalConfig.Post.DraftRetentionDays
+	}
+	return constants.DefaultDraftRetentionDays
+}
+
+// validateDraft 校验草稿内容，校验失败时返回错误信息
+func validateDraft(userID, title, content string) string {
+	validationErrors := middleware.ValidateDraftSave(userID, title, content)
+	if len(validationErrors) == 0 {
+		return ""
+	}
+	errorMsg := "参数验证失败: "
+	for _, ve := range validationErrors {
+		errorMsg += fmt.Sprintf("%s: %s; ", ve.Field, ve.Message)
+	}
+	return errorMsg
+}
+
+// draftToCreatePostRequest 草稿转换为发帖请求，发布时复用发帖校验
+func draftToCreatePostRequest(draft *models.PostDraft) *post.CreatePostRequest {
+	return &post.CreatePostRequest{
+		UserId:             draft.UserID,
+		Title:              draft.Title,
+		Content:            draft.Content,
+		Images:             draft.Images,
+		TopicId:            draft.TopicID,
+		Category:           post.PostCategory(draft.Category),
+		IsAnonymous:        draft.IsAnonymous,
+		Location:           draft.Location,
+		Tags:               draft.Tags,
+		AnonymousProfileId: draft.AnonymousProfileID,
+	}
+}
+
+// CreateDraft 创建草稿
+func (h *PostHandler) CreateDraft(ctx context.Context, req *post.CreateDraftRequest) (*post.CreateDraftResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+
+	// 参数验证
+	if errorMsg := validateDraft(req.UserId, req.Title, req.Content); errorMsg != "" {
+		return &post.CreateDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: errorMsg,
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能保存草稿
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.CreateDraftResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create draft: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	draft := &models.PostDraft{
+		UserID:             req.UserId,
+		Title:              req.Title,
+		Content:            req.Content,
+		Images:             models.StringArray(storage.NormalizeImages(req.Images)),
+		TopicID:            req.TopicId,
+		Category:           models.PostCategory(req.Category),
+		IsAnonymous:        req.IsAnonymous,
+		AnonymousProfileID: req.AnonymousProfileId,
+		Location:           req.Location,
+		Tags:               req.Tags,
+	}
+	if err := h.db.CreateDraft(ctx, draft); err != nil {
+		logger.Errorf("CreateDraft failed: %s", err)
+		return &post.CreateDraftResponse{
+			Code:    constants.PostDraftSaveFailCode,
+			Message: fmt.Sprintf("failed to create draft: %s", err),
+		}, nil
+	}
+
+	return &post.CreateDraftResponse{
+		Code:    constants.SuccessCode,
+		Message: "保存成功",
+		Draft:   models.PostDraftToKitexPostDraft(draft),
+	}, nil
+}
+
+// UpdateDraft 自动保存草稿，客户端持有的版本落后时返回冲突和服务端当前的草稿
+func (h *PostHandler) UpdateDraft(ctx context.Context, req *post.UpdateDraftRequest) (*post.UpdateDraftResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+
+	// 参数验证
+	if req.DraftId == "" {
+		return &post.UpdateDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to update draft: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	if errorMsg := validateDraft(req.UserId, req.Title, req.Content); errorMsg != "" {
+		return &post.UpdateDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: errorMsg,
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能保存草稿
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.UpdateDraftResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update draft: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	draft := &models.PostDraft{
+		ID:                 req.DraftId,
+		UserID:             req.UserId,
+		Title:              req.Title,
+		Content:            req.Content,
+		Images:             models.StringArray(storage.NormalizeImages(req.Images)),
+		TopicID:            req.TopicId,
+		Category:           models.PostCategory(req.Category),
+		IsAnonymous:        req.IsAnonymous,
+		AnonymousProfileID: req.AnonymousProfileId,
+		Location:           req.Location,
+		Tags:               req.Tags,
+	}
+	current, err := h.db.UpdateDraft(ctx, draft, req.Version)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.UpdateDraftResponse{
+				Code:    constants.PostDraftNotFoundCode,
+				Message: fmt.Sprintf("failed to update draft: %s", constants.GetErrorMessage(constants.PostDraftNotFoundCode)),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "version conflict") {
+			return &post.UpdateDraftResponse{
+				Code:    constants.PostDraftConflictCode,
+				Message: fmt.Sprintf("failed to update draft: %s", constants.GetErrorMessage(constants.PostDraftConflictCode)),
+				Draft:   models.PostDraftToKitexPostDraft(current),
+			}, nil
+		}
+		logger.Errorf("UpdateDraft failed: %s", err)
+		return &post.UpdateDraftResponse{
+			Code:    constants.PostDraftSaveFailCode,
+			Message: fmt.Sprintf("failed to update draft: %s", err),
+		}, nil
+	}
+
+	return &post.UpdateDraftResponse{
+		Code:    constants.SuccessCode,
+		Message: "保存成功",
+		Draft:   models.PostDraftToKitexPostDraft(current),
+	}, nil
+}
+
+// GetDraft 获取草稿详情
+func (h *PostHandler) GetDraft(ctx context.Context, req *post.GetDraftRequest) (*post.GetDraftResponse, error) {
+	// 参数验证
+	if req.DraftId == "" || req.UserId == "" {
+		return &post.GetDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get draft: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	draft, err := h.db.GetDraft(ctx, req.DraftId, req.UserId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.GetDraftResponse{
+				Code:    constants.PostDraftNotFoundCode,
+				Message: fmt.Sprintf("failed to get draft: %s", constants.GetErrorMessage(constants.PostDraftNotFoundCode)),
+			}, nil
+		}
+		return &post.GetDraftResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get draft: %s", err),
+		}, nil
+	}
+
+	return &post.GetDraftResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Draft:   models.PostDraftToKitexPostDraft(draft),
+	}, nil
+}
+
+// GetDraftList 获取草稿箱列表
+func (h *PostHandler) GetDraftList(ctx context.Context, req *post.GetDraftListRequest) (*post.GetDraftListResponse, error) {
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetDraftListResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get draft list: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	drafts, err := h.db.GetDraftList(ctx, req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		return &post.GetDraftListResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get draft list: %s", err),
+		}, nil
+	}
+	hasMore := len(drafts) > int(req.PageSize)
+	if hasMore {
+		drafts = drafts[:req.PageSize]
+	}
+
+	draftList := make([]*post.PostDraft, 0, len(drafts))
+	for _, d := range drafts {
+		draftList = append(draftList, models.PostDraftToKitexPostDraft(d))
+	}
+
+	return &post.GetDraftListResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Drafts:  draftList,
+		Total:   int32(len(draftList)),
+		HasMore: hasMore,
+	}, nil
+}
+
+// DeleteDraft 删除草稿
+func (h *PostHandler) DeleteDraft(ctx context.Context, req *post.DeleteDraftRequest) (*post.DeleteDraftResponse, error) {
+	// 参数验证
+	if req.DraftId == "" || req.UserId == "" {
+		return &post.DeleteDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to delete draft: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	if err := h.db.DeleteDraft(ctx, req.DraftId, req.UserId); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.DeleteDraftResponse{
+				Code:    constants.PostDraftNotFoundCode,
+				Message: fmt.Sprintf("failed to delete draft: %s", constants.GetErrorMessage(constants.PostDraftNotFoundCode)),
+			}, nil
+		}
+		return &post.DeleteDraftResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to delete draft: %s", err),
+		}, nil
+	}
+
+	return &post.DeleteDraftResponse{
+		Code:    constants.SuccessCode,
+		Message: "删除成功",
+	}, nil
+}
+
+// PublishDraft 发布草稿，经过与发帖相同的校验，帖子创建和草稿删除在同一事务内完成
+func (h *PostHandler) PublishDraft(ctx context.Context, req *post.PublishDraftRequest) (*post.PublishDraftResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("PublishDraft req: %v", req)
+
+	// 参数验证
+	if req.DraftId == "" || req.UserId == "" {
+		return &post.PublishDraftResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to publish draft: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	draft, err := h.db.GetDraft(ctx, req.DraftId, req.UserId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.PublishDraftResponse{
+				Code:    constants.PostDraftNotFoundCode,
+				Message: fmt.Sprintf("failed to publish draft: %s", constants.GetErrorMessage(constants.PostDraftNotFoundCode)),
+			}, nil
+		}
+		return &post.PublishDraftResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to publish draft: %s", err),
+		}, nil
+	}
+	if req.Version != nil && *req.Version != draft.Version {
+		return &post.PublishDraftResponse{
+			Code:    constants.PostDraftConflictCode,
+			Message: fmt.Sprintf("failed to publish draft: %s", constants.GetErrorMessage(constants.PostDraftConflictCode)),
+		}, nil
+	}
+
+	newPost, decision, code, message := h.buildPost(ctx, draftToCreatePostRequest(draft))
+	if code != constants.SuccessCode {
+		return &post.PublishDraftResponse{
+			Code:    code,
+			Message: message,
+		}, nil
+	}
+
+	// 以读取到的版本发布，期间草稿被修改或已被发布则拒绝
+	if err := h.db.PublishDraft(ctx, draft.ID, draft.UserID, draft.Version, newPost); err != nil {
+		logger.Errorf("PublishDraft failed: %s", err)
+		if strings.Contains(err.Error(), "not found") {
+			return &post.PublishDraftResponse{
+				Code:    constants.PostDraftNotFoundCode,
+				Message: fmt.Sprintf("failed to publish draft: %s", constants.GetErrorMessage(constants.PostDraftNotFoundCode)),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "version conflict") {
+			return &post.PublishDraftResponse{
+				Code:    constants.PostDraftConflictCode,
+				Message: fmt.Sprintf("failed to publish draft: %s", constants.GetErrorMessage(constants.PostDraftConflictCode)),
+			}, nil
+		}
+		return &post.PublishDraftResponse{
+			Code:    constants.PostCreateFailCode,
+			Message: fmt.Sprintf("failed to publish draft: %s", err),
+		}, nil
+	}
+
+	h.recordModeration(ctx, decision, newPost.ID)
+
+	// 审核中的帖子复审通过后再写入列表、热榜和搜索索引
+	if newPost.Status == models.PostStatusPublished {
+		h.onPostCreated(ctx, newPost)
+	}
+	h.enqueueThumbnails(ctx, newPost)
+
+	return &post.PublishDraftResponse{
+		Code:    constants.SuccessCode,
+		Message: "发布成功",
+		Post:    models.PostToKitexPost(newPost),
+	}, nil
+}
+
+// PurgeStaleDrafts 删除超过保留期限未更新的草稿
+func (h *PostHandler) PurgeStaleDrafts(ctx context.Context) (int64, error) {
+	before := time.Now().AddDate(0, 0, -draftRetentionDays())
+	return h.db.PurgeStaleDrafts(ctx, before, constants.DefaultDraftPurgeBatchSize)
+}
+
+// StartDraftPurgeWorker 启动过期草稿定时清理任务，ctx取消时退出
+func (h *PostHandler) StartDraftPurgeWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(constants.DefaultDraftPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := h.PurgeStaleDrafts(ctx)
+		if err != nil {
+			logger.Errorf("PurgeStaleDrafts failed: %v", err)
+		} else if purged > 0 {
+			logger.Infof("PurgeStaleDrafts purged %d drafts", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
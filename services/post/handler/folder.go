@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/utils"
+)
+
+// normalizeFolderName 去掉收藏夹名称首尾空白，名称为空或过长时 ok 为 false
+func normalizeFolderName(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > models.FavoriteFolderNameMax {
+		return "", false
+	}
+	return name, true
+}
+
+// folderErrorCode 将收藏夹相关的仓储错误映射为错误码
+func folderErrorCode(err error) int32 {
+	switch {
+	case strings.Contains(err.Error(), "folder not found"):
+		return constants.PostFolderNotFoundCode
+	case strings.Contains(err.Error(), "folder name exists"):
+		return constants.PostFolderNameExistsCode
+	case strings.Contains(err.Error(), "folder limit exceeded"):
+		return constants.PostFolderLimitCode
+	case strings.Contains(err.Error(), "default folder"):
+		return constants.PostFolderDefaultCode
+	default:
+		return constants.DatabaseErrorCode
+	}
+}
+
+// GetFavoriteFolders 获取用户的收藏夹，其他用户只能看到公开的收藏夹
+func (h *PostHandler) GetFavoriteFolders(ctx context.Context, req *post.GetFavoriteFoldersRequest) (*post.GetFavoriteFoldersResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetFavoriteFolders req: %v", req)
+
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetFavoriteFoldersResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get favorite folders: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	publicOnly := req.GetViewerId() != req.UserId
+	folders, err := h.db.GetFavoriteFolders(ctx, req.UserId, publicOnly)
+	if err != nil {
+		logger.Errorf("GetFavoriteFolders failed: %s", err)
+		return &post.GetFavoriteFoldersResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get favorite folders: %s", err),
+		}, nil
+	}
+
+	folderList := make([]*post.FavoriteFolder, 0, len(folders))
+	for _, f := range folders {
+		folderList = append(folderList, models.FavoriteFolderToKitexFolder(f))
+	}
+
+	return &post.GetFavoriteFoldersResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Folders: folderList,
+	}, nil
+}
+
+// CreateFavoriteFolder 创建收藏夹
+func (h *PostHandler) CreateFavoriteFolder(ctx context.Context, req *post.CreateFavoriteFolderRequest) (*post.CreateFavoriteFolderResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("CreateFavoriteFolder req: %v", req)
+
+	// 参数验证
+	name, ok := normalizeFolderName(req.Name)
+	if req.UserId == "" || !ok {
+		return &post.CreateFavoriteFolderResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to create favorite folder: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能修改收藏夹
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.CreateFavoriteFolderResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create favorite folder: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	folder := &models.FavoriteFolder{
+		UserID:   req.UserId,
+		Name:     name,
+		IsPublic: req.IsPublic,
+	}
+	if err := h.db.CreateFavoriteFolder(ctx, folder); err != nil {
+		logger.Errorf("CreateFavoriteFolder failed: %s", err)
+		return &post.CreateFavoriteFolderResponse{
+			Code:    folderErrorCode(err),
+			Message: fmt.Sprintf("failed to create favorite folder: %s", err),
+		}, nil
+	}
+
+	return &post.CreateFavoriteFolderResponse{
+		Code:    constants.SuccessCode,
+		Message: "创建成功",
+		Folder:  models.FavoriteFolderToKitexFolder(folder),
+	}, nil
+}
+
+// UpdateFavoriteFolder 修改收藏夹名称或公开状态
+func (h *PostHandler) UpdateFavoriteFolder(ctx context.Context, req *post.UpdateFavoriteFolderRequest) (*post.UpdateFavoriteFolderResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("UpdateFavoriteFolder req: %v", req)
+
+	// 参数验证
+	if req.FolderId == "" || req.UserId == "" {
+		return &post.UpdateFavoriteFolderResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to update favorite folder: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	var name *string
+	if req.IsSetName() {
+		n, ok := normalizeFolderName(req.GetName())
+		if !ok {
+			return &post.UpdateFavoriteFolderResponse{
+				Code:    constants.ValidationErrorCode,
+				Message: fmt.Sprintf("failed to update favorite folder: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+			}, nil
+		}
+		name = &n
+	}
+	// 被封禁或暂停使用的用户不能修改收藏夹
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.UpdateFavoriteFolderResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update favorite folder: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	folder, err := h.db.UpdateFavoriteFolder(ctx, req.FolderId, req.UserId, name, req.IsPublic)
+	if err != nil {
+		logger.Errorf("UpdateFavoriteFolder failed: %s", err)
+		return &post.UpdateFavoriteFolderResponse{
+			Code:    folderErrorCode(err),
+			Message: fmt.Sprintf("failed to update favorite folder: %s", err),
+		}, nil
+	}
+
+	return &post.UpdateFavoriteFolderResponse{
+		Code:    constants.SuccessCode,
+		Message: "修改成功",
+		Folder:  models.FavoriteFolderToKitexFolder(folder),
+	}, nil
+}
+
+// DeleteFavoriteFolder 删除收藏夹，其中的帖子移到默认收藏夹
+func (h *PostHandler) DeleteFavoriteFolder(ctx context.Context, req *post.DeleteFavoriteFolderRequest) (*post.DeleteFavoriteFolderResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("DeleteFavoriteFolder req: %v", req)
+
+	// 参数验证
+	if req.FolderId == "" || req.UserId == "" {
+		return &post.DeleteFavoriteFolderResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to delete favorite folder: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	if err := h.db.DeleteFavoriteFolder(ctx, req.FolderId, req.UserId); err != nil {
+		logger.Errorf("DeleteFavoriteFolder failed: %s", err)
+		return &post.DeleteFavoriteFolderResponse{
+			Code:    folderErrorCode(err),
+			Message: fmt.Sprintf("failed to delete favorite folder: %s", err),
+		}, nil
+	}
+
+	return &post.DeleteFavoriteFolderResponse{
+		Code:    constants.SuccessCode,
+		Message: "删除成功",
+	}, nil
+}
+
+// GetFavoriteFolderPosts 分页获取收藏夹中的帖子，私密收藏夹只有所有者可以查看
+func (h *PostHandler) GetFavoriteFolderPosts(ctx context.Context, req *post.GetFavoriteFolderPostsRequest) (*post.GetFavoriteFolderPostsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetFavoriteFolderPosts req: %v", req)
+
+	// 参数验证
+	if req.FolderId == "" {
+		return &post.GetFavoriteFolderPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get favorite folder posts: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	folder, err := h.db.GetFavoriteFolder(ctx, req.FolderId)
+	if err == nil && !folder.IsPublic && folder.UserID != req.GetViewerId() {
+		// 不暴露私密收藏夹的存在
+		err = fmt.Errorf("folder not found")
+	}
+	if err != nil {
+		logger.Errorf("GetFavoriteFolder failed: %s", err)
+		return &post.GetFavoriteFolderPostsResponse{
+			Code:    folderErrorCode(err),
+			Message: fmt.Sprintf("failed to get favorite folder posts: %s", err),
+		}, nil
+	}
+
+	posts, total, err := h.db.GetFolderPosts(ctx, folder.ID, req.Page, req.PageSize)
+	if err != nil {
+		logger.Errorf("GetFolderPosts failed: %s", err)
+		return &post.GetFavoriteFolderPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get favorite folder posts: %s", err),
+		}, nil
+	}
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+
+	return &post.GetFavoriteFolderPostsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Folder:  models.FavoriteFolderToKitexFolder(folder),
+		Posts:   h.convertPostsToResponse(ctx, posts),
+		Total:   int32(total),
+		HasMore: hasMore,
+	}, nil
+}
+
+// MoveFavorites 将已收藏的帖子移到指定收藏夹
+func (h *PostHandler) MoveFavorites(ctx context.Context, req *post.MoveFavoritesRequest) (*post.MoveFavoritesResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("MoveFavorites req: %v", req)
+
+	// 参数验证
+	if req.UserId == "" || req.FolderId == "" || len(req.PostIds) == 0 || len(req.PostIds) > constants.MaxMoveFavorites {
+		return &post.MoveFavoritesResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to move favorites: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能修改收藏夹
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.MoveFavoritesResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to move favorites: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	moved, err := h.db.MoveFavorites(ctx, req.UserId, req.PostIds, req.FolderId)
+	if err != nil {
+		logger.Errorf("MoveFavorites failed: %s", err)
+		return &post.MoveFavoritesResponse{
+			Code:    folderErrorCode(err),
+			Message: fmt.Sprintf("failed to move favorites: %s", err),
+		}, nil
+	}
+
+	return &post.MoveFavoritesResponse{
+		Code:       constants.SuccessCode,
+		Message:    "移动成功",
+		MovedCount: int32(moved),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/view"
+)
+
+// GetBrowsingHistory 获取浏览历史，已彻底删除的帖子会从历史中移除
+// 回收站中、被隐藏或审核中的帖子可能恢复，只在本次返回中跳过
+func (h *PostHandler) GetBrowsingHistory(ctx context.Context, req *post.GetBrowsingHistoryRequest) (*post.GetBrowsingHistoryResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetBrowsingHistoryResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get browsing history: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	entries, total, err := h.history.List(req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		logger.Errorf("GetBrowsingHistory failed: %s", err)
+		return &post.GetBrowsingHistoryResponse{
+			Code:    constants.InternalServerErrorCode,
+			Message: fmt.Sprintf("failed to get browsing history: %s", err),
+		}, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.PostID)
+	}
+	posts, err := h.db.GetPostsByIDs(ctx, ids, nil)
+	if err != nil {
+		return &post.GetBrowsingHistoryResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get browsing history: %s", err),
+		}, nil
+	}
+
+	postMap := make(map[string]*post.Post, len(posts))
+	for _, p := range h.convertPostsToResponse(ctx, posts) {
+		postMap[p.Id] = p
+	}
+	items := make([]*post.BrowsingHistoryItem, 0, len(entries))
+	var skipped []string
+	for _, e := range entries {
+		p, ok := postMap[e.PostID]
+		if !ok {
+			skipped = append(skipped, e.PostID)
+			continue
+		}
+		items = append(items, &post.BrowsingHistoryItem{
+			Post:     p,
+			ViewedAt: e.ViewedAt.Unix(),
+		})
+	}
+	missing := h.purgedPostIDs(ctx, skipped)
+	if err := h.history.Remove(req.UserId, missing...); err != nil {
+		logger.Warnf("GetBrowsingHistory remove missing posts failed: %s", err)
+	}
+
+	return &post.GetBrowsingHistoryResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Items:   items,
+		Total:   int32(total) - int32(len(missing)),
+		HasMore: int64(req.Page*req.PageSize) < total,
+	}, nil
+}
+
+// purgedPostIDs 返回 ids 中已彻底删除的帖子，查询失败时不移除任何帖子
+func (h *PostHandler) purgedPostIDs(ctx context.Context, ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	existing, err := h.db.ExistingPostIDs(ctx, ids)
+	if err != nil {
+		log.GetLogger().Warnf("get existing posts failed: %v", err)
+		return nil
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		exists[id] = true
+	}
+	var purged []string
+	for _, id := range ids {
+		if !exists[id] {
+			purged = append(purged, id)
+		}
+	}
+	return purged
+}
+
+// ClearBrowsingHistory 清空浏览历史
+func (h *PostHandler) ClearBrowsingHistory(ctx context.Context, req *post.ClearBrowsingHistoryRequest) (*post.ClearBrowsingHistoryResponse, error) {
+	// 参数验证
+	if req.UserId == "" {
+		return &post.ClearBrowsingHistoryResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to clear browsing history: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	if err := h.history.Clear(req.UserId); err != nil {
+		return &post.ClearBrowsingHistoryResponse{
+			Code:    constants.InternalServerErrorCode,
+			Message: fmt.Sprintf("failed to clear browsing history: %s", err),
+		}, nil
+	}
+
+	return &post.ClearBrowsingHistoryResponse{
+		Code:    constants.SuccessCode,
+		Message: "清空成功",
+	}, nil
+}
+
+// RemoveBrowsingHistory 删除单条浏览历史
+func (h *PostHandler) RemoveBrowsingHistory(ctx context.Context, req *post.RemoveBrowsingHistoryRequest) (*post.RemoveBrowsingHistoryResponse, error) {
+	// 参数验证
+	if req.UserId == "" || req.PostId == "" {
+		return &post.RemoveBrowsingHistoryResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to remove browsing history: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	if err := h.history.Remove(req.UserId, req.PostId); err != nil {
+		return &post.RemoveBrowsingHistoryResponse{
+			Code:    constants.InternalServerErrorCode,
+			Message: fmt.Sprintf("failed to remove browsing history: %s", err),
+		}, nil
+	}
+
+	return &post.RemoveBrowsingHistoryResponse{
+		Code:    constants.SuccessCode,
+		Message: "删除成功",
+	}, nil
+}
+
+// StartViewFlushWorker 启动浏览量定时写回任务，写回后刷新帖子热度，ctx取消时退出
+// 未写回的浏览量保留在 Redis 中，由下一次任务继续写回
+func (h *PostHandler) StartViewFlushWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(view.FlushInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		flushed, err := h.views.Flush(ctx)
+		if err != nil {
+			logger.Errorf("flush view counts failed: %v", err)
+		}
+		for _, postID := range flushed {
+			h.refreshHotScore(ctx, postID)
+		}
+	}
+}
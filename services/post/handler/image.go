@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/storage"
+)
+
+const (
+	thumbnailQueueKey     = "storage:thumbnail:queue"
+	thumbnailPollInterval = 5 * time.Second
+	thumbnailBatchSize    = 20
+)
+
+// validateImages 校验图片属于 userID 且已上传，existing 为帖子已有的图片，校验失败时返回错误信息
+func validateImages(ctx context.Context, userID string, images, existing []string) string {
+	if len(images) == 0 {
+		return ""
+	}
+	store, err := storage.Default()
+	if err != nil {
+		return fmt.Sprintf("参数验证失败: images: 图片存储不可用: %s; ", err)
+	}
+	if err := storage.ValidateImages(ctx, store, userID, images, existing); err != nil {
+		return fmt.Sprintf("参数验证失败: images: %s; ", err)
+	}
+	return ""
+}
+
+// alignThumbnails 按新的图片列表对齐已有的缩略图，图片未变的保留缩略图
+func alignThumbnails(oldImages, oldThumbnails, images []string) models.StringArray {
+	existing := make(map[string]string, len(oldImages))
+	for i, image := range oldImages {
+		if i < len(oldThumbnails) {
+			existing[image] = oldThumbnails[i]
+		}
+	}
+	thumbnails := make(models.StringArray, 0, len(images))
+	for _, image := range images {
+		thumbnails = append(thumbnails, existing[image])
+	}
+	return thumbnails
+}
+
+// missingThumbnails 是否有上传到对象存储的图片还没有缩略图
+func missingThumbnails(p *models.Post) bool {
+	for i, image := range p.Images {
+		if storage.IsLegacyURL(image) {
+			continue
+		}
+		if i >= len(p.Thumbnails) || p.Thumbnails[i] == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueueThumbnails 缺少缩略图时加入生成队列，失败只记录日志
+func (h *PostHandler) enqueueThumbnails(ctx context.Context, p *models.Post) {
+	if !missingThumbnails(p) {
+		return
+	}
+	if _, err := h.redis.LPush(thumbnailQueueKey, p.ID); err != nil {
+		log.GetLogger().Warnf("enqueue thumbnails %s failed: %v", p.ID, err)
+	}
+}
+
+// generateThumbnails 为帖子补齐缺失的缩略图，单张失败时保留原图地址并继续
+func (h *PostHandler) generateThumbnails(ctx context.Context, postID string) error {
+	p, err := h.db.GetPost(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if !missingThumbnails(p) {
+		return nil
+	}
+	store, err := storage.Default()
+	if err != nil {
+		return err
+	}
+
+	thumbnails := alignThumbnails(p.Images, p.Thumbnails, p.Images)
+	for i, image := range p.Images {
+		if thumbnails[i] != "" || storage.IsLegacyURL(image) {
+			continue
+		}
+		thumbKey, err := storage.GenerateThumbnail(ctx, store, image, storage.ThumbnailSize())
+		if err != nil {
+			log.GetLogger().Warnf("generate thumbnail %s failed: %v", image, err)
+			continue
+		}
+		thumbnails[i] = thumbKey
+	}
+
+	if err := h.db.UpdatePostThumbnails(ctx, postID, p.EditedAt, thumbnails); err != nil {
+		return err
+	}
+	h.db.InvalidatePostCache(ctx, postID)
+	return nil
+}
+
+// StartThumbnailWorker 启动缩略图生成任务，从队列中取出帖子生成九宫格缩略图，ctx取消时退出
+func (h *PostHandler) StartThumbnailWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(thumbnailPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for i := 0; i < thumbnailBatchSize; i++ {
+			postID, err := h.redis.RPop(thumbnailQueueKey)
+			if err != nil {
+				logger.Errorf("pop thumbnail queue failed: %v", err)
+				break
+			}
+			if postID == "" {
+				break
+			}
+			if err := h.generateThumbnails(ctx, postID); err != nil {
+				logger.Errorf("generate thumbnails for post %s failed: %v", postID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+)
+
+// GetModerationReviews 获取内容复审队列，仅管理员
+func (h *PostHandler) GetModerationReviews(ctx context.Context, req *post.GetModerationReviewsRequest) (*post.GetModerationReviewsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetModerationReviews req: %v", req)
+
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.GetModerationReviewsResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to get moderation reviews: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+	status := req.GetReviewStatus()
+	if status != "" && status != models.ReviewStatusPending && status != models.ReviewStatusApproved && status != models.ReviewStatusRejected {
+		return &post.GetModerationReviewsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: review_status: invalid review status; ",
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	records, total, err := h.moderator.ListReviews(ctx, status, int(page), int(pageSize))
+	if err != nil {
+		logger.Errorf("GetModerationReviews failed: %s", err)
+		return &post.GetModerationReviewsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get moderation reviews: %s", err),
+		}, nil
+	}
+
+	recordList := make([]*post.ModerationRecord, 0, len(records))
+	for _, r := range records {
+		recordList = append(recordList, moderationRecordToKitex(r))
+	}
+	return &post.GetModerationReviewsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Records: recordList,
+		Total:   int32(total),
+		HasMore: int64(page)*int64(pageSize) < total,
+	}, nil
+}
+
+// ResolveModerationReview 处理送审的帖子或评论，仅管理员
+// 帖子通过后立即发布(作者指定的发布时间未到时仍按时发布)，评论通过后出现在评论列表
+func (h *PostHandler) ResolveModerationReview(ctx context.Context, req *post.ResolveModerationReviewRequest) (*post.ResolveModerationReviewResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ResolveModerationReview req: %v", req)
+
+	if req.RecordId == "" || req.OperatorId == "" {
+		return &post.ResolveModerationReviewResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to resolve moderation review: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.ResolveModerationReviewResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to resolve moderation review: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+
+	record, err := h.moderator.Resolve(ctx, req.RecordId, req.OperatorId, req.Approve)
+	if err != nil {
+		logger.Errorf("ResolveModerationReview failed: %s", err)
+		code := int32(constants.DatabaseErrorCode)
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			code = constants.PostReviewNotFoundCode
+		case strings.Contains(err.Error(), "already resolved"):
+			code = constants.PostReviewResolvedCode
+		}
+		return &post.ResolveModerationReviewResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to resolve moderation review: %s", err),
+		}, nil
+	}
+
+	// 帖子通过后直接发布，同步写入列表、热榜和搜索索引
+	if req.Approve && record.TargetType == constants.TargetTypePost && record.TargetID != "" {
+		if postModel, err := h.db.GetPost(ctx, record.TargetID); err == nil && postModel.Status == models.PostStatusPublished {
+			h.onPostCreated(ctx, postModel)
+		}
+	}
+
+	return &post.ResolveModerationReviewResponse{
+		Code:    constants.SuccessCode,
+		Message: "处理成功",
+		Record:  moderationRecordToKitex(record),
+	}, nil
+}
+
+// moderationRecordToKitex 转换审核记录
+func moderationRecordToKitex(r *models.ModerationRecord) *post.ModerationRecord {
+	record := &post.ModerationRecord{
+		Id:           r.ID,
+		TargetType:   r.TargetType,
+		TargetId:     r.TargetID,
+		UserId:       r.UserID,
+		Action:       r.Action,
+		Reason:       r.Reason,
+		Hits:         r.Hits,
+		Content:      r.Content,
+		ReviewStatus: r.ReviewStatus,
+		ReviewerId:   r.ReviewerID,
+		CreatedAt:    r.CreatedAt.Unix(),
+	}
+	if r.ReviewedAt != nil {
+		reviewedAt := r.ReviewedAt.Unix()
+		record.ReviewedAt = &reviewedAt
+	}
+	return record
+}
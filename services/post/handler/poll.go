@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/utils"
+)
+
+// buildPoll 校验发帖时附带的投票，publishAt 为定时发布时间，校验失败时返回错误信息
+func buildPoll(req *post.CreatePollRequest, publishAt *time.Time) (*models.Poll, string) {
+	if len(req.Options) < models.PollOptionMin || len(req.Options) > models.PollOptionMax {
+		return nil, fmt.Sprintf("poll.options: 选项数量须在%d-%d之间; ", models.PollOptionMin, models.PollOptionMax)
+	}
+
+	poll := &models.Poll{
+		Multiple:    req.Multiple,
+		HideResults: req.HideResults,
+		Options:     make([]*models.PollOption, 0, len(req.Options)),
+	}
+	seen := make(map[string]bool, len(req.Options))
+	for _, content := range req.Options {
+		content = strings.TrimSpace(content)
+		if content == "" || utf8.RuneCountInString(content) > models.PollOptionMaxLength {
+			return nil, fmt.Sprintf("poll.options: 选项不能为空且不能超过%d个字; ", models.PollOptionMaxLength)
+		}
+		if seen[content] {
+			return nil, "poll.options: 选项不能重复; "
+		}
+		seen[content] = true
+		poll.Options = append(poll.Options, &models.PollOption{Content: content})
+	}
+
+	// 截止时间必须晚于帖子的发布时间
+	if req.Deadline != nil {
+		deadline := time.Unix(*req.Deadline, 0)
+		start := time.Now()
+		if publishAt != nil {
+			start = *publishAt
+		}
+		if !deadline.After(start) {
+			return nil, "poll.deadline: 截止时间必须晚于发布时间; "
+		}
+		poll.Deadline = &deadline
+	}
+	return poll, ""
+}
+
+// attachPolls 为带投票的帖子填充投票结果，viewerID 为空时不返回个人投票状态
+// 投票查询失败只记录日志，帖子照常返回
+func (h *PostHandler) attachPolls(ctx context.Context, posts []*models.Post, postList []*post.Post, viewerID string) {
+	postIDs := make([]string, 0)
+	for _, p := range posts {
+		if p.HasPoll {
+			postIDs = append(postIDs, p.ID)
+		}
+	}
+	if len(postIDs) == 0 {
+		return
+	}
+
+	polls, err := h.db.GetPollsByPostIDs(ctx, postIDs)
+	if err != nil {
+		log.GetLogger().Warnf("GetPollsByPostIDs failed: %v", err)
+		return
+	}
+	pollIDs := make([]string, 0, len(polls))
+	for _, poll := range polls {
+		pollIDs = append(pollIDs, poll.ID)
+	}
+	votes, err := h.db.GetPollVotes(ctx, viewerID, pollIDs)
+	if err != nil {
+		log.GetLogger().Warnf("GetPollVotes failed: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for i, p := range postList {
+		if poll, ok := polls[posts[i].ID]; ok {
+			p.Poll = models.PollToKitexPoll(poll, votes[poll.ID], now)
+		}
+	}
+}
+
+// VotePoll 投票
+func (h *PostHandler) VotePoll(ctx context.Context, req *post.VotePollRequest) (*post.VotePollResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("VotePoll req: %v", req)
+
+	poll, code, message := h.votePoll(ctx, req.PostId, req.UserId, req.OptionIds, false)
+	if code != constants.SuccessCode {
+		return &post.VotePollResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to vote poll: %s", message),
+		}, nil
+	}
+
+	return &post.VotePollResponse{
+		Code:    constants.SuccessCode,
+		Message: "投票成功",
+		Poll:    poll,
+	}, nil
+}
+
+// ChangePollVote 修改投票，替换用户之前的全部选择
+func (h *PostHandler) ChangePollVote(ctx context.Context, req *post.ChangePollVoteRequest) (*post.ChangePollVoteResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ChangePollVote req: %v", req)
+
+	poll, code, message := h.votePoll(ctx, req.PostId, req.UserId, req.OptionIds, true)
+	if code != constants.SuccessCode {
+		return &post.ChangePollVoteResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to change poll vote: %s", message),
+		}, nil
+	}
+
+	return &post.ChangePollVoteResponse{
+		Code:    constants.SuccessCode,
+		Message: "修改成功",
+		Poll:    poll,
+	}, nil
+}
+
+// votePoll 投票和修改投票的公共流程，失败时返回错误码和错误信息
+func (h *PostHandler) votePoll(ctx context.Context, postID, userID string, optionIDs []string, change bool) (*post.Poll, int32, string) {
+	if postID == "" || userID == "" || len(optionIDs) == 0 {
+		return nil, constants.ValidationErrorCode, constants.GetErrorMessage(constants.ValidationErrorCode)
+	}
+	// 被封禁或暂停使用的用户不能投票
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), userID); code != constants.SuccessCode {
+		return nil, code, constants.GetErrorMessage(code)
+	}
+
+	poll, err := h.db.VotePoll(ctx, postID, userID, optionIDs, change)
+	if err != nil {
+		log.GetLogger().Errorf("VotePoll %s failed: %v", postID, err)
+		msg := err.Error()
+		switch {
+		case strings.Contains(msg, "poll not found"):
+			return nil, constants.PostPollNotFoundCode, constants.GetErrorMessage(constants.PostPollNotFoundCode)
+		case strings.Contains(msg, "not found"):
+			return nil, constants.PostNotFoundCode, constants.GetErrorMessage(constants.PostNotFoundCode)
+		case strings.Contains(msg, "poll closed"):
+			return nil, constants.PostPollClosedCode, constants.GetErrorMessage(constants.PostPollClosedCode)
+		case strings.Contains(msg, "already voted"):
+			return nil, constants.PostPollAlreadyVotedCode, constants.GetErrorMessage(constants.PostPollAlreadyVotedCode)
+		case strings.Contains(msg, "not voted"):
+			return nil, constants.PostPollNotVotedCode, constants.GetErrorMessage(constants.PostPollNotVotedCode)
+		case strings.Contains(msg, "invalid poll option"):
+			return nil, constants.PostPollInvalidCode, constants.GetErrorMessage(constants.PostPollInvalidCode)
+		default:
+			return nil, constants.PostPollVoteFailCode, msg
+		}
+	}
+
+	return models.PollToKitexPoll(poll, optionIDs, time.Now()), constants.SuccessCode, ""
+}
@@ -5,23 +5,101 @@ import (
 	"fmt"
 	"hupu/kitex_gen/post"
 	"hupu/services/post/repository"
+	"hupu/shared/cache"
 	"hupu/shared/constants"
 	"hupu/shared/log"
 	"hupu/shared/middleware"
 	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/ranking"
+	"hupu/shared/relation"
+	"hupu/shared/search"
+	"hupu/shared/storage"
 	"hupu/shared/utils"
+	"hupu/shared/view"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
 
 type PostHandler struct {
-	db *repository.PostRepository
+	db          *repository.PostRepository
+	hot         *ranking.HotRanker
+	rating      *ranking.RatingRanker
+	search      search.SearchBackend
+	queries     *search.QueryLog
+	recommender *ranking.Recommender
+	topics      *ranking.TopicRanker
+	tags        *ranking.TagRanker
+	views       *view.Counter
+	history     *view.History
+	moderator   *moderation.Moderator
+	relations   *relation.Relations
+	redis       *utils.RedisClient
 }
 
 func NewPostHandler() *PostHandler {
+	hot := ranking.NewHotRanker(utils.GetDB(), utils.GetRedisClient())
+	moderator := moderation.NewModerator(utils.GetDB(), utils.GetRedisClient())
 	return &PostHandler{
-		db: repository.NewPostRepository(),
+		db:          repository.NewPostRepository(),
+		hot:         hot,
+		rating:      ranking.NewRatingRanker(utils.GetDB()),
+		search:      search.NewSearchBackend(utils.GetDB()),
+		queries:     search.NewQueryLog(utils.GetRedisClient(), moderator.Clean),
+		recommender: ranking.NewRecommender(utils.GetDB(), utils.GetRedisClient(), hot),
+		topics:      ranking.NewTopicRanker(utils.GetDB(), utils.GetRedisClient()),
+		tags:        ranking.NewTagRanker(utils.GetRedisClient()),
+		views:       view.NewCounter(utils.GetDB(), utils.GetRedisClient()),
+		history:     view.NewHistory(utils.GetRedisClient()),
+		moderator:   moderator,
+		relations:   relation.NewRelations(utils.GetDB()),
+		redis:       utils.GetRedisClient(),
+	}
+}
+
+// HotRanker 返回热榜维护器，供后台重建任务使用
+func (h *PostHandler) HotRanker() *ranking.HotRanker {
+	return h.hot
+}
+
+// onPostChanged 帖子内容或互动数据变化后失效列表缓存，刷新热度和搜索索引
+func (h *PostHandler) onPostChanged(ctx context.Context, postID string) {
+	h.db.InvalidatePostCache(ctx, postID)
+	h.refreshHotScore(ctx, postID)
+	h.indexPost(ctx, postID)
+}
+
+// indexPost 同步帖子到搜索索引，失败只记录日志
+func (h *PostHandler) indexPost(ctx context.Context, postID string) {
+	if err := h.search.Index(ctx, postID); err != nil {
+		log.GetLogger().Warnf("index post %s failed: %v", postID, err)
+	}
+}
+
+// ratingPrior 获取评分榜单的贝叶斯先验，统计失败时沿用上一次的全站均值
+func (h *PostHandler) ratingPrior(ctx context.Context) *ranking.RatingPrior {
+	prior, err := h.rating.Prior(ctx)
+	if err != nil {
+		log.GetLogger().Warnf("get rating prior failed, use mean %.2f: %v", prior.Mean, err)
+	}
+	return prior
+}
+
+// withAdjustedScores 为评分榜单中的帖子填充贝叶斯修正分
+func withAdjustedScores(postList []*post.Post, posts []*models.Post, prior *ranking.RatingPrior) []*post.Post {
+	for i, p := range postList {
+		adjusted := prior.Score(posts[i].RatingSum, posts[i].RatingCount)
+		p.AdjustedScore = &adjusted
+	}
+	return postList
+}
+
+// refreshHotScore 刷新帖子热度，失败只记录日志
+func (h *PostHandler) refreshHotScore(ctx context.Context, postID string) {
+	if err := h.hot.Refresh(ctx, postID); err != nil {
+		log.GetLogger().Warnf("refresh hot score %s failed: %v", postID, err)
 	}
 }
 
@@ -30,44 +108,145 @@ func (h *PostHandler) CreatePost(ctx context.Context, req *post.CreatePostReques
 	logger.Infof("CreatePost req: %v", req)
 
 	// 参数验证
+	newPost, decision, code, message := h.buildPost(ctx, req)
+	if code != constants.SuccessCode {
+		return &post.CreatePostResponse{
+			Code:    code,
+			Message: message,
+		}, nil
+	}
+
+	err := h.db.CreatePost(ctx, newPost)
+	if err != nil {
+		logger.Errorf("CreatePost failed: %s", err)
+		return &post.CreatePostResponse{
+			Code:    constants.PostCreateFailCode,
+			Message: fmt.Sprintf("failed to create post: %s", err),
+		}, nil
+	}
+	h.recordModeration(ctx, decision, newPost.ID)
+
+	// 定时发布和审核中的帖子到点发布时再写入列表、热榜和搜索索引
+	if newPost.Status == models.PostStatusPublished {
+		h.onPostCreated(ctx, newPost)
+	}
+	h.enqueueThumbnails(ctx, newPost)
+
+	kitexPost := models.PostToKitexPost(newPost)
+	if newPost.Poll != nil {
+		kitexPost.Poll = models.PollToKitexPoll(newPost.Poll, nil, time.Now())
+	}
+	return &post.CreatePostResponse{
+		Code: constants.SuccessCode,
+		Post: kitexPost,
+	}, nil
+}
+
+// buildPost 校验发帖请求并生成待创建的帖子，同时返回审核决定，校验失败时返回错误码和错误信息
+func (h *PostHandler) buildPost(ctx context.Context, req *post.CreatePostRequest) (*models.Post, *moderation.Decision, int32, string) {
 	validationErrors := middleware.ValidatePostCreation(req.UserId, req.Title, req.Content)
 	if len(validationErrors) > 0 {
 		errorMsg := "参数验证失败: "
 		for _, ve := range validationErrors {
 			errorMsg += fmt.Sprintf("%s: %s; ", ve.Field, ve.Message)
 		}
-		return &post.CreatePostResponse{
-			Code:    constants.ValidationErrorCode,
-			Message: errorMsg,
-		}, nil
+		return nil, nil, constants.ValidationErrorCode, errorMsg
+	}
+
+	// 被封禁或暂停使用的用户不能发帖
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return nil, nil, code, fmt.Sprintf("failed to create post: %s", constants.GetErrorMessage(code))
+	}
+
+	tags, ok := search.NormalizeTags(req.Tags)
+	if !ok {
+		return nil, nil, constants.ValidationErrorCode, fmt.Sprintf("参数验证失败: tags: 标签不能超过%d个字; ", models.TagMaxLength)
+	}
+
+	// 图片必须是发帖人通过上传凭证上传的
+	images := storage.NormalizeImages(req.Images)
+	if errorMsg := validateImages(ctx, req.UserId, images, nil); errorMsg != "" {
+		return nil, nil, constants.ValidationErrorCode, errorMsg
 	}
 
-	// 创建帖子
 	newPost := &models.Post{
 		UserID:      req.UserId,
 		Title:       req.Title,
 		Content:     req.Content,
 		TopicID:     req.TopicId,
-		Tags:        req.Tags,
+		Tags:        tags,
 		IsAnonymous: req.IsAnonymous,
 		Location:    req.Location,
 		Category:    models.PostCategory(req.Category),
-		Images:      models.StringArray(req.Images),
+		Images:      models.StringArray(images),
 	}
 
-	err := h.db.CreatePost(ctx, newPost)
-	if err != nil {
-		logger.Errorf("CreatePost failed: %s", err)
-		return &post.CreatePostResponse{
-			Code:    constants.PostCreateFailCode,
-			Message: fmt.Sprintf("failed to create post: %s", err),
-		}, nil
+	// 匿名发布时使用马甲名称，马甲必须属于发帖人
+	if req.IsAnonymous && req.GetAnonymousProfileId() != "" {
+		avatar, err := h.db.GetAnonymousAvatar(ctx, req.GetAnonymousProfileId())
+		if err != nil || avatar.UserID != req.UserId {
+			return nil, nil, constants.ValidationErrorCode, "参数验证失败: anonymous_profile_id: anonymous profile not found; "
+		}
+		newPost.AnonymousName = &avatar.Name
 	}
 
-	return &post.CreatePostResponse{
-		Code: constants.SuccessCode,
-		Post: models.PostToKitexPost(newPost),
-	}, nil
+	// 指定发布时间时定时发布，到点前仅作者可见
+	if req.PublishAt != nil {
+		publishAt, ok := validatePublishAt(*req.PublishAt)
+		if !ok {
+			return nil, nil, constants.PostScheduleInvalidCode, fmt.Sprintf("failed to create post: %s", constants.GetErrorMessage(constants.PostScheduleInvalidCode))
+		}
+		newPost.Status = models.PostStatusScheduled
+		newPost.PublishAt = &publishAt
+	}
+
+	// 附带投票
+	if req.Poll != nil {
+		poll, errorMsg := buildPoll(req.Poll, newPost.PublishAt)
+		if errorMsg != "" {
+			return nil, nil, constants.PostPollInvalidCode, "参数验证失败: " + errorMsg
+		}
+		newPost.Poll = poll
+	}
+
+	// 内容审核放在最后，避免校验失败的请求计入重复检测；需要复审的帖子通过后按原定时间发布，通过前仅作者可见
+	decision := h.moderator.Check(ctx, &moderation.Content{
+		TargetType: constants.TargetTypePost,
+		UserID:     req.UserId,
+		Title:      req.Title,
+		Body:       req.Content,
+	})
+	switch decision.Action {
+	case moderation.ActionReject:
+		h.recordModeration(ctx, decision, "")
+		code := int32(constants.PostContentRejectedCode)
+		if decision.Reason == moderation.ReasonSpam {
+			code = constants.PostContentSpamCode
+		}
+		return nil, nil, code, fmt.Sprintf("failed to create post: %s", constants.GetErrorMessage(code))
+	case moderation.ActionReview:
+		newPost.Status = models.PostStatusReviewing
+	}
+	newPost.Title, newPost.Content = decision.Title, decision.Body
+	return newPost, decision, constants.SuccessCode, ""
+}
+
+// recordModeration 记录审核决定，失败只记录日志
+func (h *PostHandler) recordModeration(ctx context.Context, decision *moderation.Decision, postID string) {
+	if err := h.moderator.Record(ctx, decision, postID); err != nil {
+		log.GetLogger().Warnf("record moderation of post %s failed: %v", postID, err)
+	}
+}
+
+// onPostCreated 帖子发布后失效最新列表缓存，写入热榜、搜索索引并记录话题参与
+func (h *PostHandler) onPostCreated(ctx context.Context, newPost *models.Post) {
+	h.db.InvalidateListCache(ctx, cache.TagLatest)
+	h.refreshHotScore(ctx, newPost.ID)
+	h.indexPost(ctx, newPost.ID)
+	if newPost.TopicID != nil && *newPost.TopicID != "" {
+		h.participateTopic(ctx, *newPost.TopicID, newPost.UserID)
+	}
+	h.recordTags(ctx, newPost.Tags)
 }
 
 func (h *PostHandler) GetPost(ctx context.Context, req *post.GetPostRequest) (*post.GetPostResponse, error) {
@@ -91,12 +270,49 @@ func (h *PostHandler) GetPost(ctx context.Context, req *post.GetPostRequest) (*p
 		}, nil
 	}
 
+	// 未发布的帖子仅作者可见，且不计浏览
+	if postModel.Status != models.PostStatusPublished {
+		if postModel.UserID != req.GetViewerId() {
+			return &post.GetPostResponse{
+				Code:    constants.PostNotFoundCode,
+				Message: fmt.Sprintf("failed to get post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+			}, nil
+		}
+		return &post.GetPostResponse{
+			Code: constants.SuccessCode,
+			Post: h.postWithPoll(ctx, postModel, req.GetViewerId()),
+		}, nil
+	}
+
+	// 记录浏览，浏览量由后台任务批量写回并刷新热度，返回时加上尚未写回的部分
+	if _, err := h.views.Record(req.PostId, view.Viewer(req.GetViewerId(), req.GetDeviceId(), req.GetClientIp())); err != nil {
+		logger.Warnf("GetPost record view failed: %s", err)
+	}
+	postModel.ViewCount += h.views.Pending(req.PostId)
+
+	// 看过的帖子记入浏览历史，且不再出现在推荐流中
+	if viewerID := req.GetViewerId(); viewerID != "" {
+		if err := h.history.Add(ctx, viewerID, req.PostId, time.Now()); err != nil {
+			logger.Warnf("GetPost add history failed: %s", err)
+		}
+		if err := h.recommender.MarkSeen(ctx, viewerID, req.PostId); err != nil {
+			logger.Warnf("GetPost MarkSeen failed: %s", err)
+		}
+	}
+
 	return &post.GetPostResponse{
 		Code: constants.SuccessCode,
-		Post: models.PostToKitexPost(postModel),
+		Post: h.postWithPoll(ctx, postModel, req.GetViewerId()),
 	}, nil
 }
 
+// postWithPoll 转换帖子并填充投票结果
+func (h *PostHandler) postWithPoll(ctx context.Context, p *models.Post, viewerID string) *post.Post {
+	kitexPost := models.PostToKitexPost(p)
+	h.attachPolls(ctx, []*models.Post{p}, []*post.Post{kitexPost}, viewerID)
+	return kitexPost
+}
+
 func (h *PostHandler) GetPostList(ctx context.Context, req *post.GetPostListRequest) (*post.GetPostListResponse, error) {
 	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
 	logger.Infof("GetPostList req: %v", req)
@@ -132,121 +348,77 @@ func (h *PostHandler) GetPostList(ctx context.Context, req *post.GetPostListRequ
 		useConditions = true
 	}
 
-	// 根据是否有条件选择查询方法
-	if useConditions {
-		// 使用条件查询
-		posts, err = h.db.GetPostListWithConditions(ctx, conditions, req.Page, req.PageSize, sortType)
-	} else {
-		// 无条件查询，先尝试缓存
-		// posts, err = h.rdb.GetPostList(ctx, req.Page, req.PageSize)
-		// 缓存失败或无数据，回退到数据库
-		logger.Warnf("Cache failed, fallback to database: %s", err)
-		posts, err = h.db.GetPostList(ctx, req.Page, req.PageSize)
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
 	}
 
+	// 游标模式按 (created_at, id) 翻页，只支持按最新排序
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err == nil && cursor != nil && sortType != constants.SortTypeLatest {
+		err = fmt.Errorf("cursor only supports %s sort", constants.SortTypeLatest)
+	}
 	if err != nil {
-		logger.Errorf("GetPostList failed: %s", err)
 		return &post.GetPostListResponse{
-			Code:    constants.DatabaseErrorCode,
+			Code:    constants.ValidationErrorCode,
 			Message: fmt.Sprintf("failed to get post list: %s", err),
 		}, nil
 	}
 
-	// 转换数据格式
-	var postList []*post.Post
-	for _, p := range posts {
-		postList = append(postList, models.PostToKitexPost(p))
-	}
-
-	return &post.GetPostListResponse{
-		Code:  constants.SuccessCode,
-		Posts: postList,
-		Total: int32(len(postList)),
-	}, nil
-}
-
-// 话题管理相关方法
-func (h *PostHandler) CreateTopic(ctx context.Context, req *post.CreateTopicRequest) (*post.CreateTopicResponse, error) {
-	// 参数验证
-	if req.Name == "" {
-		return &post.CreateTopicResponse{
-			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to create topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
-		}, nil
+	// 按最新排序的列表在发帖后需要立即失效
+	var cacheTags []string
+	if sortType == constants.SortTypeLatest {
+		cacheTags = append(cacheTags, cache.TagLatest)
 	}
-
-	topic := &models.Topic{
-		Name:        req.Name,
-		Description: &req.Description,
+	// 查看指定用户的帖子时不排除
+	var hiddenIDs []string
+	if req.GetUserId() == "" {
+		hiddenIDs = h.hiddenAuthors(ctx, req.GetViewerId())
 	}
+	cacheKey := viewerCacheKey(fmt.Sprintf("list:%v:%v:%v:%s:%d:%d:%s", conditions[constants.ParamUserID], conditions[constants.ParamTopicID],
+		conditions[constants.ParamCategory], sortType, req.Page, req.PageSize, req.GetCursor()), hiddenIDs)
 
-	err := h.db.CreateTopic(ctx, topic)
-	if err != nil {
-		// 根据错误类型返回不同的错误码
-		if strings.Contains(err.Error(), "already exists") {
-			return &post.CreateTopicResponse{
-				Code:    constants.TopicAlreadyExistsCode,
-				Message: fmt.Sprintf("failed to create topic: %s", err),
-			}, nil
+	// 根据是否有条件选择查询方法
+	posts, err = h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		if cursor != nil {
+			return h.db.GetPostListByCursor(ctx, conditions, cursor, int(req.PageSize)+1, hiddenIDs)
 		}
-		return &post.CreateTopicResponse{
-			Code:    constants.TopicCreateFailCode,
-			Message: fmt.Sprintf("failed to create topic: %s", err),
-		}, nil
-	}
-
-	description := ""
-	if topic.Description != nil {
-		description = *topic.Description
-	}
-
-	return &post.CreateTopicResponse{
-		Code: constants.SuccessCode,
-		Topic: &post.Topic{
-			Id:               topic.ID,
-			Name:             topic.Name,
-			Description:      description,
-			Icon:             "",              // Topic模型中没有Icon字段
-			Color:            "",              // Topic模型中没有Color字段
-			ParticipantCount: topic.PostCount, // 使用PostCount作为参与者数量
-			CreatedAt:        topic.CreatedAt.Unix(),
-			UpdatedAt:        topic.UpdatedAt.Unix(),
-		},
-	}, nil
-}
+		if useConditions {
+			return h.db.GetPostListWithConditions(ctx, conditions, req.Page, req.PageSize, sortType, hiddenIDs)
+		}
+		return h.db.GetPostList(ctx, req.Page, req.PageSize, hiddenIDs)
+	}, cacheTags...)
 
-func (h *PostHandler) GetTopicList(ctx context.Context, req *post.GetTopicListRequest) (*post.GetTopicListResponse, error) {
-	topics, err := h.db.GetTopicList(ctx, req.Page, req.PageSize)
 	if err != nil {
-		return &post.GetTopicListResponse{
+		logger.Errorf("GetPostList failed: %s", err)
+		return &post.GetPostListResponse{
 			Code:    constants.DatabaseErrorCode,
-			Message: fmt.Sprintf("failed to get topic list: %s", err),
+			Message: fmt.Sprintf("failed to get post list: %s", err),
 		}, nil
 	}
 
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	nextCursor := ""
+	if hasMore && sortType == constants.SortTypeLatest {
+		last := posts[len(posts)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+
 	// 转换数据格式
-	var topicList []*post.Topic
-	for _, t := range topics {
-		description := ""
-		if t.Description != nil {
-			description = *t.Description
-		}
-		topicList = append(topicList, &post.Topic{
-			Id:               t.ID,
-			Name:             t.Name,
-			Description:      description,
-			Icon:             "",          // Topic模型中没有Icon字段
-			Color:            "",          // Topic模型中没有Color字段
-			ParticipantCount: t.PostCount, // 使用PostCount作为参与者数量
-			CreatedAt:        t.CreatedAt.Unix(),
-			UpdatedAt:        t.UpdatedAt.Unix(),
-		})
+	var postList []*post.Post
+	for _, p := range posts {
+		postList = append(postList, models.PostToKitexPost(p))
 	}
+	h.attachPolls(ctx, posts, postList, "")
 
-	return &post.GetTopicListResponse{
-		Code:   constants.SuccessCode,
-		Topics: topicList,
-		Total:  int32(len(topicList)),
+	return &post.GetPostListResponse{
+		Code:       constants.SuccessCode,
+		Posts:      postList,
+		Total:      int32(len(postList)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -259,8 +431,15 @@ func (h *PostHandler) CollectPost(ctx context.Context, req *post.CollectPostRequ
 			Message: fmt.Sprintf("failed to collect post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
 		}, nil
 	}
+	// 被封禁或暂停使用的用户不能收藏
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.CollectPostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to collect post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
 
-	err := h.db.FavoritePost(ctx, req.UserId, req.PostId)
+	err := h.db.FavoritePost(ctx, req.UserId, req.PostId, req.GetFolderId())
 	if err != nil {
 		// 根据错误类型返回不同的错误码
 		if strings.Contains(err.Error(), "post not found") {
@@ -269,6 +448,12 @@ func (h *PostHandler) CollectPost(ctx context.Context, req *post.CollectPostRequ
 				Message: fmt.Sprintf("failed to collect post: %s", err),
 			}, nil
 		}
+		if strings.Contains(err.Error(), "folder not found") {
+			return &post.CollectPostResponse{
+				Code:    constants.PostFolderNotFoundCode,
+				Message: fmt.Sprintf("failed to collect post: %s", err),
+			}, nil
+		}
 		if strings.Contains(err.Error(), "already favorited") {
 			return &post.CollectPostResponse{
 				Code:    constants.PostAlreadyFavoritedCode,
@@ -281,6 +466,9 @@ func (h *PostHandler) CollectPost(ctx context.Context, req *post.CollectPostRequ
 		}, nil
 	}
 
+	h.onPostChanged(ctx, req.PostId)
+	h.db.InvalidateListCache(ctx, cache.CollectedTag(req.UserId))
+
 	return &post.CollectPostResponse{
 		Code:    constants.SuccessCode,
 		Message: "收藏成功",
@@ -304,12 +492,17 @@ func (h *PostHandler) UncollectPost(ctx context.Context, req *post.UncollectPost
 		}, nil
 	}
 
+	h.onPostChanged(ctx, req.PostId)
+	h.db.InvalidateListCache(ctx, cache.CollectedTag(req.UserId))
+
 	return &post.UncollectPostResponse{
 		Code: constants.SuccessCode,
 	}, nil
 }
 
 func (h *PostHandler) GetCollectedPosts(ctx context.Context, req *post.GetCollectedPostsRequest) (*post.GetCollectedPostsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+
 	// 参数验证
 	if req.UserId == "" {
 		return &post.GetCollectedPostsResponse{
@@ -317,8 +510,24 @@ func (h *PostHandler) GetCollectedPosts(ctx context.Context, req *post.GetCollec
 			Message: fmt.Sprintf("failed to get collected posts: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
 		}, nil
 	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
 
-	posts, err := h.db.GetFavoriteList(ctx, req.UserId, req.Page, req.PageSize)
+	// 游标模式按收藏时间翻页
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return &post.GetCollectedPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get collected posts: %s", err),
+		}, nil
+	}
+
+	cacheKey := fmt.Sprintf("collected:%s:%d:%d:%s", req.UserId, req.Page, req.PageSize, req.GetCursor())
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		if cursor != nil {
+			return h.db.GetFavoriteListByCursor(ctx, req.UserId, cursor, int(req.PageSize)+1)
+		}
+		return h.db.GetFavoriteList(ctx, req.UserId, req.Page, req.PageSize)
+	}, cache.CollectedTag(req.UserId))
 	if err != nil {
 		return &post.GetCollectedPostsResponse{
 			Code:    constants.DatabaseErrorCode,
@@ -326,6 +535,18 @@ func (h *PostHandler) GetCollectedPosts(ctx context.Context, req *post.GetCollec
 		}, nil
 	}
 
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	nextCursor := ""
+	if hasMore {
+		last := posts[len(posts)-1]
+		favorite, err := h.db.GetFavorite(ctx, req.UserId, last.ID)
+		if err != nil {
+			logger.Warnf("GetCollectedPosts build cursor failed: %s", err)
+		} else {
+			nextCursor = utils.NewTimeCursor(favorite.CreatedAt, favorite.ID).Encode()
+		}
+	}
+
 	// 转换数据格式
 	var postList []*post.Post
 	for _, p := range posts {
@@ -334,7 +555,8 @@ func (h *PostHandler) GetCollectedPosts(ctx context.Context, req *post.GetCollec
 			UserId:       p.UserID,
 			Title:        p.Title,
 			Content:      p.Content,
-			Images:       []string(p.Images),
+			Images:       storage.ImageURLs(p.Images),
+			Thumbnails:   storage.ThumbnailURLs(p.Images, p.Thumbnails),
 			LikeCount:    int32(p.LikeCount),
 			CommentCount: int32(p.CommentCount),
 			CreatedAt:    p.CreatedAt.Unix(),
@@ -343,25 +565,35 @@ func (h *PostHandler) GetCollectedPosts(ctx context.Context, req *post.GetCollec
 	}
 
 	return &post.GetCollectedPostsResponse{
-		Code:  constants.SuccessCode,
-		Posts: postList,
-		Total: int32(len(postList)),
+		Code:       constants.SuccessCode,
+		Posts:      postList,
+		Total:      int32(len(postList)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
 // 评分功能相关方法
 func (h *PostHandler) RatePost(ctx context.Context, req *post.RatePostRequest) (*post.RatePostResponse, error) {
 	// 参数验证
-	if req.UserId == "" || req.PostId == "" || req.Score < 1 || req.Score > 5 {
+	if req.UserId == "" || req.PostId == "" || req.Score < float64(models.RatingScoreMin) || req.Score > float64(models.RatingScoreMax) {
 		return &post.RatePostResponse{
 			Code:    constants.ValidationErrorCode,
 			Message: fmt.Sprintf("failed to rate post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
 		}, nil
 	}
 
-	// 检查帖子是否存在并获取帖子信息
+	// 被封禁或暂停使用的用户不能评分
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.RatePostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to rate post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	// 检查帖子是否存在并获取帖子信息，定时发布等未发布的帖子不能评分
 	postInfo, err := h.db.GetPost(ctx, req.PostId)
-	if err != nil {
+	if err != nil || postInfo.Status != models.PostStatusPublished {
 		return &post.RatePostResponse{
 			Code:    constants.PostNotFoundCode,
 			Message: fmt.Sprintf("failed to rate post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
@@ -376,6 +608,14 @@ func (h *PostHandler) RatePost(ctx context.Context, req *post.RatePostRequest) (
 		}, nil
 	}
 
+	// 被作者拉黑后不能评分
+	if code := h.blockedByAuthor(ctx, req.UserId, postInfo); code != constants.SuccessCode {
+		return &post.RatePostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to rate post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
 	rating := &models.PostRating{
 		UserID:  req.UserId,
 		PostID:  req.PostId,
@@ -383,7 +623,7 @@ func (h *PostHandler) RatePost(ctx context.Context, req *post.RatePostRequest) (
 		Comment: req.Comment,      // req.Comment已经是*string类型
 	}
 
-	err = h.db.RatePost(ctx, rating)
+	ratedPost, err := h.db.RatePost(ctx, rating)
 	if err != nil {
 		return &post.RatePostResponse{
 			Code:    constants.PostRatingFailCode,
@@ -391,24 +631,23 @@ func (h *PostHandler) RatePost(ctx context.Context, req *post.RatePostRequest) (
 		}, nil
 	}
 
-	// 获取帖子的评分统计信息
-	averageScore, totalRatings, err := h.db.GetPostRatingStats(ctx, req.PostId)
-	if err != nil {
-		return &post.RatePostResponse{
-			Code:    constants.PostRatingFailCode,
-			Message: fmt.Sprintf("failed to get rating stats: %s", err),
-		}, nil
-	}
+	h.onPostChanged(ctx, req.PostId)
 
 	return &post.RatePostResponse{
 		Code:         constants.SuccessCode,
-		AverageScore: averageScore,
-		TotalRatings: totalRatings,
+		AverageScore: ratedPost.AverageScore(),
+		TotalRatings: ratedPost.RatingCount,
 	}, nil
 }
 
 func (h *PostHandler) GetRatingRank(ctx context.Context, req *post.GetRatingRankRequest) (*post.GetRatingRankResponse, error) {
-	posts, err := h.db.GetScoreRanking(ctx, req.Page, req.PageSize)
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	prior := h.ratingPrior(ctx)
+	cacheKey := fmt.Sprintf("rating_rank:%d:%d", req.Page, req.PageSize)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetScoreRanking(ctx, prior, req.Page, req.PageSize)
+	})
 	if err != nil {
 		return &post.GetRatingRankResponse{
 			Code:    constants.PostRatingRankFailCode,
@@ -417,32 +656,24 @@ func (h *PostHandler) GetRatingRank(ctx context.Context, req *post.GetRatingRank
 	}
 
 	// 转换数据格式
-	var postList []*post.Post
-	for _, p := range posts {
-		postList = append(postList, &post.Post{
-			Id:           p.ID,
-			UserId:       p.UserID,
-			Title:        p.Title,
-			Content:      p.Content,
-			Images:       []string(p.Images),
-			LikeCount:    int32(p.LikeCount),
-			CommentCount: int32(p.CommentCount),
-			// Score字段在Post模型中不存在，这里设置为0或从其他地方计算
-			Score:     0.0,
-			CreatedAt: p.CreatedAt.Unix(),
-			UpdatedAt: p.UpdatedAt.Unix(),
-		})
-	}
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	postList := withAdjustedScores(h.convertPostsToResponse(ctx, posts), posts, prior)
 
 	return &post.GetRatingRankResponse{
-		Code:  constants.SuccessCode,
-		Posts: postList,
-		Total: int32(len(postList)),
+		Code:    constants.SuccessCode,
+		Posts:   postList,
+		Total:   int32(len(postList)),
+		HasMore: hasMore,
 	}, nil
 }
 
-// UpdatePost 更新帖子
+// UpdatePost 更新帖子，仅作者或管理员可编辑，内容有变化时保存旧版本
+// 图片、标签和话题不传时保留原值
 func (h *PostHandler) UpdatePost(ctx context.Context, req *post.UpdatePostRequest) (*post.UpdatePostResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	// 只记录ID，不记录帖子正文
+	logger.Infof("UpdatePost post_id: %s, user_id: %s", req.PostId, req.UserId)
+
 	// 参数验证
 	if req.PostId == "" {
 		return &post.UpdatePostResponse{
@@ -450,14 +681,274 @@ func (h *PostHandler) UpdatePost(ctx context.Context, req *post.UpdatePostReques
 			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
 		}, nil
 	}
+	validationErrors := middleware.ValidatePostCreation(req.UserId, req.Title, req.Content)
+	if len(validationErrors) > 0 {
+		errorMsg := "参数验证失败: "
+		for _, ve := range validationErrors {
+			errorMsg += fmt.Sprintf("%s: %s; ", ve.Field, ve.Message)
+		}
+		return &post.UpdatePostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: errorMsg,
+		}, nil
+	}
+
+	tags, ok := search.NormalizeTags(req.Tags)
+	if !ok {
+		return &post.UpdatePostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("参数验证失败: tags: 标签不能超过%d个字; ", models.TagMaxLength),
+		}, nil
+	}
+
+	// 被封禁或暂停使用的用户不能编辑帖子
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.UpdatePostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	existingPost, err := h.db.GetPost(ctx, req.PostId)
+	if err != nil {
+		logger.Errorf("UpdatePost GetPost failed: %s", err)
+		return &post.UpdatePostResponse{
+			Code:    constants.PostNotFoundCode,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+		}, nil
+	}
+
+	// 权限校验：作者本人或管理员
+	isAdmin := req.OperatorRole != nil && *req.OperatorRole == constants.UserRoleAdmin
+	if existingPost.UserID != req.UserId && !isAdmin {
+		return &post.UpdatePostResponse{
+			Code:    constants.PostPermissionDeniedCode,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostPermissionDeniedCode)),
+		}, nil
+	}
+
+	// 审核中的帖子等待复审结果，不能编辑
+	if existingPost.Status == models.PostStatusReviewing {
+		return &post.UpdatePostResponse{
+			Code:    constants.PostReviewingCode,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostReviewingCode)),
+		}, nil
+	}
+
+	// 被举报隐藏或被移除的帖子等待处理结果，不能编辑
+	if existingPost.Status == models.PostStatusHidden || existingPost.Status == models.PostStatusRemoved {
+		return &post.UpdatePostResponse{
+			Code:    constants.PostRemovedCode,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostRemovedCode)),
+		}, nil
+	}
+
+	if req.Tags == nil {
+		tags = existingPost.Tags
+	}
+	topicID := existingPost.TopicID
+	if req.TopicId != nil {
+		topicID = req.TopicId
+		if *req.TopicId == "" {
+			topicID = nil
+		}
+	}
+
+	// 只校验新增的图片，帖子原有的图片(包括旧数据中的图片地址)可以保留
+	images := []string(existingPost.Images)
+	if req.Images != nil {
+		images = storage.NormalizeImages(req.Images)
+	}
+	if errorMsg := validateImages(ctx, req.UserId, images, existingPost.Images); errorMsg != "" {
+		return &post.UpdatePostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: errorMsg,
+		}, nil
+	}
+
+	// 编辑只做敏感词审核，命中需要复审的词时拒绝编辑，避免已发布的帖子退回审核
+	decision := h.moderator.CheckText(&moderation.Content{
+		TargetType: constants.TargetTypePost,
+		UserID:     existingPost.UserID,
+		Title:      req.Title,
+		Body:       req.Content,
+	})
+	if decision.Action == moderation.ActionReject || decision.Action == moderation.ActionReview {
+		h.recordModeration(ctx, decision, req.PostId)
+		return &post.UpdatePostResponse{
+			Code:    constants.PostContentRejectedCode,
+			Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostContentRejectedCode)),
+		}, nil
+	}
+
+	updatedPost, edited, err := h.db.UpdatePost(ctx, &models.Post{
+		ID:         req.PostId,
+		Title:      decision.Title,
+		Content:    decision.Body,
+		Images:     models.StringArray(images),
+		Thumbnails: alignThumbnails(existingPost.Images, existingPost.Thumbnails, images),
+		TopicID:    topicID,
+		Category:   models.PostCategory(req.Category),
+		Location:   req.Location,
+		Tags:       tags,
+	}, req.UserId)
+	if err != nil {
+		logger.Errorf("UpdatePost failed: %s", err)
+		if strings.Contains(err.Error(), "topic not found") {
+			return &post.UpdatePostResponse{
+				Code:    constants.TopicNotFoundCode,
+				Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.TopicNotFoundCode)),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "not found") {
+			return &post.UpdatePostResponse{
+				Code:    constants.PostNotFoundCode,
+				Message: fmt.Sprintf("failed to update post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+			}, nil
+		}
+		return &post.UpdatePostResponse{
+			Code:    constants.PostUpdateFailCode,
+			Message: fmt.Sprintf("failed to update post: %s", err),
+		}, nil
+	}
+
+	h.recordModeration(ctx, decision, req.PostId)
+
+	// 内容没有变化时不产生新版本，也不刷新缓存
+	if edited {
+		h.onPostChanged(ctx, req.PostId)
+		h.db.InvalidateListCache(ctx, cache.RelatedTag(req.PostId))
+		h.enqueueThumbnails(ctx, updatedPost)
+		if updatedPost.Status == models.PostStatusPublished {
+			added, _ := search.DiffTags(existingPost.Tags, updatedPost.Tags)
+			h.recordTags(ctx, added)
+		}
+	}
 
-	// TODO: 实现更新帖子逻辑
 	return &post.UpdatePostResponse{
 		Code:    constants.SuccessCode,
 		Message: "更新成功",
+		Post:    models.PostToKitexPost(updatedPost),
+		Edited:  edited,
 	}, nil
 }
 
+// GetPostRevisions 获取帖子历史版本列表
+func (h *PostHandler) GetPostRevisions(ctx context.Context, req *post.GetPostRevisionsRequest) (*post.GetPostRevisionsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetPostRevisions req: %v", req)
+
+	if req.PostId == "" {
+		return &post.GetPostRevisionsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get post revisions: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	privileged, code := h.revisionAccess(ctx, req.PostId, req.GetViewerId(), req.GetViewerRole())
+	if code != constants.SuccessCode {
+		return &post.GetPostRevisionsResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to get post revisions: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	revisions, total, err := h.db.GetPostRevisions(ctx, req.PostId, page, pageSize)
+	if err != nil {
+		logger.Errorf("GetPostRevisions failed: %s", err)
+		return &post.GetPostRevisionsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get post revisions: %s", err),
+		}, nil
+	}
+
+	revisionList := make([]*post.PostRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionList = append(revisionList, revisionToKitex(revision, privileged))
+	}
+
+	return &post.GetPostRevisionsResponse{
+		Code:      constants.SuccessCode,
+		Revisions: revisionList,
+		Total:     int32(total),
+		HasMore:   int64(page*pageSize) < total,
+	}, nil
+}
+
+// GetPostRevision 获取帖子指定版本
+func (h *PostHandler) GetPostRevision(ctx context.Context, req *post.GetPostRevisionRequest) (*post.GetPostRevisionResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetPostRevision req: %v", req)
+
+	if req.PostId == "" || req.Version <= 0 {
+		return &post.GetPostRevisionResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get post revision: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	privileged, code := h.revisionAccess(ctx, req.PostId, req.GetViewerId(), req.GetViewerRole())
+	if code != constants.SuccessCode {
+		return &post.GetPostRevisionResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to get post revision: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	revision, err := h.db.GetPostRevision(ctx, req.PostId, req.Version)
+	if err != nil {
+		logger.Errorf("GetPostRevision failed: %s", err)
+		if strings.Contains(err.Error(), "not found") {
+			return &post.GetPostRevisionResponse{
+				Code:    constants.PostRevisionNotFoundCode,
+				Message: fmt.Sprintf("failed to get post revision: %s", constants.GetErrorMessage(constants.PostRevisionNotFoundCode)),
+			}, nil
+		}
+		return &post.GetPostRevisionResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get post revision: %s", err),
+		}, nil
+	}
+
+	return &post.GetPostRevisionResponse{
+		Code:     constants.SuccessCode,
+		Revision: revisionToKitex(revision, privileged),
+	}, nil
+}
+
+// revisionAccess 校验查看者能否查看帖子的历史版本，可见范围同帖子详情：
+// 已发布的帖子所有人可见，未发布、被隐藏移除或在回收站中的帖子仅作者和管理员可见
+// privileged 表示查看者是作者或管理员
+func (h *PostHandler) revisionAccess(ctx context.Context, postID, viewerID, viewerRole string) (bool, int32) {
+	postModel, err := h.db.GetPost(ctx, postID)
+	if err == gorm.ErrRecordNotFound && viewerID != "" {
+		postModel, err = h.db.GetDeletedPost(ctx, postID)
+	}
+	if err != nil {
+		if err == gorm.ErrRecordNotFound || strings.Contains(err.Error(), "not found") {
+			return false, constants.PostNotFoundCode
+		}
+		log.GetLogger().Errorf("revisionAccess GetPost %s failed: %v", postID, err)
+		return false, constants.DatabaseErrorCode
+	}
+
+	privileged := (viewerID != "" && postModel.UserID == viewerID) || viewerRole == constants.UserRoleAdmin
+	if !privileged && (postModel.Status != models.PostStatusPublished || postModel.DeletedAt.Valid) {
+		return false, constants.PostNotFoundCode
+	}
+	return privileged, constants.SuccessCode
+}
+
+// revisionToKitex 转换历史版本，编辑者ID只返回给作者和管理员，以免暴露匿名帖子的作者
+func revisionToKitex(revision *models.PostRevision, privileged bool) *post.PostRevision {
+	kitexRevision := models.PostRevisionToKitexPostRevision(revision)
+	if !privileged {
+		kitexRevision.EditorId = ""
+	}
+	return kitexRevision
+}
+
 // DeletePost 删除帖子
 func (h *PostHandler) DeletePost(ctx context.Context, req *post.DeletePostRequest) (*post.DeletePostResponse, error) {
 	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
@@ -471,7 +962,25 @@ func (h *PostHandler) DeletePost(ctx context.Context, req *post.DeletePostReques
 		}, nil
 	}
 
-	err := h.db.DeletePost(ctx, req.PostId)
+	existingPost, err := h.db.GetPost(ctx, req.PostId)
+	if err != nil {
+		logger.Errorf("DeletePost GetPost failed: %s", err)
+		return &post.DeletePostResponse{
+			Code:    constants.PostNotFoundCode,
+			Message: fmt.Sprintf("failed to delete post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+		}, nil
+	}
+
+	// 权限校验：作者本人或管理员
+	isAdmin := req.OperatorRole != nil && *req.OperatorRole == constants.UserRoleAdmin
+	if existingPost.UserID != req.UserId && !isAdmin {
+		return &post.DeletePostResponse{
+			Code:    constants.PostPermissionDeniedCode,
+			Message: fmt.Sprintf("failed to delete post: %s", constants.GetErrorMessage(constants.PostPermissionDeniedCode)),
+		}, nil
+	}
+
+	err = h.db.DeletePost(ctx, req.PostId)
 	if err != nil {
 		logger.Errorf("DeletePost failed: %s", err)
 		if strings.Contains(fmt.Sprintf("%s", err), "not found") {
@@ -485,12 +994,15 @@ func (h *PostHandler) DeletePost(ctx context.Context, req *post.DeletePostReques
 			Message: fmt.Sprintf("failed to delete post: %s", err),
 		}, nil
 	}
+
+	h.onPostChanged(ctx, req.PostId)
+
 	return &post.DeletePostResponse{
 		Code: constants.SuccessCode,
 	}, nil
 }
 
-// GetRecommendPosts 获取推荐帖子
+// GetRecommendPosts 获取推荐帖子，登录用户返回个性化推荐流
 func (h *PostHandler) GetRecommendPosts(ctx context.Context, req *post.GetRecommendPostsRequest) (*post.GetRecommendPostsResponse, error) {
 	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
 	logger.Infof("GetRecommendPosts req: %v", req)
@@ -503,10 +1015,12 @@ func (h *PostHandler) GetRecommendPosts(ctx context.Context, req *post.GetRecomm
 		req.PageSize = 20
 	}
 
-	// 从上下文或其他地方获取用户ID，这里先用空字符串
-	userID := ""
+	viewerID := req.GetViewerId()
+	if viewerID == "" {
+		return h.getPublicRecommendPosts(ctx, req)
+	}
 
-	posts, err := h.db.GetRecommendPosts(ctx, userID, req.Page, req.PageSize)
+	recs, err := h.recommender.Page(ctx, viewerID, req.Page, req.PageSize)
 	if err != nil {
 		logger.Errorf("GetRecommendPosts failed: %s", err)
 		return &post.GetRecommendPostsResponse{
@@ -515,33 +1029,62 @@ func (h *PostHandler) GetRecommendPosts(ctx context.Context, req *post.GetRecomm
 		}, nil
 	}
 
-	// 转换数据格式
-	var postList []*post.Post
-	for _, p := range posts {
-		// 获取帖子的平均评分
-		avgScore, _, _ := h.db.GetPostRatingStats(ctx, p.ID)
+	hasMore := len(recs) > int(req.PageSize)
+	if hasMore {
+		recs = recs[:req.PageSize]
+	}
+	ids := make([]string, 0, len(recs))
+	reasons := make(map[string]string, len(recs))
+	for _, rec := range recs {
+		ids = append(ids, rec.PostID)
+		reasons[rec.PostID] = rec.Reason
+	}
+	// 推荐流生成时已排除拉黑和屏蔽的作者，这里排除推荐流缓存期间新拉黑和屏蔽的用户
+	posts, err := h.db.GetPostsByIDs(ctx, ids, h.hiddenAuthors(ctx, viewerID))
+	if err != nil {
+		logger.Errorf("GetRecommendPosts GetPostsByIDs failed: %s", err)
+		return &post.GetRecommendPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get recommend posts: %s", err),
+		}, nil
+	}
 
-		postList = append(postList, &post.Post{
-			Id:           p.ID,
-			UserId:       p.UserID,
-			TopicId:      p.TopicID,
-			Title:        p.Title,
-			Content:      p.Content,
-			Images:       []string(p.Images),
-			Category:     post.PostCategory(p.Category),
-			IsAnonymous:  p.IsAnonymous,
-			LikeCount:    p.LikeCount,
-			CommentCount: p.CommentCount,
-			ViewCount:    p.ViewCount,
-			ShareCount:   p.ShareCount,
-			CollectCount: p.FavoriteCount,
-			Score:        avgScore,
-			CreatedAt:    p.CreatedAt.Unix(),
-			UpdatedAt:    p.UpdatedAt.Unix(),
-		})
+	// 转换数据格式并附上推荐理由
+	postList := h.convertPostsToResponse(ctx, posts)
+	for _, p := range postList {
+		reason := reasons[p.Id]
+		p.RecommendReason = &reason
+	}
+
+	return &post.GetRecommendPostsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Posts:   postList,
+		Total:   int32(len(postList)),
+		HasMore: hasMore,
+	}, nil
+}
+
+// getPublicRecommendPosts 未登录用户的推荐：最近7天按互动量排序
+func (h *PostHandler) getPublicRecommendPosts(ctx context.Context, req *post.GetRecommendPostsRequest) (*post.GetRecommendPostsResponse, error) {
+	cacheKey := fmt.Sprintf("recommend::%d:%d", req.Page, req.PageSize)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetRecommendPosts(ctx, "", req.Page, req.PageSize)
+	})
+	if err != nil {
+		log.GetLogger().Errorf("GetRecommendPosts failed: %s", err)
+		return &post.GetRecommendPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get recommend posts: %s", err),
+		}, nil
 	}
 
-	hasMore := len(postList) >= int(req.PageSize)
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	postList := h.convertPostsToResponse(ctx, posts)
+	reason := ranking.ReasonHot
+	for _, p := range postList {
+		p.RecommendReason = &reason
+	}
 
 	return &post.GetRecommendPostsResponse{
 		Code:    constants.SuccessCode,
@@ -571,10 +1114,29 @@ func (h *PostHandler) GetHotPosts(ctx context.Context, req *post.GetHotPostsRequ
 	}
 	tag := ""
 	if req.Tag != nil {
-		tag = *req.Tag
+		tag = search.NormalizeTag(*req.Tag)
+	}
+
+	// 游标模式按 (热度, ID) 翻页
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return &post.GetHotPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get hot posts: %s", err),
+		}, nil
 	}
 
-	posts, err := h.db.GetHotPosts(ctx, req.Page, req.PageSize, category, tag)
+	// 从热榜有序集合分页，热榜不可用时回退到数据库查询
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("hot:%s:%s:%d:%d:%s", category, tag, req.Page, req.PageSize, req.GetCursor()), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		posts, err := h.loadHotPosts(ctx, category, tag, cursor, req.Page, req.PageSize, hiddenIDs)
+		if err != nil && cursor == nil {
+			logger.Warnf("GetHotPosts ranker failed, fallback to database: %s", err)
+			return h.db.GetHotPosts(ctx, req.Page, req.PageSize, category, tag, hiddenIDs)
+		}
+		return posts, err
+	})
 	if err != nil {
 		logger.Errorf("GetHotPosts failed: %s", err)
 		return &post.GetHotPostsResponse{
@@ -583,6 +1145,15 @@ func (h *PostHandler) GetHotPosts(ctx context.Context, req *post.GetHotPostsRequ
 		}, nil
 	}
 
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	nextCursor := ""
+	if hasMore {
+		last := posts[len(posts)-1]
+		if score, err := h.hot.Score(ctx, category, tag, last.ID); err == nil {
+			nextCursor = utils.NewScoreCursor(score, last.ID).Encode()
+		}
+	}
+
 	// 转换数据格式
 	var postList []*post.Post
 	for _, p := range posts {
@@ -592,7 +1163,8 @@ func (h *PostHandler) GetHotPosts(ctx context.Context, req *post.GetHotPostsRequ
 			TopicId:      p.TopicID,
 			Title:        p.Title,
 			Content:      p.Content,
-			Images:       []string(p.Images),
+			Images:       storage.ImageURLs(p.Images),
+			Thumbnails:   storage.ThumbnailURLs(p.Images, p.Thumbnails),
 			Category:     post.PostCategory(p.Category),
 			IsAnonymous:  p.IsAnonymous,
 			LikeCount:    p.LikeCount,
@@ -605,14 +1177,13 @@ func (h *PostHandler) GetHotPosts(ctx context.Context, req *post.GetHotPostsRequ
 		})
 	}
 
-	hasMore := len(postList) >= int(req.PageSize)
-
 	return &post.GetHotPostsResponse{
-		Code:    constants.SuccessCode,
-		Message: "获取成功",
-		Posts:   postList,
-		Total:   int32(len(postList)),
-		HasMore: hasMore,
+		Code:       constants.SuccessCode,
+		Message:    "获取成功",
+		Posts:      postList,
+		Total:      int32(len(postList)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -630,10 +1201,15 @@ func (h *PostHandler) GetHighScorePosts(ctx context.Context, req *post.GetHighSc
 	}
 	tag := ""
 	if req.Tag != nil {
-		tag = *req.Tag
+		tag = search.NormalizeTag(*req.Tag)
 	}
 
-	posts, err := h.db.GetHighScorePosts(ctx, req.Page, req.PageSize, category, tag)
+	prior := h.ratingPrior(ctx)
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("high_score:%s:%s:%d:%d", category, tag, req.Page, req.PageSize), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetHighScorePosts(ctx, prior, req.Page, req.PageSize, category, tag, hiddenIDs)
+	})
 	if err != nil {
 		logger.Errorf("GetHighScorePosts failed: %s", err)
 		return &post.GetHighScorePostsResponse{
@@ -643,8 +1219,8 @@ func (h *PostHandler) GetHighScorePosts(ctx context.Context, req *post.GetHighSc
 	}
 
 	// 转换数据格式
-	postList := h.convertPostsToResponse(ctx, posts)
-	hasMore := len(postList) >= int(req.PageSize)
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	postList := withAdjustedScores(h.convertPostsToResponse(ctx, posts), posts, prior)
 
 	return &post.GetHighScorePostsResponse{
 		Code:    constants.SuccessCode,
@@ -669,10 +1245,15 @@ func (h *PostHandler) GetLowScorePosts(ctx context.Context, req *post.GetLowScor
 	}
 	tag := ""
 	if req.Tag != nil {
-		tag = *req.Tag
+		tag = search.NormalizeTag(*req.Tag)
 	}
 
-	posts, err := h.db.GetLowScorePosts(ctx, req.Page, req.PageSize, category, tag)
+	prior := h.ratingPrior(ctx)
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("low_score:%s:%s:%d:%d", category, tag, req.Page, req.PageSize), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetLowScorePosts(ctx, prior, req.Page, req.PageSize, category, tag, hiddenIDs)
+	})
 	if err != nil {
 		logger.Errorf("GetLowScorePosts failed: %s", err)
 		return &post.GetLowScorePostsResponse{
@@ -682,8 +1263,8 @@ func (h *PostHandler) GetLowScorePosts(ctx context.Context, req *post.GetLowScor
 	}
 
 	// 转换数据格式
-	postList := h.convertPostsToResponse(ctx, posts)
-	hasMore := len(postList) >= int(req.PageSize)
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	postList := withAdjustedScores(h.convertPostsToResponse(ctx, posts), posts, prior)
 
 	return &post.GetLowScorePostsResponse{
 		Code:    constants.SuccessCode,
@@ -708,10 +1289,14 @@ func (h *PostHandler) GetControversialPosts(ctx context.Context, req *post.GetCo
 	}
 	tag := ""
 	if req.Tag != nil {
-		tag = *req.Tag
+		tag = search.NormalizeTag(*req.Tag)
 	}
 
-	posts, err := h.db.GetControversialPosts(ctx, req.Page, req.PageSize, category, tag)
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("controversial:%s:%s:%d:%d", category, tag, req.Page, req.PageSize), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetControversialPosts(ctx, req.Page, req.PageSize, category, tag, hiddenIDs)
+	})
 	if err != nil {
 		logger.Errorf("GetControversialPosts failed: %s", err)
 		return &post.GetControversialPostsResponse{
@@ -721,8 +1306,8 @@ func (h *PostHandler) GetControversialPosts(ctx context.Context, req *post.GetCo
 	}
 
 	// 转换数据格式
+	posts, hasMore := splitPage(posts, int(req.PageSize))
 	postList := h.convertPostsToResponse(ctx, posts)
-	hasMore := len(postList) >= int(req.PageSize)
 
 	return &post.GetControversialPostsResponse{
 		Code:    constants.SuccessCode,
@@ -733,13 +1318,14 @@ func (h *PostHandler) GetControversialPosts(ctx context.Context, req *post.GetCo
 	}, nil
 }
 
-// SearchPosts 搜索帖子
+// SearchPosts 搜索帖子，按相关度排序并返回高亮摘要
 func (h *PostHandler) SearchPosts(ctx context.Context, req *post.SearchPostsRequest) (*post.SearchPostsResponse, error) {
 	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
 	logger.Infof("SearchPosts req: %v", req)
 
 	// 参数验证
-	if req.Keyword == "" {
+	keyword := strings.TrimSpace(req.Keyword)
+	if keyword == "" {
 		return &post.SearchPostsResponse{
 			Code:    constants.ValidationErrorCode,
 			Message: "搜索关键词不能为空",
@@ -749,11 +1335,47 @@ func (h *PostHandler) SearchPosts(ctx context.Context, req *post.SearchPostsRequ
 	// 参数校验和默认值设置
 	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
 
+	// 只统计首页搜索，翻页不重复计数
+	if req.Page == 1 {
+		h.recordQuery(ctx, keyword, view.Viewer(req.GetViewerId(), "", req.GetClientIp()))
+	}
+
 	// 搜索参数
-	category := ""       // 后续可以从req中获取
-	sortType := "latest" // 后续可以从req中获取
+	category := ""
+	if req.Category != nil {
+		category = *req.Category
+	}
+	sortType := search.SortRelevance
+	if req.SortType != nil && *req.SortType != "" {
+		sortType = *req.SortType
+	}
+	if sortType != search.SortRelevance && sortType != search.SortLatest {
+		return &post.SearchPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("不支持的排序方式: %s", sortType),
+		}, nil
+	}
 
-	posts, err := h.db.SearchPosts(ctx, req.Keyword, req.Page, req.PageSize, category, sortType)
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("search:%s:%s:%s:%d:%d", keyword, category, sortType, req.Page, req.PageSize), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		hits, err := h.search.Search(ctx, &search.Query{
+			Keyword:        keyword,
+			Category:       category,
+			Sort:           sortType,
+			Page:           req.Page,
+			PageSize:       req.PageSize,
+			ExcludeUserIDs: hiddenIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		posts := make([]*models.Post, 0, len(hits))
+		for _, hit := range hits {
+			posts = append(posts, hit.Post)
+		}
+		return posts, nil
+	})
 	if err != nil {
 		logger.Errorf("SearchPosts failed: %s", err)
 		return &post.SearchPostsResponse{
@@ -762,90 +1384,23 @@ func (h *PostHandler) SearchPosts(ctx context.Context, req *post.SearchPostsRequ
 		}, nil
 	}
 
-	// 转换数据格式
+	// 转换数据格式并生成高亮摘要
+	posts, hasMore := splitPage(posts, int(req.PageSize))
 	postList := h.convertPostsToResponse(ctx, posts)
+	terms := search.Terms(keyword)
+	for i, p := range postList {
+		title := search.Highlight(posts[i].Title, terms, 0)
+		content := search.Highlight(posts[i].Content, terms, search.DefaultSnippetRunes)
+		p.TitleHighlight = &title
+		p.ContentHighlight = &content
+	}
 
 	return &post.SearchPostsResponse{
 		Code:    constants.SuccessCode,
 		Message: "搜索成功",
 		Posts:   postList,
 		Total:   int32(len(postList)),
-	}, nil
-}
-
-// GetTopic 获取话题详情
-func (h *PostHandler) GetTopic(ctx context.Context, req *post.GetTopicRequest) (*post.GetTopicResponse, error) {
-	// 参数验证
-	if req.TopicId == "" {
-		return &post.GetTopicResponse{
-			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to get topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
-		}, nil
-	}
-
-	// TODO: 实现获取话题详情逻辑
-	return &post.GetTopicResponse{
-		Code:    constants.SuccessCode,
-		Message: "获取成功",
-	}, nil
-}
-
-// GetHotTopics 获取热门话题
-func (h *PostHandler) GetHotTopics(ctx context.Context, req *post.GetHotTopicsRequest) (*post.GetHotTopicsResponse, error) {
-	// TODO: 实现获取热门话题逻辑
-	return &post.GetHotTopicsResponse{
-		Code:    constants.SuccessCode,
-		Message: "获取成功",
-		Topics:  []*post.Topic{},
-	}, nil
-}
-
-// GetTopicCategories 获取话题分类
-func (h *PostHandler) GetTopicCategories(ctx context.Context, req *post.GetTopicCategoriesRequest) (*post.GetTopicCategoriesResponse, error) {
-	// TODO: 实现获取话题分类逻辑
-	return &post.GetTopicCategoriesResponse{
-		Code:    constants.SuccessCode,
-		Message: "获取成功",
-		Topics:  []*post.Topic{},
-		Total:   0,
-		HasMore: false,
-	}, nil
-}
-
-// SearchTopics 搜索话题
-func (h *PostHandler) SearchTopics(ctx context.Context, req *post.SearchTopicsRequest) (*post.SearchTopicsResponse, error) {
-	// 参数验证
-	if req.Keyword == "" {
-		return &post.SearchTopicsResponse{
-			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to search topics: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
-		}, nil
-	}
-
-	// TODO: 实现搜索话题逻辑
-	return &post.SearchTopicsResponse{
-		Code:    constants.SuccessCode,
-		Message: "搜索成功",
-		Topics:  []*post.Topic{},
-		Total:   0,
-		HasMore: false,
-	}, nil
-}
-
-// ShareTopic 分享话题
-func (h *PostHandler) ShareTopic(ctx context.Context, req *post.ShareTopicRequest) (*post.ShareTopicResponse, error) {
-	// 参数验证
-	if req.TopicId == "" {
-		return &post.ShareTopicResponse{
-			Code:    constants.ValidationErrorCode,
-			Message: fmt.Sprintf("failed to share topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
-		}, nil
-	}
-
-	// TODO: 实现分享话题逻辑
-	return &post.ShareTopicResponse{
-		Code:    constants.SuccessCode,
-		Message: "分享成功",
+		HasMore: hasMore,
 	}, nil
 }
 
@@ -888,16 +1443,24 @@ func (h *PostHandler) GetUserRating(ctx context.Context, req *post.GetUserRating
 // UpdateRating 更新评分
 func (h *PostHandler) UpdateRating(ctx context.Context, req *post.UpdateRatingRequest) (*post.UpdateRatingResponse, error) {
 	// 参数验证
-	if req.UserId == "" || req.PostId == "" || req.Score < 1 || req.Score > 5 {
+	if req.UserId == "" || req.PostId == "" || req.Score < float64(models.RatingScoreMin) || req.Score > float64(models.RatingScoreMax) {
 		return &post.UpdateRatingResponse{
 			Code:    constants.ValidationErrorCode,
 			Message: fmt.Sprintf("failed to update rating: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
 		}, nil
 	}
 
-	// 检查帖子是否存在并获取帖子信息
+	// 被封禁或暂停使用的用户不能评分
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.UpdateRatingResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update rating: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	// 检查帖子是否存在并获取帖子信息，定时发布等未发布的帖子不能评分
 	postInfo, err := h.db.GetPost(ctx, req.PostId)
-	if err != nil {
+	if err != nil || postInfo.Status != models.PostStatusPublished {
 		return &post.UpdateRatingResponse{
 			Code:    constants.PostNotFoundCode,
 			Message: fmt.Sprintf("failed to update rating: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
@@ -912,6 +1475,14 @@ func (h *PostHandler) UpdateRating(ctx context.Context, req *post.UpdateRatingRe
 		}, nil
 	}
 
+	// 被作者拉黑后不能评分
+	if code := h.blockedByAuthor(ctx, req.UserId, postInfo); code != constants.SuccessCode {
+		return &post.UpdateRatingResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to update rating: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
 	// 检查用户是否已经评分过
 	_, err = h.db.GetUserRating(ctx, req.UserId, req.PostId)
 	if err != nil {
@@ -928,7 +1499,7 @@ func (h *PostHandler) UpdateRating(ctx context.Context, req *post.UpdateRatingRe
 	}
 
 	// 更新评分
-	err = h.db.UpdateRating(ctx, req.UserId, req.PostId, int32(req.Score), req.Comment)
+	ratedPost, err := h.db.UpdateRating(ctx, req.UserId, req.PostId, int32(req.Score), req.Comment)
 	if err != nil {
 		return &post.UpdateRatingResponse{
 			Code:    constants.PostRatingFailCode,
@@ -936,20 +1507,13 @@ func (h *PostHandler) UpdateRating(ctx context.Context, req *post.UpdateRatingRe
 		}, nil
 	}
 
-	// 获取更新后的评分统计信息
-	averageScore, totalRatings, err := h.db.GetPostRatingStats(ctx, req.PostId)
-	if err != nil {
-		return &post.UpdateRatingResponse{
-			Code:    constants.PostRatingFailCode,
-			Message: fmt.Sprintf("failed to get rating stats: %s", err),
-		}, nil
-	}
+	h.onPostChanged(ctx, req.PostId)
 
 	return &post.UpdateRatingResponse{
 		Code:         constants.SuccessCode,
 		Message:      "更新成功",
-		AverageScore: averageScore,
-		TotalRatings: totalRatings,
+		AverageScore: ratedPost.AverageScore(),
+		TotalRatings: ratedPost.RatingCount,
 	}, nil
 }
 
@@ -979,7 +1543,7 @@ func (h *PostHandler) DeleteRating(ctx context.Context, req *post.DeleteRatingRe
 	}
 
 	// 删除评分
-	err = h.db.DeleteRating(ctx, req.UserId, req.PostId)
+	ratedPost, err := h.db.DeleteRating(ctx, req.UserId, req.PostId)
 	if err != nil {
 		return &post.DeleteRatingResponse{
 			Code:    constants.PostRatingFailCode,
@@ -987,35 +1551,76 @@ func (h *PostHandler) DeleteRating(ctx context.Context, req *post.DeleteRatingRe
 		}, nil
 	}
 
-	// 获取删除后的评分统计信息
-	averageScore, totalRatings, err := h.db.GetPostRatingStats(ctx, req.PostId)
+	h.onPostChanged(ctx, req.PostId)
+
+	return &post.DeleteRatingResponse{
+		Code:         constants.SuccessCode,
+		Message:      "删除成功",
+		AverageScore: ratedPost.AverageScore(),
+		TotalRatings: ratedPost.RatingCount,
+	}, nil
+}
+
+// GetPostRatingDistribution 获取帖子评分分布，供评分墙展示
+func (h *PostHandler) GetPostRatingDistribution(ctx context.Context, req *post.GetPostRatingDistributionRequest) (*post.GetPostRatingDistributionResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetPostRatingDistribution req: %v", req)
+
+	if req.PostId == "" {
+		return &post.GetPostRatingDistributionResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get rating distribution: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	stats, err := h.db.GetPostRatingDistribution(ctx, req.PostId)
 	if err != nil {
-		return &post.DeleteRatingResponse{
-			Code:    constants.PostRatingFailCode,
-			Message: fmt.Sprintf("failed to get rating stats: %s", err),
+		logger.Errorf("GetPostRatingDistribution failed: %s", err)
+		if strings.Contains(err.Error(), "not found") {
+			return &post.GetPostRatingDistributionResponse{
+				Code:    constants.PostNotFoundCode,
+				Message: fmt.Sprintf("failed to get rating distribution: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+			}, nil
+		}
+		return &post.GetPostRatingDistributionResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get rating distribution: %s", err),
 		}, nil
 	}
 
-	return &post.DeleteRatingResponse{
+	// 按分值从高到低返回每一档的人数和占比
+	buckets := make([]*post.RatingBucket, 0, models.RatingScoreMax)
+	for score := models.RatingScoreMax; score >= models.RatingScoreMin; score-- {
+		count := stats.RatingHistogram.Count(score)
+		ratio := 0.0
+		if stats.RatingCount > 0 {
+			ratio = float64(count) / float64(stats.RatingCount)
+		}
+		buckets = append(buckets, &post.RatingBucket{
+			Score: score,
+			Count: count,
+			Ratio: ratio,
+		})
+	}
+
+	return &post.GetPostRatingDistributionResponse{
 		Code:         constants.SuccessCode,
-		Message:      "删除成功",
-		AverageScore: averageScore,
-		TotalRatings: totalRatings,
+		AverageScore: stats.AverageScore(),
+		TotalRatings: stats.RatingCount,
+		Buckets:      buckets,
 	}, nil
 }
 
 // convertPostToResponse 转换帖子数据为响应格式的通用方法
 func (h *PostHandler) convertPostToResponse(ctx context.Context, p *models.Post) *post.Post {
-	// 获取帖子的平均评分
-	avgScore, _, _ := h.db.GetPostRatingStats(ctx, p.ID)
-
 	return &post.Post{
 		Id:           p.ID,
 		UserId:       p.UserID,
 		TopicId:      p.TopicID,
 		Title:        p.Title,
 		Content:      p.Content,
-		Images:       []string(p.Images),
+		Images:       storage.ImageURLs(p.Images),
+		Thumbnails:   storage.ThumbnailURLs(p.Images, p.Thumbnails),
 		Category:     post.PostCategory(p.Category),
 		IsAnonymous:  p.IsAnonymous,
 		LikeCount:    p.LikeCount,
@@ -1023,21 +1628,32 @@ func (h *PostHandler) convertPostToResponse(ctx context.Context, p *models.Post)
 		ViewCount:    p.ViewCount,
 		ShareCount:   p.ShareCount,
 		CollectCount: p.FavoriteCount,
-		Score:        avgScore,
+		Score:        p.AverageScore(),
+		RatingCount:  p.RatingCount,
 		CreatedAt:    p.CreatedAt.Unix(),
 		UpdatedAt:    p.UpdatedAt.Unix(),
+		IsEdited:     p.EditedAt != nil,
 	}
 }
 
-// convertPostsToResponse 批量转换帖子数据为响应格式
+// convertPostsToResponse 批量转换帖子数据为响应格式，列表中的投票不含个人投票状态
 func (h *PostHandler) convertPostsToResponse(ctx context.Context, posts []*models.Post) []*post.Post {
 	var postList []*post.Post
 	for _, p := range posts {
 		postList = append(postList, h.convertPostToResponse(ctx, p))
 	}
+	h.attachPolls(ctx, posts, postList, "")
 	return postList
 }
 
+// splitPage 截取一页数据，查询时多取的一条用于判断是否还有下一页
+func splitPage(posts []*models.Post, pageSize int) ([]*models.Post, bool) {
+	if len(posts) > pageSize {
+		return posts[:pageSize], true
+	}
+	return posts, false
+}
+
 // validatePaginationParams 验证分页参数
 func (h *PostHandler) validatePaginationParams(page, pageSize int32) (int32, int32) {
 	if page <= 0 {
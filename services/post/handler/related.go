@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/cache"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+)
+
+// 相关帖子返回条数
+const (
+	defaultRelatedLimit = 6
+	maxRelatedLimit     = 20
+)
+
+// GetRelatedPosts 详情页的相关帖子，结果按帖子缓存
+func (h *PostHandler) GetRelatedPosts(ctx context.Context, req *post.GetRelatedPostsRequest) (*post.GetRelatedPostsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetRelatedPosts req: %v", req)
+
+	// 参数验证
+	if req.PostId == "" {
+		return &post.GetRelatedPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get related posts: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+	if limit > maxRelatedLimit {
+		limit = maxRelatedLimit
+	}
+
+	target, err := h.db.GetPost(ctx, req.PostId)
+	if err != nil {
+		logger.Errorf("GetRelatedPosts GetPost failed: %s", err)
+		return &post.GetRelatedPostsResponse{
+			Code:    constants.PostNotFoundCode,
+			Message: fmt.Sprintf("failed to get related posts: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+		}, nil
+	}
+
+	hiddenIDs := h.hiddenAuthors(ctx, req.GetViewerId())
+	cacheKey := viewerCacheKey(fmt.Sprintf("related:%s:%d", req.PostId, limit), hiddenIDs)
+	posts, err := h.db.GetPostsWithCache(ctx, cacheKey, func() ([]*models.Post, error) {
+		return h.db.GetRelatedPosts(ctx, target, limit, hiddenIDs)
+	}, cache.RelatedTag(req.PostId))
+	if err != nil {
+		logger.Errorf("GetRelatedPosts failed: %s", err)
+		return &post.GetRelatedPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get related posts: %s", err),
+		}, nil
+	}
+
+	return &post.GetRelatedPostsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Posts:   h.convertPostsToResponse(ctx, posts),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+// hotFillBatch 查看者有隐藏的作者时，每次从热榜补读的帖子数
+const hotFillBatch = 100
+
+// blockedByAuthor 校验用户是否被帖子作者拉黑，返回成功码或错误码
+// 匿名帖子不做校验，以免被拉黑的用户据此推断出作者
+func (h *PostHandler) blockedByAuthor(ctx context.Context, userID string, postInfo *models.Post) int32 {
+	if postInfo.IsAnonymous {
+		return constants.SuccessCode
+	}
+	blocked, err := h.relations.BlockedByAny(ctx, userID, postInfo.UserID)
+	if err != nil {
+		log.GetLogger().Errorf("check block %s -> %s failed: %v", postInfo.UserID, userID, err)
+		return constants.DatabaseErrorCode
+	}
+	if blocked {
+		return constants.UserBlockedCode
+	}
+	return constants.SuccessCode
+}
+
+// hiddenAuthors 查看者拉黑和屏蔽的用户，列表查询时排除这些作者的帖子（匿名帖子除外，以免暴露作者）
+// 未登录或查询失败时不排除
+func (h *PostHandler) hiddenAuthors(ctx context.Context, viewerID string) []string {
+	if viewerID == "" {
+		return nil
+	}
+	hiddenIDs, err := h.relations.HiddenIDs(ctx, viewerID)
+	if err != nil {
+		log.GetLogger().Warnf("get hidden users of %s failed: %v", viewerID, err)
+		return nil
+	}
+	return hiddenIDs
+}
+
+// viewerCacheKey 有隐藏作者的查看者的列表缓存按隐藏的用户集合区分，其他查看者共用同一份缓存
+func viewerCacheKey(cacheKey string, hiddenIDs []string) string {
+	if len(hiddenIDs) == 0 {
+		return cacheKey
+	}
+	ids := append([]string(nil), hiddenIDs...)
+	sort.Strings(ids)
+	sum := fnv.New64a()
+	sum.Write([]byte(strings.Join(ids, ",")))
+	return fmt.Sprintf("%s:x%x", cacheKey, sum.Sum64())
+}
+
+// loadHotPosts 从热榜读取一页帖子，多取一条用于判断 has_more
+// 有隐藏的作者时从榜首或游标处分批读取并在查询时排除，直到补足一页或读完热榜，翻页偏移按排除后的结果计算
+func (h *PostHandler) loadHotPosts(ctx context.Context, category, tag string, cursor *utils.Cursor, page, pageSize int32, hiddenIDs []string) ([]*models.Post, error) {
+	if len(hiddenIDs) == 0 {
+		var ids []string
+		var err error
+		if cursor != nil {
+			ids, err = h.hot.After(ctx, category, tag, cursor, int(pageSize)+1)
+		} else {
+			ids, err = h.hot.Page(ctx, category, tag, page, pageSize)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return h.db.GetPostsByIDs(ctx, ids, nil)
+	}
+
+	skip := 0
+	if cursor == nil {
+		skip = int((page - 1) * pageSize)
+	}
+	need := skip + int(pageSize) + 1
+	var posts []*models.Post
+	var start int64
+	for len(posts) < need {
+		var ids []string
+		var err error
+		if cursor != nil {
+			ids, err = h.hot.After(ctx, category, tag, cursor, hotFillBatch)
+		} else {
+			ids, err = h.hot.Range(ctx, category, tag, start, start+hotFillBatch-1)
+			start += hotFillBatch
+		}
+		if err != nil {
+			return nil, err
+		}
+		batch, err := h.db.GetPostsByIDs(ctx, ids, hiddenIDs)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, batch...)
+		if len(ids) < hotFillBatch {
+			break
+		}
+		if cursor != nil {
+			last := ids[len(ids)-1]
+			score, err := h.hot.Score(ctx, category, tag, last)
+			if err != nil {
+				return nil, err
+			}
+			cursor = utils.NewScoreCursor(score, last)
+		}
+	}
+
+	if skip >= len(posts) {
+		return []*models.Post{}, nil
+	}
+	posts = posts[skip:]
+	if len(posts) > int(pageSize)+1 {
+		posts = posts[:int(pageSize)+1]
+	}
+	return posts, nil
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/utils"
+)
+
+// reportDetailMaxLength 举报补充说明的最大长度
+const reportDetailMaxLength = 500
+
+// CreateReport 举报帖子、评论或用户，同一用户对同一对象只能举报一次
+func (h *PostHandler) CreateReport(ctx context.Context, req *post.CreateReportRequest) (*post.CreateReportResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("CreateReport req: %v", req)
+
+	errorMsg := ""
+	switch {
+	case req.UserId == "":
+		errorMsg = "user_id: user_id is required; "
+	case req.TargetType != constants.TargetTypePost && req.TargetType != constants.TargetTypeComment && req.TargetType != constants.TargetTypeUser:
+		errorMsg = "target_type: invalid target type; "
+	case req.TargetId == "":
+		errorMsg = "target_id: target_id is required; "
+	case !moderation.ValidReportReason(req.Reason):
+		errorMsg = "reason: invalid reason; "
+	case utf8.RuneCountInString(req.GetDetail()) > reportDetailMaxLength:
+		errorMsg = fmt.Sprintf("detail: 补充说明不能超过%d个字; ", reportDetailMaxLength)
+	}
+	if errorMsg != "" {
+		return &post.CreateReportResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: " + errorMsg,
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能举报
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.CreateReportResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create report: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	reportCase, err := h.moderator.Report(ctx, req.UserId, req.TargetType, req.TargetId, req.Reason, req.GetDetail())
+	if err != nil {
+		logger.Errorf("CreateReport failed: %s", err)
+		code := int32(constants.DatabaseErrorCode)
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			code = constants.PostReportNotFoundCode
+		case strings.Contains(err.Error(), "yourself"):
+			code = constants.PostReportSelfCode
+		case strings.Contains(err.Error(), "already reported"):
+			code = constants.PostReportDuplicateCode
+		}
+		return &post.CreateReportResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to create report: %s", err),
+		}, nil
+	}
+
+	// 举报人数达到阈值自动隐藏后，帖子移出列表、热榜和搜索索引，评论不再计入帖子评论数
+	if reportCase.Hidden {
+		h.onReportTargetChanged(ctx, reportCase)
+	}
+
+	return &post.CreateReportResponse{
+		Code:    constants.SuccessCode,
+		Message: "举报成功",
+	}, nil
+}
+
+// GetReportCases 获取举报队列，仅管理员
+func (h *PostHandler) GetReportCases(ctx context.Context, req *post.GetReportCasesRequest) (*post.GetReportCasesResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetReportCases req: %v", req)
+
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.GetReportCasesResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to get report cases: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+	status := req.GetStatus()
+	if status == "" {
+		status = models.ReportCasePending
+	}
+	if status != models.ReportCasePending && status != models.ReportCaseApproved &&
+		status != models.ReportCaseRemoved && status != models.ReportCaseBanned {
+		return &post.GetReportCasesResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: status: invalid status; ",
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	cases, total, err := h.moderator.ListReportCases(ctx, status, int(page), int(pageSize))
+	if err != nil {
+		logger.Errorf("GetReportCases failed: %s", err)
+		return &post.GetReportCasesResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get report cases: %s", err),
+		}, nil
+	}
+
+	caseIDs := make([]string, 0, len(cases))
+	for _, c := range cases {
+		caseIDs = append(caseIDs, c.ID)
+	}
+	reasonCounts, err := h.moderator.ReportReasonCounts(ctx, caseIDs)
+	if err != nil {
+		logger.Errorf("GetReportCases ReportReasonCounts failed: %s", err)
+		return &post.GetReportCasesResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get report cases: %s", err),
+		}, nil
+	}
+
+	caseList := make([]*post.ReportCase, 0, len(cases))
+	for _, c := range cases {
+		caseList = append(caseList, reportCaseToKitex(c, reasonCounts[c.ID]))
+	}
+	return &post.GetReportCasesResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Cases:   caseList,
+		Total:   int32(total),
+		HasMore: int64(page)*int64(pageSize) < total,
+	}, nil
+}
+
+// ResolveReportCase 处理举报单，仅管理员
+// approve 恢复被隐藏的内容，remove 移除内容，ban 移除内容并封禁作者，后两者会通知作者
+func (h *PostHandler) ResolveReportCase(ctx context.Context, req *post.ResolveReportCaseRequest) (*post.ResolveReportCaseResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ResolveReportCase req: %v", req)
+
+	if req.CaseId == "" || req.OperatorId == "" {
+		return &post.ResolveReportCaseResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to resolve report case: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	if req.OperatorRole == nil || *req.OperatorRole != constants.UserRoleAdmin {
+		return &post.ResolveReportCaseResponse{
+			Code:    constants.PermissionDeniedCode,
+			Message: fmt.Sprintf("failed to resolve report case: %s", constants.GetErrorMessage(constants.PermissionDeniedCode)),
+		}, nil
+	}
+	if req.Action != moderation.ReportActionApprove && req.Action != moderation.ReportActionRemove && req.Action != moderation.ReportActionBan {
+		return &post.ResolveReportCaseResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: "参数验证失败: action: invalid action; ",
+		}, nil
+	}
+
+	reportCase, err := h.moderator.ResolveReportCase(ctx, req.CaseId, req.OperatorId, req.Action, strings.TrimSpace(req.GetNote()))
+	if err != nil {
+		logger.Errorf("ResolveReportCase failed: %s", err)
+		code := int32(constants.DatabaseErrorCode)
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			code = constants.PostCaseNotFoundCode
+		case strings.Contains(err.Error(), "already resolved"):
+			code = constants.PostReportResolvedCode
+		case strings.Contains(err.Error(), "invalid action"):
+			code = constants.ValidationErrorCode
+		}
+		return &post.ResolveReportCaseResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to resolve report case: %s", err),
+		}, nil
+	}
+
+	h.onReportTargetChanged(ctx, reportCase)
+
+	reasonCounts, err := h.moderator.ReportReasonCounts(ctx, []string{reportCase.ID})
+	if err != nil {
+		logger.Warnf("ResolveReportCase ReportReasonCounts failed: %s", err)
+	}
+	return &post.ResolveReportCaseResponse{
+		Code:       constants.SuccessCode,
+		Message:    "处理成功",
+		ReportCase: reportCaseToKitex(reportCase, reasonCounts[reportCase.ID]),
+	}, nil
+}
+
+// onReportTargetChanged 被举报的帖子或评论状态变化后刷新所属帖子的缓存、热度和搜索索引
+func (h *PostHandler) onReportTargetChanged(ctx context.Context, reportCase *models.ReportCase) {
+	if postID := h.moderator.TargetPostID(ctx, reportCase.TargetType, reportCase.TargetID); postID != "" {
+		h.onPostChanged(ctx, postID)
+	}
+}
+
+// reportCaseToKitex 转换举报单
+func reportCaseToKitex(c *models.ReportCase, reasonCounts map[string]int32) *post.ReportCase {
+	if reasonCounts == nil {
+		reasonCounts = map[string]int32{}
+	}
+	reportCase := &post.ReportCase{
+		Id:            c.ID,
+		TargetType:    c.TargetType,
+		TargetId:      c.TargetID,
+		TargetUserId:  c.TargetUserID,
+		ReporterCount: c.ReporterCount,
+		ReasonCounts:  reasonCounts,
+		Hidden:        c.Hidden,
+		Status:        c.Status,
+		HandlerId:     c.HandlerID,
+		Note:          c.Note,
+		CreatedAt:     c.CreatedAt.Unix(),
+		UpdatedAt:     c.UpdatedAt.Unix(),
+	}
+	if c.HandledAt != nil {
+		handledAt := c.HandledAt.Unix()
+		reportCase.HandledAt = &handledAt
+	}
+	return reportCase
+}
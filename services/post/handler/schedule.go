@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/utils"
+)
+
+const (
+	scheduleLockKey = "post:schedule:lock" // 多实例部署时只有持锁的实例执行定时发布
+	scheduleLockTTL = time.Minute
+)
+
+// scheduleMaxDays 定时发布最多提前的天数
+func scheduleMaxDays() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.ScheduleMaxDays > 0 {
+		return config.GlobalConfig.Post.ScheduleMaxDays
+	}
+	return constants.DefaultScheduleMaxDays
+}
+
+// scheduleInterval 定时发布扫描间隔
+func scheduleInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.ScheduleIntervalSec > 0 {
+		return time.Duration(config.GlobalConfig.Post.ScheduleIntervalSec) * time.Second
+	}
+	return constants.DefaultScheduleInterval
+}
+
+// validatePublishAt 校验定时发布时间，必须晚于当前时间且不超过最大提前天数
+func validatePublishAt(publishAt int64) (time.Time, bool) {
+	now := time.Now()
+	t := time.Unix(publishAt, 0)
+	if !t.After(now) || t.After(now.AddDate(0, 0, scheduleMaxDays())) {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// GetScheduledPosts 获取用户定时发布和已取消定时发布的帖子
+func (h *PostHandler) GetScheduledPosts(ctx context.Context, req *post.GetScheduledPostsRequest) (*post.GetScheduledPostsResponse, error) {
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetScheduledPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get scheduled posts: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	posts, total, err := h.db.GetScheduledPosts(ctx, req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		return &post.GetScheduledPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get scheduled posts: %s", err),
+		}, nil
+	}
+	hasMore := len(posts) > int(req.PageSize)
+	if hasMore {
+		posts = posts[:req.PageSize]
+	}
+
+	// 转换数据格式，保留发布状态和发布时间
+	postList := make([]*post.Post, 0, len(posts))
+	for _, p := range posts {
+		postList = append(postList, models.PostToKitexPost(p))
+	}
+
+	return &post.GetScheduledPostsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Posts:   postList,
+		Total:   int32(total),
+		HasMore: hasMore,
+	}, nil
+}
+
+// ReschedulePost 修改定时发布时间，已取消定时发布的帖子可重新定时
+func (h *PostHandler) ReschedulePost(ctx context.Context, req *post.ReschedulePostRequest) (*post.ReschedulePostResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ReschedulePost req: %v", req)
+
+	// 参数验证
+	if req.PostId == "" || req.UserId == "" {
+		return &post.ReschedulePostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to reschedule post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	publishAt, ok := validatePublishAt(req.PublishAt)
+	if !ok {
+		return &post.ReschedulePostResponse{
+			Code:    constants.PostScheduleInvalidCode,
+			Message: fmt.Sprintf("failed to reschedule post: %s", constants.GetErrorMessage(constants.PostScheduleInvalidCode)),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能修改定时发布
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.ReschedulePostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to reschedule post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	postModel, err := h.db.ReschedulePost(ctx, req.PostId, req.UserId, publishAt)
+	if err != nil {
+		logger.Errorf("ReschedulePost failed: %s", err)
+		return &post.ReschedulePostResponse{
+			Code:    scheduleErrorCode(err),
+			Message: fmt.Sprintf("failed to reschedule post: %s", err),
+		}, nil
+	}
+
+	return &post.ReschedulePostResponse{
+		Code:    constants.SuccessCode,
+		Message: "修改成功",
+		Post:    models.PostToKitexPost(postModel),
+	}, nil
+}
+
+// CancelScheduledPost 取消定时发布，帖子保留为仅作者可见
+func (h *PostHandler) CancelScheduledPost(ctx context.Context, req *post.CancelScheduledPostRequest) (*post.CancelScheduledPostResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("CancelScheduledPost req: %v", req)
+
+	// 参数验证
+	if req.PostId == "" || req.UserId == "" {
+		return &post.CancelScheduledPostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to cancel scheduled post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	postModel, err := h.db.CancelScheduledPost(ctx, req.PostId, req.UserId)
+	if err != nil {
+		logger.Errorf("CancelScheduledPost failed: %s", err)
+		return &post.CancelScheduledPostResponse{
+			Code:    scheduleErrorCode(err),
+			Message: fmt.Sprintf("failed to cancel scheduled post: %s", err),
+		}, nil
+	}
+
+	return &post.CancelScheduledPostResponse{
+		Code:    constants.SuccessCode,
+		Message: "取消成功",
+		Post:    models.PostToKitexPost(postModel),
+	}, nil
+}
+
+// scheduleErrorCode 定时发布操作的错误码
+func scheduleErrorCode(err error) int32 {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		return constants.PostNotFoundCode
+	case strings.Contains(err.Error(), "not scheduled"):
+		return constants.PostNotScheduledCode
+	default:
+		return constants.DatabaseErrorCode
+	}
+}
+
+// PublishDuePosts 发布到期的定时帖子，返回本次发布的帖子数
+// 多实例部署时通过 Redis 锁只由一个实例执行；发布本身按状态条件更新，锁过期导致并发执行时也不会重复发布
+func (h *PostHandler) PublishDuePosts(ctx context.Context) (int, error) {
+	token, ok, err := h.redis.TryLock(scheduleLockKey, scheduleLockTTL)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer h.redis.Unlock(scheduleLockKey, token)
+
+	published := 0
+	for {
+		now := time.Now()
+		postIDs, err := h.db.GetDueScheduledPostIDs(ctx, now, constants.DefaultScheduleBatchSize)
+		if err != nil {
+			return published, err
+		}
+
+		// 发布失败的帖子仍处于定时状态，留到下次扫描重试
+		failed := false
+		for _, postID := range postIDs {
+			postModel, err := h.db.PublishScheduledPost(ctx, postID, now)
+			if err != nil {
+				log.GetLogger().Errorf("PublishScheduledPost %s failed: %v", postID, err)
+				failed = true
+				continue
+			}
+			if postModel == nil {
+				continue
+			}
+			h.onPostCreated(ctx, postModel)
+			published++
+		}
+
+		if failed || len(postIDs) < constants.DefaultScheduleBatchSize {
+			return published, nil
+		}
+	}
+}
+
+// StartScheduleWorker 启动定时发布任务，ctx取消时退出
+func (h *PostHandler) StartScheduleWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(scheduleInterval())
+	defer ticker.Stop()
+
+	for {
+		published, err := h.PublishDuePosts(ctx)
+		if err != nil {
+			logger.Errorf("PublishDuePosts failed: %v", err)
+		} else if published > 0 {
+			logger.Infof("PublishDuePosts published %d posts", published)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/search"
+)
+
+// 搜索建议和热搜的返回条数
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 20
+)
+
+func suggestLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		return maxSuggestLimit
+	}
+	return int(limit)
+}
+
+// recordQuery 记录搜索词，失败只记录日志
+func (h *PostHandler) recordQuery(ctx context.Context, keyword, searcher string) {
+	if err := h.queries.Record(ctx, keyword, searcher); err != nil {
+		log.GetLogger().Warnf("record search query %q failed: %v", keyword, err)
+	}
+}
+
+// GetSearchSuggestions 搜索框自动补全，依次合并话题名、标签、热门搜索词和热门帖子标题
+func (h *PostHandler) GetSearchSuggestions(ctx context.Context, req *post.GetSearchSuggestionsRequest) (*post.GetSearchSuggestionsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetSearchSuggestions req: %v", req)
+
+	prefix := strings.TrimSpace(req.Query)
+	if prefix == "" {
+		return &post.GetSearchSuggestionsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: constants.MsgKeywordEmpty,
+		}, nil
+	}
+	limit := suggestLimit(req.Limit)
+
+	// 单个来源失败不影响其他来源的建议
+	topics, err := h.db.SuggestTopicNames(ctx, prefix, limit)
+	if err != nil {
+		logger.Warnf("SuggestTopicNames failed: %s", err)
+	}
+	tags, err := h.db.SuggestTags(ctx, prefix, limit)
+	if err != nil {
+		logger.Warnf("SuggestTags failed: %s", err)
+	}
+	queries, err := h.queries.Prefix(ctx, prefix, limit)
+	if err != nil {
+		logger.Warnf("QueryLog Prefix failed: %s", err)
+	}
+	titles, err := h.db.SuggestPostTitles(ctx, prefix, limit)
+	if err != nil {
+		logger.Warnf("SuggestPostTitles failed: %s", err)
+	}
+
+	merged := search.MergeSuggestions(limit,
+		search.NewSuggestions(topics, search.SuggestionTopic),
+		search.NewSuggestions(tags, search.SuggestionTag),
+		search.NewSuggestions(queries, search.SuggestionQuery),
+		search.NewSuggestions(titles, search.SuggestionPost),
+	)
+
+	suggestions := make([]*post.SearchSuggestion, 0, len(merged))
+	for _, s := range merged {
+		suggestions = append(suggestions, &post.SearchSuggestion{Text: s.Text, Type: s.Type})
+	}
+
+	return &post.GetSearchSuggestionsResponse{
+		Code:        constants.SuccessCode,
+		Message:     "获取成功",
+		Suggestions: suggestions,
+	}, nil
+}
+
+// GetTrendingSearches 最近24小时的热门搜索词
+func (h *PostHandler) GetTrendingSearches(ctx context.Context, req *post.GetTrendingSearchesRequest) (*post.GetTrendingSearchesResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetTrendingSearches req: %v", req)
+
+	trending, err := h.queries.Trending(ctx, suggestLimit(req.Limit))
+	if err != nil {
+		logger.Errorf("GetTrendingSearches failed: %s", err)
+		return &post.GetTrendingSearchesResponse{
+			Code:    constants.CacheErrorCode,
+			Message: fmt.Sprintf("failed to get trending searches: %s", err),
+		}, nil
+	}
+
+	queries := make([]*post.TrendingSearch, 0, len(trending))
+	for _, t := range trending {
+		queries = append(queries, &post.TrendingSearch{Query: t.Query, Score: t.Score})
+	}
+
+	return &post.GetTrendingSearchesResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Queries: queries,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/ranking"
+	"hupu/shared/search"
+)
+
+// recordTags 记录标签使用次数用于热门标签，失败只记录日志
+func (h *PostHandler) recordTags(ctx context.Context, tags []string) {
+	if err := h.tags.Record(ctx, tags); err != nil {
+		log.GetLogger().Warnf("record tags %v failed: %v", tags, err)
+	}
+}
+
+// GetTrendingTags 获取统计窗口内使用次数最多的标签
+func (h *PostHandler) GetTrendingTags(ctx context.Context, req *post.GetTrendingTagsRequest) (*post.GetTrendingTagsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetTrendingTags req: %v", req)
+
+	window := ranking.TagWindowDay
+	if req.GetWindow() != "" {
+		window = req.GetWindow()
+	}
+	if !ranking.ValidTagWindow(window) {
+		return &post.GetTrendingTagsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get trending tags: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	trends, err := h.tags.Trending(ctx, window, suggestLimit(req.Limit))
+	if err != nil {
+		logger.Errorf("GetTrendingTags failed: %s", err)
+		return &post.GetTrendingTagsResponse{
+			Code:    constants.CacheErrorCode,
+			Message: fmt.Sprintf("failed to get trending tags: %s", err),
+		}, nil
+	}
+
+	names := make([]string, 0, len(trends))
+	for _, t := range trends {
+		names = append(names, t.Name)
+	}
+	tags, err := h.db.GetTagsByNames(ctx, names)
+	if err != nil {
+		logger.Errorf("GetTagsByNames failed: %s", err)
+		return &post.GetTrendingTagsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get trending tags: %s", err),
+		}, nil
+	}
+
+	// 按热度顺序返回，已不被任何帖子使用的标签不再展示
+	tagList := make([]*post.Tag, 0, len(trends))
+	for _, t := range trends {
+		tag, ok := tags[t.Name]
+		if !ok || tag.PostCount <= 0 {
+			continue
+		}
+		kitexTag := models.TagToKitexTag(tag)
+		count := t.Count
+		kitexTag.TrendingCount = &count
+		tagList = append(tagList, kitexTag)
+	}
+
+	return &post.GetTrendingTagsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Tags:    tagList,
+	}, nil
+}
+
+// GetTag 获取标签详情及带有该标签的帖子，标签名按规范化规则匹配
+func (h *PostHandler) GetTag(ctx context.Context, req *post.GetTagRequest) (*post.GetTagResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetTag req: %v", req)
+
+	// 参数验证
+	name := search.NormalizeTag(req.Name)
+	if name == "" {
+		return &post.GetTagResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get tag: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	tag, err := h.db.GetTagByName(ctx, name)
+	if err != nil {
+		logger.Errorf("GetTagByName failed: %s", err)
+		if strings.Contains(err.Error(), "not found") {
+			return &post.GetTagResponse{
+				Code:    constants.PostTagNotFoundCode,
+				Message: fmt.Sprintf("failed to get tag: %s", constants.GetErrorMessage(constants.PostTagNotFoundCode)),
+			}, nil
+		}
+		return &post.GetTagResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get tag: %s", err),
+		}, nil
+	}
+
+	posts, err := h.db.GetTagPosts(ctx, tag.Name, req.Page, req.PageSize, req.GetSortType(), h.hiddenAuthors(ctx, req.GetViewerId()))
+	if err != nil {
+		logger.Errorf("GetTagPosts failed: %s", err)
+		return &post.GetTagResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get tag: %s", err),
+		}, nil
+	}
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+
+	return &post.GetTagResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Tag:     models.TagToKitexTag(tag),
+		Posts:   h.convertPostsToResponse(ctx, posts),
+		HasMore: hasMore,
+	}, nil
+}
@@ -0,0 +1,531 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+	"hupu/shared/view"
+)
+
+// 话题列表返回条数
+const (
+	defaultHotTopicLimit      = 10
+	defaultCategoryTopicLimit = 6
+	maxTopicLimit             = 50
+)
+
+func topicLimit(limit *int32, fallback int) int {
+	if limit == nil || *limit <= 0 {
+		return fallback
+	}
+	if *limit > maxTopicLimit {
+		return maxTopicLimit
+	}
+	return int(*limit)
+}
+
+// participateTopic 记录用户参与话题，失败只记录日志
+func (h *PostHandler) participateTopic(ctx context.Context, topicID, userID string) {
+	if err := h.topics.Participate(ctx, topicID, userID); err != nil {
+		log.GetLogger().Warnf("participate topic %s failed: %v", topicID, err)
+	}
+}
+
+// topicShareURL 话题分享链接
+func topicShareURL(topicID string) string {
+	base := ""
+	if config.GlobalConfig != nil {
+		base = strings.TrimRight(config.GlobalConfig.Post.TopicShareBaseURL, "/")
+	}
+	return base + "/topics/" + topicID
+}
+
+func convertTopicsToResponse(topics []*models.Topic) []*post.Topic {
+	topicList := make([]*post.Topic, 0, len(topics))
+	for _, t := range topics {
+		topicList = append(topicList, models.TopicToKitexTopic(t))
+	}
+	return topicList
+}
+
+// CreateTopic 创建话题
+func (h *PostHandler) CreateTopic(ctx context.Context, req *post.CreateTopicRequest) (*post.CreateTopicResponse, error) {
+	// 参数验证
+	if req.Name == "" {
+		return &post.CreateTopicResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to create topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	topic := &models.Topic{
+		Name:        req.Name,
+		Description: &req.Description,
+		CategoryID:  req.CategoryId,
+		Icon:        req.Icon,
+		Color:       req.Color,
+	}
+
+	err := h.db.CreateTopic(ctx, topic)
+	if err != nil {
+		// 根据错误类型返回不同的错误码
+		if strings.Contains(err.Error(), "already exists") {
+			return &post.CreateTopicResponse{
+				Code:    constants.TopicAlreadyExistsCode,
+				Message: fmt.Sprintf("failed to create topic: %s", err),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "not found") {
+			return &post.CreateTopicResponse{
+				Code:    constants.TopicCategoryNotFoundCode,
+				Message: fmt.Sprintf("failed to create topic: %s", constants.GetErrorMessage(constants.TopicCategoryNotFoundCode)),
+			}, nil
+		}
+		return &post.CreateTopicResponse{
+			Code:    constants.TopicCreateFailCode,
+			Message: fmt.Sprintf("failed to create topic: %s", err),
+		}, nil
+	}
+
+	return &post.CreateTopicResponse{
+		Code:  constants.SuccessCode,
+		Topic: models.TopicToKitexTopic(topic),
+	}, nil
+}
+
+// GetTopicList 获取话题列表
+func (h *PostHandler) GetTopicList(ctx context.Context, req *post.GetTopicListRequest) (*post.GetTopicListResponse, error) {
+	topics, err := h.db.GetTopicList(ctx, req.Page, req.PageSize)
+	if err != nil {
+		return &post.GetTopicListResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get topic list: %s", err),
+		}, nil
+	}
+
+	// 转换数据格式
+	topicList := convertTopicsToResponse(topics)
+
+	return &post.GetTopicListResponse{
+		Code:   constants.SuccessCode,
+		Topics: topicList,
+		Total:  int32(len(topicList)),
+	}, nil
+}
+
+// GetTopic 获取话题详情
+func (h *PostHandler) GetTopic(ctx context.Context, req *post.GetTopicRequest) (*post.GetTopicResponse, error) {
+	// 参数验证
+	if req.TopicId == "" {
+		return &post.GetTopicResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	topic, err := h.db.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.GetTopicResponse{
+				Code:    constants.TopicNotFoundCode,
+				Message: fmt.Sprintf("failed to get topic: %s", constants.GetErrorMessage(constants.TopicNotFoundCode)),
+			}, nil
+		}
+		return &post.GetTopicResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get topic: %s", err),
+		}, nil
+	}
+
+	topicResp := models.TopicToKitexTopic(topic)
+	if viewerID := req.GetViewerId(); viewerID != "" {
+		subscribed, err := h.db.IsTopicSubscribed(ctx, viewerID, topic.ID)
+		if err == nil {
+			topicResp.IsSubscribed = &subscribed
+		}
+	}
+
+	return &post.GetTopicResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Topic:   topicResp,
+	}, nil
+}
+
+// GetHotTopics 获取热门话题，按今日去重参与人数排序
+func (h *PostHandler) GetHotTopics(ctx context.Context, req *post.GetHotTopicsRequest) (*post.GetHotTopicsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	limit := topicLimit(req.Limit, defaultHotTopicLimit)
+
+	heats, err := h.topics.Hot(ctx, limit)
+	if err != nil {
+		logger.Warnf("GetHotTopics ranker failed, fallback to database: %s", err)
+	}
+
+	ids := make([]string, 0, len(heats))
+	today := make(map[string]int32, len(heats))
+	for _, heat := range heats {
+		ids = append(ids, heat.TopicID)
+		today[heat.TopicID] = int32(heat.Today)
+	}
+	topics, err := h.db.GetTopicsByIDs(ctx, ids)
+	if err != nil {
+		return &post.GetHotTopicsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get hot topics: %s", err),
+		}, nil
+	}
+
+	// 今日参与的话题不足时用累计参与人数最多的话题补齐
+	if len(topics) < limit {
+		top, err := h.db.GetTopTopics(ctx, limit)
+		if err != nil {
+			return &post.GetHotTopicsResponse{
+				Code:    constants.DatabaseErrorCode,
+				Message: fmt.Sprintf("failed to get hot topics: %s", err),
+			}, nil
+		}
+		for _, t := range top {
+			if len(topics) >= limit {
+				break
+			}
+			if _, ok := today[t.ID]; !ok {
+				topics = append(topics, t)
+			}
+		}
+	}
+
+	topicList := convertTopicsToResponse(topics)
+	for _, t := range topicList {
+		count := today[t.Id]
+		t.TodayParticipantCount = &count
+	}
+
+	return &post.GetHotTopicsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Topics:  topicList,
+	}, nil
+}
+
+// GetTopicCategories 获取话题分类及各分类下的话题
+func (h *PostHandler) GetTopicCategories(ctx context.Context, req *post.GetTopicCategoriesRequest) (*post.GetTopicCategoriesResponse, error) {
+	limit := topicLimit(req.Limit, defaultCategoryTopicLimit)
+
+	// 指定分类时只返回该分类下的话题
+	if categoryID := req.GetCategoryId(); categoryID != "" {
+		if _, err := h.db.GetTopicCategory(ctx, categoryID); err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				return &post.GetTopicCategoriesResponse{
+					Code:    constants.TopicCategoryNotFoundCode,
+					Message: fmt.Sprintf("failed to get topic categories: %s", constants.GetErrorMessage(constants.TopicCategoryNotFoundCode)),
+				}, nil
+			}
+			return &post.GetTopicCategoriesResponse{
+				Code:    constants.DatabaseErrorCode,
+				Message: fmt.Sprintf("failed to get topic categories: %s", err),
+			}, nil
+		}
+		topics, err := h.db.GetTopicsByCategory(ctx, categoryID, limit)
+		if err != nil {
+			return &post.GetTopicCategoriesResponse{
+				Code:    constants.DatabaseErrorCode,
+				Message: fmt.Sprintf("failed to get topic categories: %s", err),
+			}, nil
+		}
+		hasMore := len(topics) > limit
+		if hasMore {
+			topics = topics[:limit]
+		}
+		return &post.GetTopicCategoriesResponse{
+			Code:    constants.SuccessCode,
+			Message: "获取成功",
+			Topics:  convertTopicsToResponse(topics),
+			Total:   int32(len(topics)),
+			HasMore: hasMore,
+		}, nil
+	}
+
+	categories, err := h.db.GetTopicCategories(ctx)
+	if err != nil {
+		return &post.GetTopicCategoriesResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get topic categories: %s", err),
+		}, nil
+	}
+
+	categoryList := make([]*post.TopicCategory, 0, len(categories))
+	for _, c := range categories {
+		topics, err := h.db.GetTopicsByCategory(ctx, c.ID, limit)
+		if err != nil {
+			return &post.GetTopicCategoriesResponse{
+				Code:    constants.DatabaseErrorCode,
+				Message: fmt.Sprintf("failed to get topic categories: %s", err),
+			}, nil
+		}
+		if len(topics) > limit {
+			topics = topics[:limit]
+		}
+		categoryList = append(categoryList, &post.TopicCategory{
+			Id:     c.ID,
+			Name:   c.Name,
+			Icon:   c.Icon,
+			Topics: convertTopicsToResponse(topics),
+		})
+	}
+
+	return &post.GetTopicCategoriesResponse{
+		Code:       constants.SuccessCode,
+		Message:    "获取成功",
+		Categories: categoryList,
+		Total:      int32(len(categoryList)),
+	}, nil
+}
+
+// SearchTopics 按名称和描述搜索话题
+func (h *PostHandler) SearchTopics(ctx context.Context, req *post.SearchTopicsRequest) (*post.SearchTopicsResponse, error) {
+	// 参数验证
+	keyword := strings.TrimSpace(req.Keyword)
+	if keyword == "" {
+		return &post.SearchTopicsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to search topics: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	// 只统计首页搜索，翻页不重复计数
+	if req.Page == 1 {
+		h.recordQuery(ctx, keyword, view.Viewer(req.GetViewerId(), "", req.GetClientIp()))
+	}
+
+	topics, err := h.db.SearchTopics(ctx, keyword, req.Page, req.PageSize)
+	if err != nil {
+		return &post.SearchTopicsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to search topics: %s", err),
+		}, nil
+	}
+	hasMore := len(topics) > int(req.PageSize)
+	if hasMore {
+		topics = topics[:req.PageSize]
+	}
+
+	return &post.SearchTopicsResponse{
+		Code:    constants.SuccessCode,
+		Message: "搜索成功",
+		Topics:  convertTopicsToResponse(topics),
+		Total:   int32(len(topics)),
+		HasMore: hasMore,
+	}, nil
+}
+
+// ShareTopic 分享话题，分享数加一并返回分享卡片内容
+func (h *PostHandler) ShareTopic(ctx context.Context, req *post.ShareTopicRequest) (*post.ShareTopicResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("ShareTopic req: %v", req)
+
+	// 参数验证
+	if req.TopicId == "" {
+		return &post.ShareTopicResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to share topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	topic, err := h.db.IncrementTopicShareCount(ctx, req.TopicId)
+	if err != nil {
+		logger.Errorf("ShareTopic failed: %s", err)
+		if strings.Contains(err.Error(), "not found") {
+			return &post.ShareTopicResponse{
+				Code:    constants.TopicNotFoundCode,
+				Message: fmt.Sprintf("failed to share topic: %s", constants.GetErrorMessage(constants.TopicNotFoundCode)),
+			}, nil
+		}
+		return &post.ShareTopicResponse{
+			Code:    constants.TopicShareFailCode,
+			Message: fmt.Sprintf("failed to share topic: %s", err),
+		}, nil
+	}
+
+	if req.UserId != "" {
+		h.participateTopic(ctx, topic.ID, req.UserId)
+	}
+
+	description := fmt.Sprintf("%d人正在参与讨论", topic.ParticipantCount)
+	if topic.Description != nil && *topic.Description != "" {
+		description = *topic.Description
+	}
+
+	return &post.ShareTopicResponse{
+		Code:    constants.SuccessCode,
+		Message: "分享成功",
+		Payload: &post.TopicSharePayload{
+			Title:       "#" + topic.Name + "#",
+			Description: description,
+			Icon:        topic.Icon,
+			Url:         topicShareURL(topic.ID),
+		},
+		ShareCount: topic.ShareCount,
+	}, nil
+}
+
+// SubscribeTopic 订阅话题
+func (h *PostHandler) SubscribeTopic(ctx context.Context, req *post.SubscribeTopicRequest) (*post.SubscribeTopicResponse, error) {
+	// 参数验证
+	if req.TopicId == "" || req.UserId == "" {
+		return &post.SubscribeTopicResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to subscribe topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	count, err := h.db.SubscribeTopic(ctx, req.UserId, req.TopicId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return &post.SubscribeTopicResponse{
+				Code:    constants.TopicNotFoundCode,
+				Message: fmt.Sprintf("failed to subscribe topic: %s", constants.GetErrorMessage(constants.TopicNotFoundCode)),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "already subscribed") {
+			return &post.SubscribeTopicResponse{
+				Code:    constants.TopicAlreadySubscribedCode,
+				Message: fmt.Sprintf("failed to subscribe topic: %s", constants.GetErrorMessage(constants.TopicAlreadySubscribedCode)),
+			}, nil
+		}
+		return &post.SubscribeTopicResponse{
+			Code:    constants.TopicSubscribeFailCode,
+			Message: fmt.Sprintf("failed to subscribe topic: %s", err),
+		}, nil
+	}
+
+	return &post.SubscribeTopicResponse{
+		Code:            constants.SuccessCode,
+		Message:         "订阅成功",
+		SubscriberCount: count,
+	}, nil
+}
+
+// UnsubscribeTopic 取消订阅话题
+func (h *PostHandler) UnsubscribeTopic(ctx context.Context, req *post.UnsubscribeTopicRequest) (*post.UnsubscribeTopicResponse, error) {
+	// 参数验证
+	if req.TopicId == "" || req.UserId == "" {
+		return &post.UnsubscribeTopicResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to unsubscribe topic: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+
+	count, err := h.db.UnsubscribeTopic(ctx, req.UserId, req.TopicId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not subscribed") {
+			return &post.UnsubscribeTopicResponse{
+				Code:    constants.TopicNotSubscribedCode,
+				Message: fmt.Sprintf("failed to unsubscribe topic: %s", constants.GetErrorMessage(constants.TopicNotSubscribedCode)),
+			}, nil
+		}
+		return &post.UnsubscribeTopicResponse{
+			Code:    constants.TopicSubscribeFailCode,
+			Message: fmt.Sprintf("failed to unsubscribe topic: %s", err),
+		}, nil
+	}
+
+	return &post.UnsubscribeTopicResponse{
+		Code:            constants.SuccessCode,
+		Message:         "取消订阅成功",
+		SubscriberCount: count,
+	}, nil
+}
+
+// GetSubscribedTopics 获取用户订阅的话题
+func (h *PostHandler) GetSubscribedTopics(ctx context.Context, req *post.GetSubscribedTopicsRequest) (*post.GetSubscribedTopicsResponse, error) {
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetSubscribedTopicsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get subscribed topics: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	topics, err := h.db.GetSubscribedTopics(ctx, req.UserId, req.Page, req.PageSize)
+	if err != nil {
+		return &post.GetSubscribedTopicsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get subscribed topics: %s", err),
+		}, nil
+	}
+	hasMore := len(topics) > int(req.PageSize)
+	if hasMore {
+		topics = topics[:req.PageSize]
+	}
+
+	topicList := convertTopicsToResponse(topics)
+	subscribed := true
+	for _, t := range topicList {
+		t.IsSubscribed = &subscribed
+	}
+
+	return &post.GetSubscribedTopicsResponse{
+		Code:    constants.SuccessCode,
+		Message: "获取成功",
+		Topics:  topicList,
+		Total:   int32(len(topicList)),
+		HasMore: hasMore,
+	}, nil
+}
+
+// GetSubscribedTopicFeed 用户订阅话题下的新帖，按发布时间倒序
+func (h *PostHandler) GetSubscribedTopicFeed(ctx context.Context, req *post.GetSubscribedTopicFeedRequest) (*post.GetSubscribedTopicFeedResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+
+	// 参数验证
+	if req.UserId == "" {
+		return &post.GetSubscribedTopicFeedResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get subscribed topic feed: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	req.Page, req.PageSize = h.validatePaginationParams(req.Page, req.PageSize)
+
+	cursor, err := utils.DecodeCursor(req.GetCursor())
+	if err != nil {
+		return &post.GetSubscribedTopicFeedResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get subscribed topic feed: %s", err),
+		}, nil
+	}
+
+	posts, err := h.db.GetSubscribedTopicFeed(ctx, req.UserId, cursor, req.Page, req.PageSize, h.hiddenAuthors(ctx, req.UserId))
+	if err != nil {
+		logger.Errorf("GetSubscribedTopicFeed failed: %s", err)
+		return &post.GetSubscribedTopicFeedResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get subscribed topic feed: %s", err),
+		}, nil
+	}
+
+	posts, hasMore := splitPage(posts, int(req.PageSize))
+	nextCursor := ""
+	if hasMore {
+		last := posts[len(posts)-1]
+		nextCursor = utils.NewTimeCursor(last.CreatedAt, last.ID).Encode()
+	}
+
+	return &post.GetSubscribedTopicFeedResponse{
+		Code:       constants.SuccessCode,
+		Message:    "获取成功",
+		Posts:      h.convertPostsToResponse(ctx, posts),
+		Total:      int32(len(posts)),
+		HasMore:    hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hupu/kitex_gen/post"
+	"hupu/shared/cache"
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/moderation"
+	"hupu/shared/utils"
+)
+
+// trashRetentionDays 回收站保留天数
+func trashRetentionDays() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.TrashRetentionDays > 0 {
+		return config.GlobalConfig.Post.TrashRetentionDays
+	}
+	return constants.DefaultTrashRetentionDays
+}
+
+// trashPurgeInterval 回收站清理间隔
+func trashPurgeInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.TrashPurgeIntervalMin > 0 {
+		return time.Duration(config.GlobalConfig.Post.TrashPurgeIntervalMin) * time.Minute
+	}
+	return constants.DefaultTrashPurgeInterval
+}
+
+// trashCutoff 回收站可恢复的最早删除时间
+func trashCutoff() time.Time {
+	return time.Now().AddDate(0, 0, -trashRetentionDays())
+}
+
+// GetDeletedPosts 获取最近删除的帖子
+func (h *PostHandler) GetDeletedPosts(ctx context.Context, req *post.GetDeletedPostsRequest) (*post.GetDeletedPostsResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("GetDeletedPosts req: %v", req)
+
+	if req.UserId == "" {
+		return &post.GetDeletedPostsResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to get deleted posts: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	page, pageSize := h.validatePaginationParams(req.Page, req.PageSize)
+
+	posts, total, err := h.db.GetDeletedPosts(ctx, req.UserId, trashCutoff(), page, pageSize)
+	if err != nil {
+		logger.Errorf("GetDeletedPosts failed: %s", err)
+		return &post.GetDeletedPostsResponse{
+			Code:    constants.DatabaseErrorCode,
+			Message: fmt.Sprintf("failed to get deleted posts: %s", err),
+		}, nil
+	}
+
+	postList := make([]*post.Post, 0, len(posts))
+	for _, p := range posts {
+		kitexPost := models.PostToKitexPost(p)
+		if p.DeletedAt.Valid {
+			deletedAt := p.DeletedAt.Time.Unix()
+			kitexPost.DeletedAt = &deletedAt
+		}
+		postList = append(postList, kitexPost)
+	}
+
+	return &post.GetDeletedPostsResponse{
+		Code:          constants.SuccessCode,
+		Posts:         postList,
+		Total:         int32(total),
+		HasMore:       int64(page*pageSize) < total,
+		RetentionDays: int32(trashRetentionDays()),
+	}, nil
+}
+
+// RestorePost 从回收站恢复帖子
+func (h *PostHandler) RestorePost(ctx context.Context, req *post.RestorePostRequest) (*post.RestorePostResponse, error) {
+	logger := log.GetLogger().WithField(constants.TraceIdKey, ctx.Value(constants.TraceIdKey).(string))
+	logger.Infof("RestorePost req: %v", req)
+
+	if req.PostId == "" || req.UserId == "" {
+		return &post.RestorePostResponse{
+			Code:    constants.ValidationErrorCode,
+			Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(constants.ValidationErrorCode)),
+		}, nil
+	}
+	// 被封禁或暂停使用的用户不能恢复帖子
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &post.RestorePostResponse{
+			Code:    code,
+			Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(code)),
+		}, nil
+	}
+
+	deletedPost, err := h.db.GetDeletedPost(ctx, req.PostId)
+	if err != nil {
+		logger.Errorf("RestorePost GetDeletedPost failed: %s", err)
+		return &post.RestorePostResponse{
+			Code:    constants.PostNotFoundCode,
+			Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+		}, nil
+	}
+
+	// 权限校验：作者本人或管理员
+	isAdmin := req.OperatorRole != nil && *req.OperatorRole == constants.UserRoleAdmin
+	if deletedPost.UserID != req.UserId && !isAdmin {
+		return &post.RestorePostResponse{
+			Code:    constants.PostPermissionDeniedCode,
+			Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(constants.PostPermissionDeniedCode)),
+		}, nil
+	}
+
+	restoredPost, err := h.db.RestorePost(ctx, req.PostId, trashCutoff())
+	if err != nil {
+		logger.Errorf("RestorePost failed: %s", err)
+		if strings.Contains(err.Error(), "expired") {
+			return &post.RestorePostResponse{
+				Code:    constants.PostRestoreExpiredCode,
+				Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(constants.PostRestoreExpiredCode)),
+			}, nil
+		}
+		if strings.Contains(err.Error(), "not found") {
+			return &post.RestorePostResponse{
+				Code:    constants.PostNotFoundCode,
+				Message: fmt.Sprintf("failed to restore post: %s", constants.GetErrorMessage(constants.PostNotFoundCode)),
+			}, nil
+		}
+		return &post.RestorePostResponse{
+			Code:    constants.PostRestoreFailCode,
+			Message: fmt.Sprintf("failed to restore post: %s", err),
+		}, nil
+	}
+
+	h.db.InvalidateListCache(ctx, cache.TagLatest)
+	h.refreshHotScore(ctx, req.PostId)
+	h.indexPost(ctx, req.PostId)
+
+	return &post.RestorePostResponse{
+		Code:    constants.SuccessCode,
+		Message: "恢复成功",
+		Post:    models.PostToKitexPost(restoredPost),
+	}, nil
+}
+
+// PurgeExpiredPosts 彻底删除超过回收站保留期限的帖子
+func (h *PostHandler) PurgeExpiredPosts(ctx context.Context) (int64, error) {
+	return h.db.PurgeDeletedPosts(ctx, trashCutoff(), constants.DefaultTrashPurgeBatchSize)
+}
+
+// StartTrashPurgeWorker 启动回收站定时清理任务，ctx取消时退出
+func (h *PostHandler) StartTrashPurgeWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(trashPurgeInterval())
+	defer ticker.Stop()
+
+	for {
+		purged, err := h.PurgeExpiredPosts(ctx)
+		if err != nil {
+			logger.Errorf("PurgeExpiredPosts failed: %v", err)
+		} else if purged > 0 {
+			logger.Infof("PurgeExpiredPosts purged %d posts", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
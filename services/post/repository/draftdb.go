@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/models"
+)
+
+// CreateDraft 创建草稿
+func (r *PostRepository) CreateDraft(ctx context.Context, draft *models.PostDraft) error {
+	draft.ID = xid.New().String()
+	draft.Version = 1
+	draft.CreatedAt = time.Now()
+	draft.UpdatedAt = draft.CreatedAt
+	return r.db.WithContext(ctx).Create(draft).Error
+}
+
+// GetDraft 获取用户的草稿
+func (r *PostRepository) GetDraft(ctx context.Context, draftID, userID string) (*models.PostDraft, error) {
+	var draft models.PostDraft
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).First(&draft).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("draft not found")
+		}
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// GetDraftList 获取用户的草稿列表，按最后保存时间倒序
+func (r *PostRepository) GetDraftList(ctx context.Context, userID string, page, pageSize int32) ([]*models.PostDraft, error) {
+	var drafts []*models.PostDraft
+	offset := (page - 1) * pageSize
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC, id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&drafts).Error
+	return drafts, err
+}
+
+// UpdateDraft 按版本号更新草稿，版本号不一致时返回服务端当前的草稿和冲突错误
+func (r *PostRepository) UpdateDraft(ctx context.Context, draft *models.PostDraft, version int32) (*models.PostDraft, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.PostDraft{}).
+		Where("id = ? AND user_id = ? AND version = ?", draft.ID, draft.UserID, version).
+		Updates(map[string]interface{}{
+			"title":                draft.Title,
+			"content":              draft.Content,
+			"images":               draft.Images,
+			"topic_id":             draft.TopicID,
+			"category":             draft.Category,
+			"is_anonymous":         draft.IsAnonymous,
+			"anonymous_profile_id": draft.AnonymousProfileID,
+			"location":             draft.Location,
+			"tags":                 draft.Tags,
+			"version":              gorm.Expr("version + 1"),
+			"updated_at":           time.Now(),
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	current, err := r.GetDraft(ctx, draft.ID, draft.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return current, fmt.Errorf("draft version conflict")
+	}
+	return current, nil
+}
+
+// DeleteDraft 删除草稿
+func (r *PostRepository) DeleteDraft(ctx context.Context, draftID, userID string) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).Delete(&models.PostDraft{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("draft not found")
+	}
+	return nil
+}
+
+// PublishDraft 在同一事务内将草稿发布为帖子并删除草稿
+// 草稿在读取后被修改或已被发布时返回冲突错误，避免重复发帖
+func (r *PostRepository) PublishDraft(ctx context.Context, draftID, userID string, version int32, post *models.Post) error {
+	post.ID = xid.New().String()
+	post.CreatedAt = time.Now()
+	post.UpdatedAt = post.CreatedAt
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var draft models.PostDraft
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", draftID, userID).
+			First(&draft).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("draft not found")
+			}
+			return err
+		}
+		if draft.Version != version {
+			return fmt.Errorf("draft version conflict")
+		}
+
+		if err := createPost(tx, post); err != nil {
+			return err
+		}
+		return tx.Delete(&draft).Error
+	})
+}
+
+// PurgeStaleDrafts 删除超过保留期限未更新的草稿
+func (r *PostRepository) PurgeStaleDrafts(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	var purged int64
+	for {
+		var draftIDs []string
+		if err := r.db.WithContext(ctx).Model(&models.PostDraft{}).
+			Where("updated_at < ?", before).
+			Limit(batchSize).Pluck("id", &draftIDs).Error; err != nil {
+			return purged, err
+		}
+		if len(draftIDs) == 0 {
+			return purged, nil
+		}
+
+		result := r.db.WithContext(ctx).Where("id IN ?", draftIDs).Delete(&models.PostDraft{})
+		if result.Error != nil {
+			return purged, result.Error
+		}
+		purged += result.RowsAffected
+
+		if len(draftIDs) < batchSize {
+			return purged, nil
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/models"
+)
+
+// ensureDefaultFolder 获取用户的默认收藏夹，不存在时创建
+// 新建默认收藏夹时，收藏夹功能上线前的收藏（包括回收站中的）一并归入
+func ensureDefaultFolder(tx *gorm.DB, userID string) (*models.FavoriteFolder, error) {
+	var folder models.FavoriteFolder
+	err := tx.Where("user_id = ? AND is_default = ?", userID, true).First(&folder).Error
+	if err == nil {
+		return &folder, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	// 收藏夹名称按用户唯一，并发创建时只有一个成功
+	now := time.Now()
+	folder = models.FavoriteFolder{
+		ID:        xid.New().String(),
+		UserID:    userID,
+		Name:      models.DefaultFavoriteFolderName,
+		IsDefault: true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&folder)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		if err := tx.Where("user_id = ? AND is_default = ?", userID, true).First(&folder).Error; err != nil {
+			return nil, err
+		}
+		return &folder, nil
+	}
+
+	if err := tx.Unscoped().Model(&models.PostFavorite{}).
+		Where("user_id = ? AND folder_id = ?", userID, "").
+		UpdateColumn("folder_id", folder.ID).Error; err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// getOwnedFolder 获取属于用户的收藏夹
+func getOwnedFolder(tx *gorm.DB, folderID, userID string) (*models.FavoriteFolder, error) {
+	var folder models.FavoriteFolder
+	err := tx.Where("id = ? AND user_id = ?", folderID, userID).First(&folder).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// folderNameExists 用户是否已有同名收藏夹，excludeID 为正在修改的收藏夹
+func folderNameExists(tx *gorm.DB, userID, name, excludeID string) (bool, error) {
+	var count int64
+	err := tx.Model(&models.FavoriteFolder{}).
+		Where("user_id = ? AND name = ? AND id <> ?", userID, name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// fillFolderPostCounts 统计各收藏夹中已发布帖子的数量
+func (r *PostRepository) fillFolderPostCounts(ctx context.Context, folders []*models.FavoriteFolder) error {
+	if len(folders) == 0 {
+		return nil
+	}
+	folderIDs := make([]string, 0, len(folders))
+	for _, f := range folders {
+		folderIDs = append(folderIDs, f.ID)
+	}
+
+	var counts []struct {
+		FolderID string
+		Count    int32
+	}
+	err := r.db.WithContext(ctx).
+		Table("post_favorites").
+		Select("post_favorites.folder_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = post_favorites.post_id AND posts.deleted_at IS NULL").
+		Scopes(models.Published).
+		Where("post_favorites.folder_id IN ? AND post_favorites.deleted_at IS NULL", folderIDs).
+		Group("post_favorites.folder_id").
+		Scan(&counts).Error
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]int32, len(counts))
+	for _, c := range counts {
+		byID[c.FolderID] = c.Count
+	}
+	for _, f := range folders {
+		f.PostCount = byID[f.ID]
+	}
+	return nil
+}
+
+// GetFavoriteFolders 获取用户的收藏夹，默认收藏夹在最前，publicOnly 为 true 时只返回公开的收藏夹
+// 所有者查看时确保默认收藏夹存在
+func (r *PostRepository) GetFavoriteFolders(ctx context.Context, userID string, publicOnly bool) ([]*models.FavoriteFolder, error) {
+	if !publicOnly {
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			_, err := ensureDefaultFolder(tx, userID)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var folders []*models.FavoriteFolder
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if publicOnly {
+		query = query.Where("is_public = ?", true)
+	}
+	if err := query.Order("is_default DESC, created_at ASC, id ASC").Find(&folders).Error; err != nil {
+		return nil, err
+	}
+	if err := r.fillFolderPostCounts(ctx, folders); err != nil {
+		return nil, err
+	}
+	return folders, nil
+}
+
+// GetFavoriteFolder 获取收藏夹及其帖子数
+func (r *PostRepository) GetFavoriteFolder(ctx context.Context, folderID string) (*models.FavoriteFolder, error) {
+	var folder models.FavoriteFolder
+	err := r.db.WithContext(ctx).Where("id = ?", folderID).First(&folder).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, err
+	}
+	if err := r.fillFolderPostCounts(ctx, []*models.FavoriteFolder{&folder}); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
+
+// CreateFavoriteFolder 创建收藏夹，名称按用户唯一，每个用户最多 FavoriteFolderMax 个收藏夹
+func (r *PostRepository) CreateFavoriteFolder(ctx context.Context, folder *models.FavoriteFolder) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 先创建默认收藏夹，保留默认名称并计入数量上限
+		if _, err := ensureDefaultFolder(tx, folder.UserID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.FavoriteFolder{}).Where("user_id = ?", folder.UserID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= models.FavoriteFolderMax {
+			return fmt.Errorf("folder limit exceeded")
+		}
+		exists, err := folderNameExists(tx, folder.UserID, folder.Name, "")
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("folder name exists")
+		}
+
+		folder.ID = xid.New().String()
+		folder.CreatedAt = time.Now()
+		folder.UpdatedAt = folder.CreatedAt
+		return tx.Create(folder).Error
+	})
+}
+
+// UpdateFavoriteFolder 修改收藏夹名称或公开状态，name 和 isPublic 为空时不修改
+func (r *PostRepository) UpdateFavoriteFolder(ctx context.Context, folderID, userID string, name *string, isPublic *bool) (*models.FavoriteFolder, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := getOwnedFolder(tx, folderID, userID); err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{"updated_at": time.Now()}
+		if name != nil {
+			exists, err := folderNameExists(tx, userID, *name, folderID)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("folder name exists")
+			}
+			updates["name"] = *name
+		}
+		if isPublic != nil {
+			updates["is_public"] = *isPublic
+		}
+		return tx.Model(&models.FavoriteFolder{}).Where("id = ?", folderID).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetFavoriteFolder(ctx, folderID)
+}
+
+// DeleteFavoriteFolder 删除收藏夹，其中的收藏（包括回收站中帖子的收藏）移到默认收藏夹
+func (r *PostRepository) DeleteFavoriteFolder(ctx context.Context, folderID, userID string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		folder, err := getOwnedFolder(tx, folderID, userID)
+		if err != nil {
+			return err
+		}
+		if folder.IsDefault {
+			return fmt.Errorf("default folder cannot be deleted")
+		}
+
+		defaultFolder, err := ensureDefaultFolder(tx, userID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.PostFavorite{}).
+			Where("folder_id = ?", folderID).
+			UpdateColumn("folder_id", defaultFolder.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.FavoriteFolder{}, "id = ?", folderID).Error
+	})
+}
+
+// MoveFavorites 将用户已收藏的帖子移到指定收藏夹，返回实际移动的收藏数
+func (r *PostRepository) MoveFavorites(ctx context.Context, userID string, postIDs []string, folderID string) (int64, error) {
+	var moved int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := getOwnedFolder(tx, folderID, userID); err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.PostFavorite{}).
+			Where("user_id = ? AND post_id IN ? AND folder_id <> ?", userID, postIDs, folderID).
+			Updates(map[string]interface{}{"folder_id": folderID, "updated_at": time.Now()})
+		moved = result.RowsAffected
+		return result.Error
+	})
+	return moved, err
+}
+
+// GetFolderPosts 获取收藏夹中的已发布帖子，按收藏时间倒序
+func (r *PostRepository) GetFolderPosts(ctx context.Context, folderID string, page, pageSize int32) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var total int64
+	offset := (page - 1) * pageSize
+
+	query := r.db.WithContext(ctx).
+		Table("posts").
+		Scopes(models.Published).
+		Joins("JOIN post_favorites ON posts.id = post_favorites.post_id").
+		Where("post_favorites.folder_id = ? AND post_favorites.deleted_at IS NULL AND posts.deleted_at IS NULL", folderID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("post_favorites.created_at DESC, post_favorites.id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&posts).Error
+
+	return posts, total, err
+}
@@ -3,8 +3,13 @@ package repository
 import (
 	"context"
 	"fmt"
+	"hupu/shared/models"
+	"hupu/shared/search"
 	"hupu/shared/utils"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/rs/xid"
 	"gorm.io/gorm"
 )
 
@@ -29,8 +34,8 @@ func (m *DatabaseMigrator) CreateAdvancedIndexes(ctx context.Context) error {
 
 	// 定义需要创建的高级索引
 	indexes := []string{
-		// 全文搜索索引（MySQL 5.7+）
-		"CREATE FULLTEXT INDEX idx_posts_title_content ON posts(title, content)",
+		// 全文搜索索引（MySQL 5.7+），使用 ngram 分词以支持中文
+		"CREATE FULLTEXT INDEX idx_posts_title_content ON posts(title, content) WITH PARSER ngram",
 
 		// JSON标签搜索的虚拟列索引（MySQL 5.7+）
 		// 先创建虚拟列，再在虚拟列上创建索引
@@ -128,6 +133,127 @@ func (m *DatabaseMigrator) DropUnusedIndexes(ctx context.Context) error {
 	return nil
 }
 
+// RebuildFulltextIndex 旧版本创建的全文索引未使用 ngram 分词，无法检索中文，需要重建
+func (m *DatabaseMigrator) RebuildFulltextIndex(ctx context.Context) error {
+	var table struct {
+		Table       string `gorm:"column:Table"`
+		CreateTable string `gorm:"column:Create Table"`
+	}
+	if err := m.db.WithContext(ctx).Raw("SHOW CREATE TABLE posts").Scan(&table).Error; err != nil {
+		return fmt.Errorf("failed to show create table posts: %w", err)
+	}
+	if !strings.Contains(table.CreateTable, "idx_posts_title_content") || strings.Contains(table.CreateTable, "ngram") {
+		return nil
+	}
+
+	rebuild := []string{
+		"DROP INDEX idx_posts_title_content ON posts",
+		"CREATE FULLTEXT INDEX idx_posts_title_content ON posts(title, content) WITH PARSER ngram",
+	}
+	for _, sql := range rebuild {
+		if err := m.db.WithContext(ctx).Exec(sql).Error; err != nil {
+			return fmt.Errorf("failed to rebuild fulltext index: %s, error: %w", sql, err)
+		}
+	}
+	return nil
+}
+
+// BackfillRatingAggregates 根据评分记录回填帖子上的评分聚合和评分分布
+func (m *DatabaseMigrator) BackfillRatingAggregates(ctx context.Context) error {
+	buckets := make([]string, 0, models.RatingScoreMax)
+	for score := models.RatingScoreMin; score <= models.RatingScoreMax; score++ {
+		buckets = append(buckets, fmt.Sprintf("SUM(score = %d)", score))
+	}
+
+	sql := fmt.Sprintf(`UPDATE posts p JOIN (
+			SELECT post_id, SUM(score) AS rating_sum, COUNT(*) AS rating_count, JSON_ARRAY(%s) AS rating_histogram
+			FROM post_ratings WHERE deleted_at IS NULL GROUP BY post_id
+		) r ON p.id = r.post_id
+		SET p.rating_sum = r.rating_sum, p.rating_count = r.rating_count, p.rating_histogram = r.rating_histogram`,
+		strings.Join(buckets, ", "))
+	if err := m.db.WithContext(ctx).Exec(sql).Error; err != nil {
+		return fmt.Errorf("failed to backfill rating aggregates: %w", err)
+	}
+	return nil
+}
+
+// backfillTagBatchSize 回填标签关联时每批处理的帖子数
+const backfillTagBatchSize = 500
+
+// BackfillPostTags 规范化帖子上的标签并重建标签关联，最后按已发布帖子重新统计标签的帖子数
+// 超过长度限制的历史标签直接丢弃，可重复执行
+func (m *DatabaseMigrator) BackfillPostTags(ctx context.Context) error {
+	lastID := ""
+	for {
+		var posts []*models.Post
+		if err := m.db.WithContext(ctx).Unscoped().Select("id", "tags").
+			Where("id > ?", lastID).Order("id ASC").Limit(backfillTagBatchSize).
+			Find(&posts).Error; err != nil {
+			return fmt.Errorf("failed to load posts: %w", err)
+		}
+		if len(posts) == 0 {
+			break
+		}
+		lastID = posts[len(posts)-1].ID
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, post := range posts {
+				tags := make(models.StringArray, 0, len(post.Tags))
+				seen := make(map[string]bool, len(post.Tags))
+				for _, tag := range post.Tags {
+					tag = search.NormalizeTag(tag)
+					if tag == "" || seen[tag] || utf8.RuneCountInString(tag) > models.TagMaxLength {
+						continue
+					}
+					seen[tag] = true
+					tags = append(tags, tag)
+				}
+				if err := tx.Unscoped().Model(&models.Post{}).Where("id = ?", post.ID).
+					UpdateColumn("tags", tags).Error; err != nil {
+					return err
+				}
+				if err := tx.Where("post_id = ?", post.ID).Delete(&models.PostTag{}).Error; err != nil {
+					return err
+				}
+				if err := syncPostTags(tx, post.ID, nil, tags, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill post tags: %w", err)
+		}
+	}
+
+	err := m.db.WithContext(ctx).Exec(`UPDATE tags t SET t.post_count = (
+			SELECT COUNT(*) FROM post_tags pt JOIN posts p ON p.id = pt.post_id
+			WHERE pt.tag_id = t.id AND p.deleted_at IS NULL AND p.status = ?
+		)`, models.PostStatusPublished).Error
+	if err != nil {
+		return fmt.Errorf("failed to recount tag posts: %w", err)
+	}
+	return nil
+}
+
+// defaultTopicCategories 默认话题分类，按顺序展示
+var defaultTopicCategories = []string{"篮球", "足球", "综合体育", "游戏电竞", "数码科技", "生活娱乐"}
+
+// SeedTopicCategories 初始化默认话题分类，已存在的分类不会重复创建
+func (m *DatabaseMigrator) SeedTopicCategories(ctx context.Context) error {
+	for i, name := range defaultTopicCategories {
+		var category models.TopicCategory
+		err := m.db.WithContext(ctx).
+			Where(models.TopicCategory{Name: name}).
+			Attrs(models.TopicCategory{ID: xid.New().String(), SortOrder: int32(i)}).
+			FirstOrCreate(&category).Error
+		if err != nil {
+			return fmt.Errorf("failed to seed topic category %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
 // RunAllMigrations 执行所有数据库优化
 func (m *DatabaseMigrator) RunAllMigrations(ctx context.Context) error {
 	steps := []struct {
@@ -135,9 +261,13 @@ func (m *DatabaseMigrator) RunAllMigrations(ctx context.Context) error {
 		fn   func(context.Context) error
 	}{
 		{"创建高级索引", m.CreateAdvancedIndexes},
+		{"重建全文索引", m.RebuildFulltextIndex},
 		{"创建部分索引", m.CreatePartialIndexes},
 		{"删除无用索引", m.DropUnusedIndexes},
 		{"优化现有索引", m.OptimizeExistingIndexes},
+		{"回填评分聚合", m.BackfillRatingAggregates},
+		{"初始化话题分类", m.SeedTopicCategories},
+		{"回填标签关联", m.BackfillPostTags},
 	}
 
 	for _, step := range steps {
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/models"
+)
+
+// createPoll 在发帖事务内创建投票和选项
+func createPoll(tx *gorm.DB, postID string, poll *models.Poll) error {
+	now := time.Now()
+	poll.ID = xid.New().String()
+	poll.PostID = postID
+	poll.CreatedAt = now
+	poll.UpdatedAt = now
+	if err := tx.Create(poll).Error; err != nil {
+		return err
+	}
+
+	for i, option := range poll.Options {
+		option.ID = xid.New().String()
+		option.PollID = poll.ID
+		option.Position = int32(i)
+	}
+	return tx.Create(&poll.Options).Error
+}
+
+// GetPollsByPostIDs 批量获取帖子的投票及选项，返回帖子ID到投票的映射
+func (r *PostRepository) GetPollsByPostIDs(ctx context.Context, postIDs []string) (map[string]*models.Poll, error) {
+	polls := make(map[string]*models.Poll)
+	if len(postIDs) == 0 {
+		return polls, nil
+	}
+
+	var pollList []*models.Poll
+	if err := r.db.WithContext(ctx).Where("post_id IN ?", postIDs).Find(&pollList).Error; err != nil {
+		return nil, err
+	}
+	if len(pollList) == 0 {
+		return polls, nil
+	}
+
+	byID := make(map[string]*models.Poll, len(pollList))
+	pollIDs := make([]string, 0, len(pollList))
+	for _, p := range pollList {
+		polls[p.PostID] = p
+		byID[p.ID] = p
+		pollIDs = append(pollIDs, p.ID)
+	}
+
+	var options []*models.PollOption
+	if err := r.db.WithContext(ctx).
+		Where("poll_id IN ?", pollIDs).
+		Order("poll_id ASC, position ASC").
+		Find(&options).Error; err != nil {
+		return nil, err
+	}
+	for _, o := range options {
+		byID[o.PollID].Options = append(byID[o.PollID].Options, o)
+	}
+	return polls, nil
+}
+
+// GetPollVotes 获取用户在各投票中选择的选项，返回投票ID到选项ID列表的映射
+func (r *PostRepository) GetPollVotes(ctx context.Context, userID string, pollIDs []string) (map[string][]string, error) {
+	votes := make(map[string][]string)
+	if userID == "" || len(pollIDs) == 0 {
+		return votes, nil
+	}
+
+	var records []*models.PollVote
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND poll_id IN ?", userID, pollIDs).
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+	for _, v := range records {
+		votes[v.PollID] = append(votes[v.PollID], v.OptionID)
+	}
+	return votes, nil
+}
+
+// VotePoll 投票或修改投票，返回更新后的投票
+// 锁定投票行后校验并更新选项票数，同一投票的并发投票串行执行，票数与投票记录始终一致
+// change 为 false 时用户必须尚未投票，为 true 时替换用户之前的全部选择
+func (r *PostRepository) VotePoll(ctx context.Context, postID, userID string, optionIDs []string, change bool) (*models.Poll, error) {
+	now := time.Now()
+	var pollID string
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var post models.Post
+		if err := tx.Scopes(models.Published).Select("id").Where("id = ?", postID).First(&post).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("post not found")
+			}
+			return err
+		}
+
+		var poll models.Poll
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("post_id = ?", postID).First(&poll).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("poll not found")
+			}
+			return err
+		}
+		pollID = poll.ID
+		if poll.Closed(now) {
+			return fmt.Errorf("poll closed")
+		}
+
+		// 校验选项属于该投票且不重复，单选只能选一个
+		if len(optionIDs) == 0 || (!poll.Multiple && len(optionIDs) > 1) {
+			return fmt.Errorf("invalid poll option")
+		}
+		var count int64
+		if err := tx.Model(&models.PollOption{}).Where("poll_id = ? AND id IN ?", poll.ID, optionIDs).Count(&count).Error; err != nil {
+			return err
+		}
+		if int(count) != len(optionIDs) {
+			return fmt.Errorf("invalid poll option")
+		}
+
+		var previous []string
+		if err := tx.Model(&models.PollVote{}).Where("poll_id = ? AND user_id = ?", poll.ID, userID).
+			Pluck("option_id", &previous).Error; err != nil {
+			return err
+		}
+		switch {
+		case !change && len(previous) > 0:
+			return fmt.Errorf("poll already voted")
+		case change && len(previous) == 0:
+			return fmt.Errorf("poll not voted")
+		}
+
+		if len(previous) > 0 {
+			if err := tx.Where("poll_id = ? AND user_id = ?", poll.ID, userID).Delete(&models.PollVote{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.PollOption{}).Where("id IN ? AND vote_count > 0", previous).
+				UpdateColumn("vote_count", gorm.Expr("vote_count - ?", 1)).Error; err != nil {
+				return err
+			}
+		}
+
+		votes := make([]*models.PollVote, 0, len(optionIDs))
+		for _, optionID := range optionIDs {
+			votes = append(votes, &models.PollVote{
+				ID:        xid.New().String(),
+				PollID:    poll.ID,
+				UserID:    userID,
+				OptionID:  optionID,
+				CreatedAt: now,
+			})
+		}
+		if err := tx.Create(&votes).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.PollOption{}).Where("id IN ?", optionIDs).
+			UpdateColumn("vote_count", gorm.Expr("vote_count + ?", 1)).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{"updated_at": now}
+		if len(previous) == 0 {
+			updates["voter_count"] = gorm.Expr("voter_count + ?", 1)
+		}
+		return tx.Model(&models.Poll{}).Where("id = ?", poll.ID).UpdateColumns(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	polls, err := r.GetPollsByPostIDs(ctx, []string{postID})
+	if err != nil {
+		return nil, err
+	}
+	poll, ok := polls[postID]
+	if !ok || poll.ID != pollID {
+		return nil, fmt.Errorf("poll not found")
+	}
+	return poll, nil
+}
@@ -3,23 +3,37 @@ package repository
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/rs/xid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"hupu/shared/cache"
+	"hupu/shared/constants"
+	"hupu/shared/log"
 	"hupu/shared/models"
+	"hupu/shared/ranking"
+	"hupu/shared/relation"
+	"hupu/shared/search"
 	"hupu/shared/utils"
 )
 
+// relatedCandidateLimit 相关帖子每类候选的最大数量
+const relatedCandidateLimit = 100
+
 type PostRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	cache *cache.PostCache
 }
 
 // NewPostRepository 创建新的帖子数据库仓库
 func NewPostRepository() *PostRepository {
 	return &PostRepository{
-		db: utils.GetDB(),
+		db:    utils.GetDB(),
+		cache: cache.NewPostCache(utils.GetRedisClient()),
 	}
 }
 
@@ -31,28 +45,34 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *models.Post) erro
 	post.UpdatedAt = time.Now()
 
 	// 使用事务确保数据一致性
-	tx := r.db.WithContext(ctx).Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createPost(tx, post)
+	})
+}
 
-	// 创建帖子
+// createPost 在事务内创建帖子及附带的投票和标签关联，并更新话题的帖子数量
+// 定时发布和审核中的帖子在实际发布时才计入话题和标签的帖子数
+func createPost(tx *gorm.DB, post *models.Post) error {
+	post.HasPoll = post.Poll != nil
 	if err := tx.Create(post).Error; err != nil {
-		tx.Rollback()
+		return err
+	}
+	if post.Poll != nil {
+		if err := createPoll(tx, post.ID, post.Poll); err != nil {
+			return err
+		}
+	}
+	if err := syncPostTags(tx, post.ID, nil, post.Tags, post.Status == models.PostStatusPublished); err != nil {
 		return err
 	}
 
 	// 如果有话题ID，更新话题的帖子数量
-	if post.TopicID != nil {
+	if post.TopicID != nil && post.Status == models.PostStatusPublished {
 		if err := tx.Model(&models.Topic{}).Where("id = ?", post.TopicID).Update("post_count", gorm.Expr("post_count + ?", 1)).Error; err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
-
-	return tx.Commit().Error
+	return nil
 }
 
 // GetPost 根据ID获取帖子
@@ -65,29 +85,498 @@ func (r *PostRepository) GetPost(ctx context.Context, id string) (*models.Post,
 	return &post, nil
 }
 
-// GetPostList 获取帖子列表
-func (r *PostRepository) GetPostList(ctx context.Context, page, pageSize int64) ([]*models.Post, error) {
+// GetPostsByIDs 根据ID列表批量获取已发布的帖子，按传入顺序返回，不存在、未发布或作者被排除的帖子被跳过
+func (r *PostRepository) GetPostsByIDs(ctx context.Context, ids []string, excludeUserIDs []string) ([]*models.Post, error) {
+	if len(ids) == 0 {
+		return []*models.Post{}, nil
+	}
+	var posts []*models.Post
+	if err := r.db.WithContext(ctx).
+		Scopes(models.Published, relation.ExcludeNamedUsers("user_id", excludeUserIDs)).
+		Where("id IN ?", ids).
+		Find(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	postMap := make(map[string]*models.Post, len(posts))
+	for _, p := range posts {
+		postMap[p.ID] = p
+	}
+	ordered := make([]*models.Post, 0, len(posts))
+	for _, id := range ids {
+		if p, ok := postMap[id]; ok {
+			ordered = append(ordered, p)
+		}
+	}
+	return ordered, nil
+}
+
+// ExistingPostIDs 返回 ids 中仍存在的帖子ID，包括回收站中和未发布的帖子
+func (r *PostRepository) ExistingPostIDs(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+	var existing []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Post{}).
+		Where("id IN ?", ids).
+		Pluck("id", &existing).Error
+	return existing, err
+}
+
+// GetPostList 获取帖子列表，excludeUserIDs 为查看者拉黑和屏蔽的用户
+func (r *PostRepository) GetPostList(ctx context.Context, page, pageSize int64, excludeUserIDs []string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
 	err := r.db.WithContext(ctx).
+		Scopes(models.Published, relation.ExcludeNamedUsers("user_id", excludeUserIDs)).
 		Offset(int(offset)).
-		Limit(int(pageSize)).
-		Order("created_at DESC").
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Order("created_at DESC, id DESC").
 		Find(&posts).Error
 
 	return posts, err
 }
 
-// UpdatePost 更新帖子
-func (r *PostRepository) UpdatePost(ctx context.Context, post *models.Post) error {
-	post.UpdatedAt = time.Now()
-	return r.db.WithContext(ctx).Save(post).Error
+// GetPostListByCursor 按 (created_at, id) 游标获取最新帖子列表，最多返回 limit 条
+func (r *PostRepository) GetPostListByCursor(ctx context.Context, conditions map[string]interface{}, cursor *utils.Cursor, limit int, excludeUserIDs []string) ([]*models.Post, error) {
+	var posts []*models.Post
+
+	err := applyPostConditions(r.db.WithContext(ctx), conditions).
+		Scopes(utils.TimeCursorScope("created_at", "id", cursor), relation.ExcludeNamedUsers("user_id", excludeUserIDs)).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&posts).Error
+
+	return posts, err
+}
+
+// UpdatePost 更新帖子，更新前将旧内容保存为历史版本，并同步话题帖子数
+// 内容与当前版本相同时不写入，返回当前帖子且 edited 为 false
+func (r *PostRepository) UpdatePost(ctx context.Context, post *models.Post, editorID string) (*models.Post, bool, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 锁定帖子行，避免并发编辑产生相同的版本号
+	var oldPost models.Post
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", post.ID).First(&oldPost).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, false, fmt.Errorf("post not found")
+		}
+		return nil, false, err
+	}
+
+	if !postChanged(&oldPost, post) {
+		tx.Rollback()
+		return &oldPost, false, nil
+	}
+
+	// 话题变更时校验新话题存在
+	oldTopicID, newTopicID := "", ""
+	if oldPost.TopicID != nil {
+		oldTopicID = *oldPost.TopicID
+	}
+	if post.TopicID != nil {
+		newTopicID = *post.TopicID
+	}
+	if newTopicID != "" && newTopicID != oldTopicID {
+		var count int64
+		if err := tx.Model(&models.Topic{}).Where("id = ?", newTopicID).Count(&count).Error; err != nil {
+			tx.Rollback()
+			return nil, false, err
+		}
+		if count == 0 {
+			tx.Rollback()
+			return nil, false, fmt.Errorf("topic not found")
+		}
+	}
+
+	var maxVersion int32
+	if err := tx.Model(&models.PostRevision{}).Where("post_id = ?", post.ID).
+		Select("COALESCE(MAX(version), 0)").Scan(&maxVersion).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	now := time.Now()
+	revision := &models.PostRevision{
+		ID:        xid.New().String(),
+		PostID:    oldPost.ID,
+		Version:   maxVersion + 1,
+		EditorID:  editorID,
+		TopicID:   oldPost.TopicID,
+		Title:     oldPost.Title,
+		Content:   oldPost.Content,
+		Images:    oldPost.Images,
+		Category:  oldPost.Category,
+		Location:  oldPost.Location,
+		Tags:      oldPost.Tags,
+		CreatedAt: now,
+	}
+	if err := tx.Create(revision).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	updates := map[string]interface{}{
+		"title":      post.Title,
+		"content":    post.Content,
+		"images":     post.Images,
+		"thumbnails": post.Thumbnails,
+		"topic_id":   post.TopicID,
+		"category":   post.Category,
+		"location":   post.Location,
+		"tags":       post.Tags,
+		"edited_at":  now,
+		"updated_at": now,
+	}
+	if err := tx.Model(&models.Post{}).Where("id = ?", post.ID).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+	if err := syncPostTags(tx, post.ID, oldPost.Tags, post.Tags, oldPost.Status == models.PostStatusPublished); err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	// 话题变更时迁移话题帖子数，未发布的帖子不计入话题帖子数
+	if oldTopicID != newTopicID && oldPost.Status == models.PostStatusPublished {
+		if oldTopicID != "" {
+			if err := tx.Model(&models.Topic{}).Where("id = ? AND post_count > 0", oldTopicID).
+				Update("post_count", gorm.Expr("post_count - ?", 1)).Error; err != nil {
+				tx.Rollback()
+				return nil, false, err
+			}
+		}
+		if newTopicID != "" {
+			if err := tx.Model(&models.Topic{}).Where("id = ?", newTopicID).
+				Update("post_count", gorm.Expr("post_count + ?", 1)).Error; err != nil {
+				tx.Rollback()
+				return nil, false, err
+			}
+		}
+	}
+
+	var updatedPost models.Post
+	if err := tx.Where("id = ?", post.ID).First(&updatedPost).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, false, err
+	}
+	return &updatedPost, true, nil
+}
+
+// postChanged 比较编辑前后的帖子内容，缩略图随图片变化，不单独比较
+func postChanged(oldPost, post *models.Post) bool {
+	return oldPost.Title != post.Title ||
+		oldPost.Content != post.Content ||
+		!slices.Equal(oldPost.Images, post.Images) ||
+		!slices.Equal(oldPost.Tags, post.Tags) ||
+		oldPost.Category != post.Category ||
+		stringValue(oldPost.TopicID) != stringValue(post.TopicID) ||
+		stringValue(oldPost.Location) != stringValue(post.Location)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// UpdatePostThumbnails 写入帖子的缩略图，只在 edited_at 未变时更新，期间被编辑过的帖子由编辑后的任务重新生成
+func (r *PostRepository) UpdatePostThumbnails(ctx context.Context, postID string, editedAt *time.Time, thumbnails models.StringArray) error {
+	query := r.db.WithContext(ctx).Model(&models.Post{}).Where("id = ?", postID)
+	if editedAt != nil {
+		query = query.Where("edited_at = ?", *editedAt)
+	} else {
+		query = query.Where("edited_at IS NULL")
+	}
+	return query.UpdateColumn("thumbnails", thumbnails).Error
 }
 
-// DeletePost 删除帖子
+// GetPostRevisions 获取帖子历史版本列表，按版本号倒序
+func (r *PostRepository) GetPostRevisions(ctx context.Context, postID string, page, pageSize int32) ([]*models.PostRevision, int64, error) {
+	var revisions []*models.PostRevision
+	var total int64
+	offset := (page - 1) * pageSize
+
+	query := r.db.WithContext(ctx).Model(&models.PostRevision{}).Where("post_id = ?", postID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("version DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Find(&revisions).Error
+
+	return revisions, total, err
+}
+
+// GetPostRevision 获取帖子指定版本
+func (r *PostRepository) GetPostRevision(ctx context.Context, postID string, version int32) (*models.PostRevision, error) {
+	var revision models.PostRevision
+	err := r.db.WithContext(ctx).Where("post_id = ? AND version = ?", postID, version).First(&revision).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("revision not found")
+		}
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// DeletePost 删除帖子，级联软删除评论、评分、收藏和点赞，并同步话题和标签的帖子数
+// 级联数据与帖子使用同一删除时间，恢复时据此区分被级联删除的数据
 func (r *PostRepository) DeletePost(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Post{}).Error
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var post models.Post
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&post).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("post not found")
+		}
+		return err
+	}
+
+	// MySQL datetime 精度有限，截断到秒保证恢复时可精确匹配
+	now := time.Now().Truncate(time.Second)
+
+	var commentIDs []string
+	if err := tx.Model(&models.Comment{}).Where("post_id = ?", id).Pluck("id", &commentIDs).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if len(commentIDs) > 0 {
+		if err := tx.Model(&models.Like{}).Where("target_type = ? AND target_id IN ?", constants.TargetTypeComment, commentIDs).
+			Update("deleted_at", now).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	cascades := []struct {
+		model interface{}
+		query string
+		args  []interface{}
+	}{
+		{&models.Comment{}, "post_id = ?", []interface{}{id}},
+		{&models.Like{}, "target_type = ? AND target_id = ?", []interface{}{constants.TargetTypePost, id}},
+		{&models.PostRating{}, "post_id = ?", []interface{}{id}},
+		{&models.PostFavorite{}, "post_id = ?", []interface{}{id}},
+		{&models.Post{}, "id = ?", []interface{}{id}},
+	}
+	for _, c := range cascades {
+		if err := tx.Model(c.model).Where(c.query, c.args...).Update("deleted_at", now).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if post.TopicID != nil && post.Status == models.PostStatusPublished {
+		if err := tx.Model(&models.Topic{}).Where("id = ? AND post_count > 0", *post.TopicID).
+			Update("post_count", gorm.Expr("post_count - ?", 1)).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if post.Status == models.PostStatusPublished {
+		if err := adjustTagCounts(tx, id, -1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// GetDeletedPosts 获取用户最近删除的帖子（回收站），仅返回删除时间晚于since的帖子
+func (r *PostRepository) GetDeletedPosts(ctx context.Context, userID string, since time.Time, page, pageSize int32) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var total int64
+	offset := (page - 1) * pageSize
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&models.Post{}).
+		Where("user_id = ? AND deleted_at IS NOT NULL AND deleted_at >= ?", userID, since)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("deleted_at DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// GetDeletedPost 获取回收站中的帖子
+func (r *PostRepository) GetDeletedPost(ctx context.Context, id string) (*models.Post, error) {
+	var post models.Post
+	err := r.db.WithContext(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, err
+	}
+	return &post, nil
+}
+
+// RestorePost 从回收站恢复帖子，连同删除时被级联删除的数据一并恢复
+func (r *PostRepository) RestorePost(ctx context.Context, id string, since time.Time) (*models.Post, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var post models.Post
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).First(&post).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, err
+	}
+	if post.DeletedAt.Time.Before(since) {
+		tx.Rollback()
+		return nil, fmt.Errorf("restore period expired")
+	}
+	deletedAt := post.DeletedAt.Time
+
+	var commentIDs []string
+	if err := tx.Unscoped().Model(&models.Comment{}).Where("post_id = ? AND deleted_at = ?", id, deletedAt).
+		Pluck("id", &commentIDs).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(commentIDs) > 0 {
+		if err := tx.Unscoped().Model(&models.Like{}).
+			Where("target_type = ? AND target_id IN ? AND deleted_at = ?", constants.TargetTypeComment, commentIDs, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	cascades := []struct {
+		model interface{}
+		query string
+		args  []interface{}
+	}{
+		{&models.Comment{}, "post_id = ? AND deleted_at = ?", []interface{}{id, deletedAt}},
+		{&models.Like{}, "target_type = ? AND target_id = ? AND deleted_at = ?", []interface{}{constants.TargetTypePost, id, deletedAt}},
+		{&models.PostRating{}, "post_id = ? AND deleted_at = ?", []interface{}{id, deletedAt}},
+		{&models.PostFavorite{}, "post_id = ? AND deleted_at = ?", []interface{}{id, deletedAt}},
+		{&models.Post{}, "id = ?", []interface{}{id}},
+	}
+	for _, c := range cascades {
+		if err := tx.Unscoped().Model(c.model).Where(c.query, c.args...).Update("deleted_at", nil).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if post.TopicID != nil && post.Status == models.PostStatusPublished {
+		if err := tx.Model(&models.Topic{}).Where("id = ?", *post.TopicID).
+			Update("post_count", gorm.Expr("post_count + ?", 1)).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if post.Status == models.PostStatusPublished {
+		if err := adjustTagCounts(tx, id, 1); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	post.DeletedAt = gorm.DeletedAt{}
+	return &post, nil
+}
+
+// PurgeDeletedPosts 彻底删除删除时间早于before的帖子及其关联数据，返回清理的帖子数
+func (r *PostRepository) PurgeDeletedPosts(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	var purged int64
+	for {
+		var postIDs []string
+		if err := r.db.WithContext(ctx).Unscoped().Model(&models.Post{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Limit(batchSize).Pluck("id", &postIDs).Error; err != nil {
+			return purged, err
+		}
+		if len(postIDs) == 0 {
+			return purged, nil
+		}
+
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			tx = tx.Unscoped()
+			var commentIDs []string
+			if err := tx.Model(&models.Comment{}).Where("post_id IN ?", postIDs).Pluck("id", &commentIDs).Error; err != nil {
+				return err
+			}
+			if len(commentIDs) > 0 {
+				if err := tx.Where("target_type = ? AND target_id IN ?", constants.TargetTypeComment, commentIDs).
+					Delete(&models.Like{}).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Where("target_type = ? AND target_id IN ?", constants.TargetTypePost, postIDs).Delete(&models.Like{}).Error; err != nil {
+				return err
+			}
+			for _, model := range []interface{}{&models.Comment{}, &models.PostRating{}, &models.PostFavorite{}, &models.PostRevision{}, &models.PostTag{}} {
+				if err := tx.Where("post_id IN ?", postIDs).Delete(model).Error; err != nil {
+					return err
+				}
+			}
+			var pollIDs []string
+			if err := tx.Model(&models.Poll{}).Where("post_id IN ?", postIDs).Pluck("id", &pollIDs).Error; err != nil {
+				return err
+			}
+			if len(pollIDs) > 0 {
+				for _, model := range []interface{}{&models.PollVote{}, &models.PollOption{}} {
+					if err := tx.Where("poll_id IN ?", pollIDs).Delete(model).Error; err != nil {
+						return err
+					}
+				}
+				if err := tx.Where("id IN ?", pollIDs).Delete(&models.Poll{}).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Where("id IN ?", postIDs).Delete(&models.Post{}).Error
+		})
+		if err != nil {
+			return purged, err
+		}
+		purged += int64(len(postIDs))
+
+		if len(postIDs) < batchSize {
+			return purged, nil
+		}
+	}
 }
 
 // GetPostsByUserID 根据用户ID获取帖子列表
@@ -96,6 +585,7 @@ func (r *PostRepository) GetPostsByUserID(ctx context.Context, userID string, pa
 	offset := (page - 1) * pageSize
 
 	err := r.db.WithContext(ctx).
+		Scopes(models.Published).
 		Where("user_id = ?", userID).
 		Offset(int(offset)).
 		Limit(int(pageSize)).
@@ -111,6 +601,7 @@ func (r *PostRepository) GetPostListByTopic(ctx context.Context, topicID string,
 	offset := (page - 1) * pageSize
 
 	err := r.db.WithContext(ctx).
+		Scopes(models.Published).
 		Where("topic_id = ?", topicID).
 		Offset(int(offset)).
 		Limit(int(pageSize)).
@@ -126,6 +617,7 @@ func (r *PostRepository) GetPostListByCategory(ctx context.Context, category str
 	offset := (page - 1) * pageSize
 
 	err := r.db.WithContext(ctx).
+		Scopes(models.Published).
 		Where("category = ?", category).
 		Offset(int(offset)).
 		Limit(int(pageSize)).
@@ -136,27 +628,12 @@ func (r *PostRepository) GetPostListByCategory(ctx context.Context, category str
 }
 
 // GetPostListWithConditions 根据多个条件获取帖子列表
-func (r *PostRepository) GetPostListWithConditions(ctx context.Context, conditions map[string]interface{}, page, pageSize int64, sortType string) ([]*models.Post, error) {
+func (r *PostRepository) GetPostListWithConditions(ctx context.Context, conditions map[string]interface{}, page, pageSize int64, sortType string, excludeUserIDs []string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
-	query := r.db.WithContext(ctx)
-
-	// 添加查询条件
-	for key, value := range conditions {
-		if value != nil && value != "" {
-			switch key {
-			case "topic_id":
-				query = query.Where("topic_id = ?", value)
-			case "category":
-				query = query.Where("category = ?", value)
-			case "user_id":
-				query = query.Where("user_id = ?", value)
-			case "is_anonymous":
-				query = query.Where("is_anonymous = ?", value)
-			}
-		}
-	}
+	query := applyPostConditions(r.db.WithContext(ctx), conditions).
+		Scopes(relation.ExcludeNamedUsers("user_id", excludeUserIDs))
 
 	// 设置排序
 	switch sortType {
@@ -167,27 +644,44 @@ func (r *PostRepository) GetPostListWithConditions(ctx context.Context, conditio
 	case "latest":
 		fallthrough
 	default:
-		query = query.Order("created_at DESC")
+		query = query.Order("created_at DESC, id DESC")
 	}
 
 	err := query.
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
 }
 
-// IncrementViewCount 增加浏览次数
-func (r *PostRepository) IncrementViewCount(ctx context.Context, postID string) error {
-	return r.db.WithContext(ctx).
-		Model(&models.Post{}).
-		Where("id = ?", postID).
-		Update("view_count", gorm.Expr("view_count + ?", 1)).Error
+// applyPostConditions 添加帖子列表的筛选条件，只返回已发布的帖子
+func applyPostConditions(query *gorm.DB, conditions map[string]interface{}) *gorm.DB {
+	query = query.Scopes(models.Published)
+	for key, value := range conditions {
+		if value != nil && value != "" {
+			switch key {
+			case "topic_id":
+				query = query.Where("topic_id = ?", value)
+			case "category":
+				query = query.Where("category = ?", value)
+			case "user_id":
+				query = query.Where("user_id = ?", value)
+			case "is_anonymous":
+				query = query.Where("is_anonymous = ?", value)
+			}
+		}
+	}
+	return query
 }
 
 // 话题管理相关方法
 func (r *PostRepository) CreateTopic(ctx context.Context, topic *models.Topic) error {
+	if topic.CategoryID != nil {
+		if _, err := r.GetTopicCategory(ctx, *topic.CategoryID); err != nil {
+			return err
+		}
+	}
 	topic.ID = xid.New().String()
 	topic.CreatedAt = time.Now()
 	topic.UpdatedAt = time.Now()
@@ -211,16 +705,117 @@ func (r *PostRepository) GetTopic(ctx context.Context, topicID string) (*models.
 	var topic models.Topic
 	err := r.db.WithContext(ctx).Where("id = ?", topicID).First(&topic).Error
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("topic not found")
+		}
 		return nil, err
 	}
 	return &topic, nil
 }
 
+// GetTopicsByIDs 按给定ID顺序批量获取话题
+func (r *PostRepository) GetTopicsByIDs(ctx context.Context, ids []string) ([]*models.Topic, error) {
+	if len(ids) == 0 {
+		return []*models.Topic{}, nil
+	}
+	var topics []*models.Topic
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&topics).Error; err != nil {
+		return nil, err
+	}
+
+	topicMap := make(map[string]*models.Topic, len(topics))
+	for _, t := range topics {
+		topicMap[t.ID] = t
+	}
+	ordered := make([]*models.Topic, 0, len(topics))
+	for _, id := range ids {
+		if t, ok := topicMap[id]; ok {
+			ordered = append(ordered, t)
+		}
+	}
+	return ordered, nil
+}
+
+// GetTopTopics 按累计参与人数获取话题，今日暂无参与数据时作为热门话题
+func (r *PostRepository) GetTopTopics(ctx context.Context, limit int) ([]*models.Topic, error) {
+	var topics []*models.Topic
+	err := r.db.WithContext(ctx).
+		Order("participant_count DESC, post_count DESC, id DESC").
+		Limit(limit).
+		Find(&topics).Error
+	return topics, err
+}
+
+// GetTopicCategories 获取全部话题分类
+func (r *PostRepository) GetTopicCategories(ctx context.Context) ([]*models.TopicCategory, error) {
+	var categories []*models.TopicCategory
+	err := r.db.WithContext(ctx).Order("sort_order ASC, id ASC").Find(&categories).Error
+	return categories, err
+}
+
+// GetTopicCategory 获取话题分类
+func (r *PostRepository) GetTopicCategory(ctx context.Context, categoryID string) (*models.TopicCategory, error) {
+	var category models.TopicCategory
+	err := r.db.WithContext(ctx).Where("id = ?", categoryID).First(&category).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("topic category not found")
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// GetTopicsByCategory 获取分类下参与人数最多的话题
+func (r *PostRepository) GetTopicsByCategory(ctx context.Context, categoryID string, limit int) ([]*models.Topic, error) {
+	var topics []*models.Topic
+	err := r.db.WithContext(ctx).
+		Where("category_id = ?", categoryID).
+		Order("participant_count DESC, post_count DESC, id DESC").
+		Limit(limit + 1). // 多取一条用于判断 has_more
+		Find(&topics).Error
+	return topics, err
+}
+
+// SearchTopics 按名称和描述搜索话题，名称前缀匹配的排在前面
+func (r *PostRepository) SearchTopics(ctx context.Context, keyword string, page, pageSize int32) ([]*models.Topic, error) {
+	var topics []*models.Topic
+	offset := (page - 1) * pageSize
+	pattern := "%" + escapeLike(keyword) + "%"
+
+	err := r.db.WithContext(ctx).
+		Where("name LIKE ? OR description LIKE ?", pattern, pattern).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "name LIKE ? DESC, participant_count DESC, id DESC",
+			Vars: []interface{}{escapeLike(keyword) + "%"},
+		}}).
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&topics).Error
+	return topics, err
+}
+
+// IncrementTopicShareCount 话题分享数加一并返回最新的话题
+func (r *PostRepository) IncrementTopicShareCount(ctx context.Context, topicID string) (*models.Topic, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Topic{}).
+		Where("id = ?", topicID).
+		UpdateColumn("share_count", gorm.Expr("share_count + ?", 1))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("topic not found")
+	}
+	return r.GetTopic(ctx, topicID)
+}
+
 // 收藏功能相关方法
-func (r *PostRepository) FavoritePost(ctx context.Context, userID string, postID string) error {
+// folderID 为空时收藏到默认收藏夹
+func (r *PostRepository) FavoritePost(ctx context.Context, userID string, postID string, folderID string) error {
 	// 检查帖子是否存在
 	var post models.Post
-	err := r.db.WithContext(ctx).Where("id = ?", postID).First(&post).Error
+	err := r.db.WithContext(ctx).Scopes(models.Published).Where("id = ?", postID).First(&post).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return fmt.Errorf("post not found")
@@ -249,11 +844,23 @@ func (r *PostRepository) FavoritePost(ctx context.Context, userID string, postID
 		}
 	}()
 
+	var folder *models.FavoriteFolder
+	if folderID != "" {
+		folder, err = getOwnedFolder(tx, folderID, userID)
+	} else {
+		folder, err = ensureDefaultFolder(tx, userID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	// 创建收藏记录
 	favorite := &models.PostFavorite{
 		ID:        xid.New().String(),
 		UserID:    userID,
 		PostID:    postID,
+		FolderID:  folder.ID,
 		CreatedAt: time.Now(),
 	}
 
@@ -262,13 +869,29 @@ func (r *PostRepository) FavoritePost(ctx context.Context, userID string, postID
 		return fmt.Errorf("failed to create favorite: %w", err)
 	}
 
+	// 更新帖子收藏数
+	if err := tx.Model(&models.Post{}).Where("id = ?", postID).
+		UpdateColumn("favorite_count", gorm.Expr("favorite_count + ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update favorite count: %w", err)
+	}
+
 	return tx.Commit().Error
 }
 
+// UnfavoritePost 取消收藏并减少帖子收藏数
+// 收藏记录直接删除，否则软删除的记录会与再次收藏冲突；随帖子进入回收站的收藏不受影响
 func (r *PostRepository) UnfavoritePost(ctx context.Context, userID string, postID string) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ? AND post_id = ?", userID, postID).
-		Delete(&models.PostFavorite{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("user_id = ? AND post_id = ? AND deleted_at IS NULL", userID, postID).
+			Delete(&models.PostFavorite{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return tx.Model(&models.Post{}).Where("id = ? AND favorite_count > 0", postID).
+			UpdateColumn("favorite_count", gorm.Expr("favorite_count - ?", 1)).Error
+	})
 }
 
 func (r *PostRepository) GetFavoriteList(ctx context.Context, userID string, page, pageSize int32) ([]*models.Post, error) {
@@ -277,51 +900,133 @@ func (r *PostRepository) GetFavoriteList(ctx context.Context, userID string, pag
 
 	err := r.db.WithContext(ctx).
 		Table("posts").
+		Scopes(models.Published).
 		Joins("JOIN post_favorites ON posts.id = post_favorites.post_id").
-		Where("post_favorites.user_id = ?", userID).
+		Where("post_favorites.user_id = ? AND post_favorites.deleted_at IS NULL", userID).
 		Offset(int(offset)).
-		Limit(int(pageSize)).
-		Order("post_favorites.created_at DESC").
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Order("post_favorites.created_at DESC, post_favorites.id DESC").
+		Find(&posts).Error
+
+	return posts, err
+}
+
+// GetFavoriteListByCursor 按收藏时间游标获取收藏列表，最多返回 limit 条
+func (r *PostRepository) GetFavoriteListByCursor(ctx context.Context, userID string, cursor *utils.Cursor, limit int) ([]*models.Post, error) {
+	var posts []*models.Post
+
+	err := r.db.WithContext(ctx).
+		Table("posts").
+		Scopes(models.Published).
+		Joins("JOIN post_favorites ON posts.id = post_favorites.post_id").
+		Where("post_favorites.user_id = ? AND post_favorites.deleted_at IS NULL", userID).
+		Scopes(utils.TimeCursorScope("post_favorites.created_at", "post_favorites.id", cursor)).
+		Order("post_favorites.created_at DESC, post_favorites.id DESC").
+		Limit(limit).
 		Find(&posts).Error
 
 	return posts, err
 }
 
-// 评分功能相关方法
-func (r *PostRepository) RatePost(ctx context.Context, rating *models.PostRating) error {
-	// 检查是否已经评分过
-	var existingRating models.PostRating
-	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND post_id = ?", rating.UserID, rating.PostID).
-		First(&existingRating).Error
-
-	if err == gorm.ErrRecordNotFound {
-		// 创建新评分
-		rating.ID = xid.New().String()
-		rating.CreatedAt = time.Now()
-		return r.db.WithContext(ctx).Create(rating).Error
-	} else if err != nil {
-		return err
-	} else {
-		// 更新现有评分
-		existingRating.Score = rating.Score
-		existingRating.UpdatedAt = time.Now()
-		return r.db.WithContext(ctx).Save(&existingRating).Error
-	}
+// GetFavorite 获取用户对帖子的收藏记录
+func (r *PostRepository) GetFavorite(ctx context.Context, userID, postID string) (*models.PostFavorite, error) {
+	var favorite models.PostFavorite
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND post_id = ?", userID, postID).
+		First(&favorite).Error
+	if err != nil {
+		return nil, err
+	}
+	return &favorite, nil
+}
+
+// 评分功能相关方法
+
+// RatePost 评分帖子，已评分时覆盖原评分，返回更新聚合后的帖子
+func (r *PostRepository) RatePost(ctx context.Context, rating *models.PostRating) (*models.Post, error) {
+	if rating.Score < models.RatingScoreMin || rating.Score > models.RatingScoreMax {
+		return nil, fmt.Errorf("invalid score: %d", rating.Score)
+	}
+
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	post, err := lockRatingStats(tx, rating.PostID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 检查是否已经评分过
+	var oldScore int32
+	var existingRating models.PostRating
+	err = tx.Where("user_id = ? AND post_id = ?", rating.UserID, rating.PostID).First(&existingRating).Error
+	if err == gorm.ErrRecordNotFound {
+		// 创建新评分
+		rating.ID = xid.New().String()
+		rating.CreatedAt = time.Now()
+		err = tx.Create(rating).Error
+	} else if err == nil {
+		// 更新现有评分
+		oldScore = existingRating.Score
+		existingRating.Score = rating.Score
+		existingRating.UpdatedAt = time.Now()
+		err = tx.Save(&existingRating).Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := saveRatingStats(tx, post, oldScore, rating.Score); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return post, tx.Commit().Error
+}
+
+// lockRatingStats 锁定帖子行并读取评分聚合，保证并发评分时聚合不丢失更新
+func lockRatingStats(tx *gorm.DB, postID string) (*models.Post, error) {
+	var post models.Post
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Scopes(models.Published).
+		Select("id", "rating_sum", "rating_count", "rating_histogram").
+		Where("id = ?", postID).
+		First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, err
+	}
+	return &post, nil
+}
+
+// saveRatingStats 按评分变化更新帖子的评分聚合，不修改 updated_at
+func saveRatingStats(tx *gorm.DB, post *models.Post, oldScore, newScore int32) error {
+	post.ApplyRating(oldScore, newScore)
+	return tx.Model(&models.Post{}).
+		Where("id = ?", post.ID).
+		UpdateColumns(map[string]interface{}{
+			"rating_sum":       post.RatingSum,
+			"rating_count":     post.RatingCount,
+			"rating_histogram": post.RatingHistogram,
+		}).Error
 }
 
-func (r *PostRepository) GetScoreRanking(ctx context.Context, page, pageSize int32) ([]*models.Post, error) {
+// GetScoreRanking 按贝叶斯平均分获取评分排行榜
+func (r *PostRepository) GetScoreRanking(ctx context.Context, prior *ranking.RatingPrior, page, pageSize int32) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
-	err := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-		Group("posts.id").
-		Order("avg_score DESC").
+	err := r.ratedPostsQuery(ctx, prior.MinVotes, "", "").
+		Order(prior.ScoreExpr("posts") + " DESC, posts.rating_count DESC, posts.id DESC").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
@@ -349,8 +1054,35 @@ func (r *PostRepository) GetUserRating(ctx context.Context, userID, postID strin
 	return &rating, nil
 }
 
-// UpdateRating 更新评分
-func (r *PostRepository) UpdateRating(ctx context.Context, userID, postID string, score int32, comment *string) error {
+// UpdateRating 更新评分，返回更新聚合后的帖子
+func (r *PostRepository) UpdateRating(ctx context.Context, userID, postID string, score int32, comment *string) (*models.Post, error) {
+	if score < models.RatingScoreMin || score > models.RatingScoreMax {
+		return nil, fmt.Errorf("invalid score: %d", score)
+	}
+
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	post, err := lockRatingStats(tx, postID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var existingRating models.PostRating
+	if err := tx.Where("user_id = ? AND post_id = ?", userID, postID).First(&existingRating).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("rating not found")
+		}
+		return nil, err
+	}
+
+	oldScore := existingRating.Score
 	updateData := map[string]interface{}{
 		"score":      score,
 		"updated_at": time.Now(),
@@ -358,99 +1090,106 @@ func (r *PostRepository) UpdateRating(ctx context.Context, userID, postID string
 	if comment != nil {
 		updateData["comment"] = *comment
 	}
+	if err := tx.Model(&existingRating).Updates(updateData).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
-	return r.db.WithContext(ctx).
-		Model(&models.PostRating{}).
-		Where("user_id = ? AND post_id = ?", userID, postID).
-		Updates(updateData).Error
+	if err := saveRatingStats(tx, post, oldScore, score); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return post, tx.Commit().Error
 }
 
-// DeleteRating 删除评分
-func (r *PostRepository) DeleteRating(ctx context.Context, userID, postID string) error {
-	return r.db.WithContext(ctx).
-		Where("user_id = ? AND post_id = ?", userID, postID).
-		Delete(&models.PostRating{}).Error
-}
+// DeleteRating 删除评分，返回更新聚合后的帖子
+func (r *PostRepository) DeleteRating(ctx context.Context, userID, postID string) (*models.Post, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
 
-// GetPostRatingStats 获取帖子评分统计信息
-func (r *PostRepository) GetPostRatingStats(ctx context.Context, postID string) (float64, int32, error) {
-	var result struct {
-		AverageScore float64
-		TotalRatings int32
+	post, err := lockRatingStats(tx, postID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
-	err := r.db.WithContext(ctx).
-		Model(&models.PostRating{}).
-		Select("AVG(score) as average_score, COUNT(*) as total_ratings").
-		Where("post_id = ?", postID).
-		Scan(&result).Error
+	var existingRating models.PostRating
+	if err := tx.Where("user_id = ? AND post_id = ?", userID, postID).First(&existingRating).Error; err != nil {
+		tx.Rollback()
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("rating not found")
+		}
+		return nil, err
+	}
+	if err := tx.Delete(&existingRating).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := saveRatingStats(tx, post, existingRating.Score, 0); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return post, tx.Commit().Error
+}
 
+// GetPostRatingStats 获取帖子评分统计信息，读取帖子上的评分聚合
+func (r *PostRepository) GetPostRatingStats(ctx context.Context, postID string) (float64, int32, error) {
+	post, err := r.GetPostRatingDistribution(ctx, postID)
 	if err != nil {
 		return 0, 0, err
 	}
+	return post.AverageScore(), post.RatingCount, nil
+}
 
-	return result.AverageScore, result.TotalRatings, nil
+// GetPostRatingDistribution 获取帖子的评分聚合和评分分布
+func (r *PostRepository) GetPostRatingDistribution(ctx context.Context, postID string) (*models.Post, error) {
+	var post models.Post
+	err := r.db.WithContext(ctx).
+		Select("id", "rating_sum", "rating_count", "rating_histogram").
+		Where("id = ?", postID).
+		First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, err
+	}
+	return &post, nil
 }
 
-// GetHighScorePosts 获取高分帖子
-func (r *PostRepository) GetHighScorePosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
+// GetHighScorePosts 获取高分帖子，按贝叶斯平均分排序
+func (r *PostRepository) GetHighScorePosts(ctx context.Context, prior *ranking.RatingPrior, page, pageSize int32, category, tag string, excludeUserIDs []string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-		Group("posts.id").
-		Having("rating_count >= ? AND avg_score >= ?", 3, 3.5) // 至少3个评分且平均分>=3.5
-
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
-	}
-
-	// 添加标签筛选
-	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-	}
-
-	err := query.
-		Order("avg_score DESC, rating_count DESC, posts.created_at DESC").
+	err := r.ratedPostsQuery(ctx, prior.MinVotes, category, tag).
+		Scopes(relation.ExcludeNamedUsers("posts.user_id", excludeUserIDs)).
+		Where("posts.rating_sum >= ? * posts.rating_count", 3.5). // 平均分>=3.5
+		Order(prior.ScoreExpr("posts") + " DESC, posts.rating_count DESC, posts.created_at DESC").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
 }
 
 // GetTodayHighScorePosts 获取今日高分帖子
-func (r *PostRepository) GetTodayHighScorePosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
+func (r *PostRepository) GetTodayHighScorePosts(ctx context.Context, prior *ranking.RatingPrior, page, pageSize int32, category, tag string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
 	// 今日零点时间
 	today := time.Now().Truncate(24 * time.Hour)
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
+	err := r.ratedPostsQuery(ctx, todayMinVotes(prior), category, tag).
 		Where("posts.created_at >= ?", today).
-		Group("posts.id").
-		Having("rating_count >= ? AND avg_score >= ?", 2, 3.5) // 今日帖子要求稍微降低
-
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
-	}
-
-	// 添加标签筛选
-	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-	}
-
-	err := query.
-		Order("avg_score DESC, rating_count DESC, posts.created_at DESC").
+		Where("posts.rating_sum >= ? * posts.rating_count", 3.5).
+		Order(prior.ScoreExpr("posts") + " DESC, posts.rating_count DESC, posts.created_at DESC").
 		Offset(int(offset)).
 		Limit(int(pageSize)).
 		Find(&posts).Error
@@ -458,65 +1197,34 @@ func (r *PostRepository) GetTodayHighScorePosts(ctx context.Context, page, pageS
 	return posts, err
 }
 
-// GetLowScorePosts 获取低分帖子
-func (r *PostRepository) GetLowScorePosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
+// GetLowScorePosts 获取低分帖子，按贝叶斯平均分排序
+func (r *PostRepository) GetLowScorePosts(ctx context.Context, prior *ranking.RatingPrior, page, pageSize int32, category, tag string, excludeUserIDs []string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-		Group("posts.id").
-		Having("rating_count >= ? AND avg_score <= ?", 3, 2.5) // 至少3个评分且平均分<=2.5
-
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
-	}
-
-	// 添加标签筛选
-	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-	}
-
-	err := query.
-		Order("avg_score ASC, rating_count DESC, posts.created_at DESC").
+	err := r.ratedPostsQuery(ctx, prior.MinVotes, category, tag).
+		Scopes(relation.ExcludeNamedUsers("posts.user_id", excludeUserIDs)).
+		Where("posts.rating_sum <= ? * posts.rating_count", 2.5). // 平均分<=2.5
+		Order(prior.ScoreExpr("posts") + " ASC, posts.rating_count DESC, posts.created_at DESC").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
 }
 
 // GetTodayLowScorePosts 获取今日低分帖子
-func (r *PostRepository) GetTodayLowScorePosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
+func (r *PostRepository) GetTodayLowScorePosts(ctx context.Context, prior *ranking.RatingPrior, page, pageSize int32, category, tag string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
 	// 今日零点时间
 	today := time.Now().Truncate(24 * time.Hour)
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
+	err := r.ratedPostsQuery(ctx, todayMinVotes(prior), category, tag).
 		Where("posts.created_at >= ?", today).
-		Group("posts.id").
-		Having("rating_count >= ? AND avg_score <= ?", 2, 2.5) // 今日帖子要求稍微降低
-
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
-	}
-
-	// 添加标签筛选
-	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-	}
-
-	err := query.
-		Order("avg_score ASC, rating_count DESC, posts.created_at DESC").
+		Where("posts.rating_sum <= ? * posts.rating_count", 2.5).
+		Order(prior.ScoreExpr("posts") + " ASC, posts.rating_count DESC, posts.created_at DESC").
 		Offset(int(offset)).
 		Limit(int(pageSize)).
 		Find(&posts).Error
@@ -524,23 +1232,12 @@ func (r *PostRepository) GetTodayLowScorePosts(ctx context.Context, page, pageSi
 	return posts, err
 }
 
-// GetControversialPosts 获取争议帖子 (评分差异大)
-func (r *PostRepository) GetControversialPosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
-	var posts []*models.Post
-	offset := (page - 1) * pageSize
-
-	// 争议帖子的定义：评分数量多且评分分布分散（标准差大）
+// ratedPostsQuery 评分榜单公共查询：评分人数达到门槛，可按分类和标签筛选
+func (r *PostRepository) ratedPostsQuery(ctx context.Context, minVotes int32, category, tag string) *gorm.DB {
 	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select(`posts.*, 
-			AVG(post_ratings.score) as avg_score, 
-			COUNT(post_ratings.id) as rating_count,
-			STDDEV_POP(post_ratings.score) as score_stddev,
-			SUM(CASE WHEN post_ratings.score <= 2 THEN 1 ELSE 0 END) as low_ratings,
-			SUM(CASE WHEN post_ratings.score >= 4 THEN 1 ELSE 0 END) as high_ratings`).
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-		Group("posts.id").
-		Having("rating_count >= ? AND score_stddev >= ? AND low_ratings > 0 AND high_ratings > 0", 5, 1.2) // 至少5个评分，标准差>=1.2，且有高分和低分
+		Model(&models.Post{}).
+		Scopes(models.Published).
+		Where("posts.rating_count >= ?", minVotes)
 
 	// 添加分类筛选
 	if category != "" {
@@ -549,101 +1246,70 @@ func (r *PostRepository) GetControversialPosts(ctx context.Context, page, pageSi
 
 	// 添加标签筛选
 	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
+		query = query.Scopes(models.TaggedWith(tag))
 	}
-
-	err := query.
-		Order("score_stddev DESC, rating_count DESC, posts.created_at DESC").
-		Offset(int(offset)).
-		Limit(int(pageSize)).
-		Find(&posts).Error
-
-	return posts, err
+	return query
 }
 
-// GetTodayControversialPosts 获取今日争议帖子
-func (r *PostRepository) GetTodayControversialPosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
-	var posts []*models.Post
-	offset := (page - 1) * pageSize
-
-	// 今日零点时间
-	today := time.Now().Truncate(24 * time.Hour)
+// todayMinVotes 今日榜单的评分人数门槛比总榜低一档
+func todayMinVotes(prior *ranking.RatingPrior) int32 {
+	if prior.MinVotes > 1 {
+		return prior.MinVotes - 1
+	}
+	return 1
+}
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select(`posts.*, 
-			AVG(post_ratings.score) as avg_score, 
-			COUNT(post_ratings.id) as rating_count,
-			STDDEV_POP(post_ratings.score) as score_stddev,
-			SUM(CASE WHEN post_ratings.score <= 2 THEN 1 ELSE 0 END) as low_ratings,
-			SUM(CASE WHEN post_ratings.score >= 4 THEN 1 ELSE 0 END) as high_ratings`).
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-		Where("posts.created_at >= ?", today).
-		Group("posts.id").
-		Having("rating_count >= ? AND score_stddev >= ? AND low_ratings > 0 AND high_ratings > 0", 3, 1.0) // 今日帖子要求稍微降低
+// ratingCountExpr 评分直方图中给出 score 分的人数
+func ratingCountExpr(score int) string {
+	return fmt.Sprintf("COALESCE(CAST(JSON_EXTRACT(posts.rating_histogram, '$[%d]') AS SIGNED), 0)", score-1)
+}
 
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
+// ratingVarianceExpr 由评分直方图和评分总和计算评分方差：Σ(分数²×人数)/评分人数 - 平均分²
+func ratingVarianceExpr() string {
+	terms := make([]string, 0, models.RatingScoreMax)
+	for score := 1; score <= int(models.RatingScoreMax); score++ {
+		terms = append(terms, fmt.Sprintf("%d * %s", score*score, ratingCountExpr(score)))
 	}
+	return fmt.Sprintf("((%s) / posts.rating_count - POW(posts.rating_sum / posts.rating_count, 2))", strings.Join(terms, " + "))
+}
 
-	// 添加标签筛选
-	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-	}
+// controversialPostsQuery 争议帖子的定义：评分数量多且评分分布分散（标准差大），且有高分和低分
+// 使用帖子上的评分聚合，不再联表统计评分
+func (r *PostRepository) controversialPostsQuery(ctx context.Context, minVotes int32, minStddev float64, category, tag string) *gorm.DB {
+	variance := ratingVarianceExpr()
+	return r.ratedPostsQuery(ctx, minVotes, category, tag).
+		Where(variance+" >= ?", minStddev*minStddev).
+		Where(ratingCountExpr(1) + " + " + ratingCountExpr(2) + " > 0").
+		Where(ratingCountExpr(4) + " + " + ratingCountExpr(5) + " > 0").
+		Order(variance + " DESC, posts.rating_count DESC, posts.created_at DESC")
+}
 
-	err := query.
-		Order("score_stddev DESC, rating_count DESC, posts.created_at DESC").
+// GetControversialPosts 获取争议帖子 (评分差异大)
+func (r *PostRepository) GetControversialPosts(ctx context.Context, page, pageSize int32, category, tag string, excludeUserIDs []string) ([]*models.Post, error) {
+	var posts []*models.Post
+	offset := (page - 1) * pageSize
+
+	// 至少5个评分，标准差>=1.2
+	err := r.controversialPostsQuery(ctx, 5, 1.2, category, tag).
+		Scopes(relation.ExcludeNamedUsers("posts.user_id", excludeUserIDs)).
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
 }
 
-// SearchPosts 搜索帖子
-func (r *PostRepository) SearchPosts(ctx context.Context, keyword string, page, pageSize int32, category, sortType string) ([]*models.Post, error) {
+// GetTodayControversialPosts 获取今日争议帖子
+func (r *PostRepository) GetTodayControversialPosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
-	query := r.db.WithContext(ctx).
-		Table("posts").
-		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count")
-
-	// 构建搜索条件
-	searchCondition := "posts.title LIKE ? OR posts.content LIKE ?"
-	searchKeyword := "%" + keyword + "%"
-
-	// 支持标签搜索
-	if len(keyword) > 0 {
-		searchCondition += " OR JSON_CONTAINS(posts.tags, ?)"
-		query = query.Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-			Where(searchCondition, searchKeyword, searchKeyword, fmt.Sprintf(`"%s"`, keyword))
-	} else {
-		query = query.Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
-			Where(searchCondition, searchKeyword, searchKeyword)
-	}
-
-	// 添加分类筛选
-	if category != "" {
-		query = query.Where("posts.category = ?", category)
-	}
-
-	query = query.Group("posts.id")
-
-	// 设置排序
-	switch sortType {
-	case "hot":
-		query = query.Order("posts.like_count DESC, posts.comment_count DESC, posts.created_at DESC")
-	case "score":
-		query = query.Order("avg_score DESC, rating_count DESC, posts.created_at DESC")
-	case "latest":
-		fallthrough
-	default:
-		query = query.Order("posts.created_at DESC")
-	}
+	// 今日零点时间
+	today := time.Now().Truncate(24 * time.Hour)
 
-	err := query.
+	// 今日帖子要求稍微降低
+	err := r.controversialPostsQuery(ctx, 3, 1.0, category, tag).
+		Where("posts.created_at >= ?", today).
 		Offset(int(offset)).
 		Limit(int(pageSize)).
 		Find(&posts).Error
@@ -659,7 +1325,8 @@ func (r *PostRepository) AdvancedSearchPosts(ctx context.Context, conditions map
 	query := r.db.WithContext(ctx).
 		Table("posts").
 		Select("posts.*, AVG(post_ratings.score) as avg_score, COUNT(post_ratings.id) as rating_count").
-		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id")
+		Joins("LEFT JOIN post_ratings ON posts.id = post_ratings.post_id").
+		Scopes(models.Published)
 
 	// 动态构建查询条件
 	for key, value := range conditions {
@@ -689,9 +1356,7 @@ func (r *PostRepository) AdvancedSearchPosts(ctx context.Context, conditions map
 			query = query.Where("posts.created_at <= ?", value)
 		case "tags":
 			if tags, ok := value.([]string); ok {
-				for _, tag := range tags {
-					query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
-				}
+				query = query.Scopes(models.TaggedWith(tags...))
 			}
 		}
 	}
@@ -723,8 +1388,8 @@ func (r *PostRepository) AdvancedSearchPosts(ctx context.Context, conditions map
 	return posts, err
 }
 
-// GetHotPosts 获取热门帖子
-func (r *PostRepository) GetHotPosts(ctx context.Context, page, pageSize int32, category, tag string) ([]*models.Post, error) {
+// GetHotPosts 获取热门帖子，热榜不可用时使用
+func (r *PostRepository) GetHotPosts(ctx context.Context, page, pageSize int32, category, tag string, excludeUserIDs []string) ([]*models.Post, error) {
 	var posts []*models.Post
 	offset := (page - 1) * pageSize
 
@@ -732,6 +1397,7 @@ func (r *PostRepository) GetHotPosts(ctx context.Context, page, pageSize int32,
 	query := r.db.WithContext(ctx).
 		Table("posts").
 		Select("posts.*, (like_count * 0.5 + comment_count * 0.3 + view_count * 0.1 + share_count * 0.1) as hot_score").
+		Scopes(models.Published, relation.ExcludeNamedUsers("posts.user_id", excludeUserIDs)).
 		Where("posts.created_at > ?", time.Now().AddDate(0, 0, -30)) // 只考虑30天内的帖子
 
 	// 添加分类筛选
@@ -741,13 +1407,13 @@ func (r *PostRepository) GetHotPosts(ctx context.Context, page, pageSize int32,
 
 	// 添加标签筛选
 	if tag != "" {
-		query = query.Where("JSON_CONTAINS(posts.tags, ?)", fmt.Sprintf(`"%s"`, tag))
+		query = query.Scopes(models.TaggedWith(tag))
 	}
 
 	err := query.
 		Order("hot_score DESC, posts.created_at DESC").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
@@ -763,6 +1429,7 @@ func (r *PostRepository) GetRecommendPosts(ctx context.Context, userID string, p
 		Table("posts").
 		Select(`posts.*, 
 			(posts.like_count * 0.4 + posts.comment_count * 0.3 + posts.view_count * 0.2 + posts.share_count * 0.1) as recommend_score`).
+		Scopes(models.Published).
 		Where("posts.created_at > ?", time.Now().AddDate(0, 0, -7)) // 只推荐7天内的帖子
 
 	// 排除用户自己的帖子
@@ -773,43 +1440,123 @@ func (r *PostRepository) GetRecommendPosts(ctx context.Context, userID string, p
 	err := query.
 		Order("recommend_score DESC, posts.created_at DESC").
 		Offset(int(offset)).
-		Limit(int(pageSize)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
 		Find(&posts).Error
 
 	return posts, err
 }
 
-// GetPostsWithCache 带缓存的帖子获取（预留接口）
-// 后续可以集成Redis或其他缓存系统
-func (r *PostRepository) GetPostsWithCache(ctx context.Context, cacheKey string, queryFunc func() ([]*models.Post, error)) ([]*models.Post, error) {
-	// TODO: 集成缓存逻辑
-	// 1. 先从缓存获取
-	// 2. 缓存未命中时从数据库获取
-	// 3. 将结果写入缓存
-
-	// 目前直接执行数据库查询
-	return queryFunc()
+// GetPostsWithCache 带缓存的帖子列表获取，缓存未命中时执行queryFunc并回填
+func (r *PostRepository) GetPostsWithCache(ctx context.Context, cacheKey string, queryFunc func() ([]*models.Post, error), tags ...string) ([]*models.Post, error) {
+	return r.cache.GetPosts(ctx, cacheKey, queryFunc, tags...)
 }
 
-// InvalidatePostCache 失效帖子相关缓存（预留接口）
+// InvalidatePostCache 失效包含该帖子的列表缓存，失败只记录日志
+// 当帖子被更新、删除、评分或点赞时调用
 func (r *PostRepository) InvalidatePostCache(ctx context.Context, postID string) error {
-	// TODO: 实现缓存失效逻辑
-	// 当帖子被更新、删除或评分时调用
+	if err := r.cache.InvalidatePost(ctx, postID); err != nil {
+		log.GetLogger().Warnf("InvalidatePostCache %s failed: %v", postID, err)
+		return err
+	}
+	return nil
+}
+
+// InvalidateListCache 按标签失效列表缓存，失败只记录日志
+func (r *PostRepository) InvalidateListCache(ctx context.Context, tag string) error {
+	if err := r.cache.InvalidateTag(ctx, tag); err != nil {
+		log.GetLogger().Warnf("InvalidateListCache %s failed: %v", tag, err)
+		return err
+	}
 	return nil
 }
 
-// GetSearchSuggestions 获取搜索建议（预留接口，为ElasticSearch准备）
-func (r *PostRepository) GetSearchSuggestions(ctx context.Context, keyword string, limit int) ([]string, error) {
-	// TODO: 实现搜索建议功能
-	// 可以基于热门搜索词、标签等生成建议
-	return []string{}, nil
+// SuggestTopicNames 以 prefix 开头的话题名，按帖子数排序
+func (r *PostRepository) SuggestTopicNames(ctx context.Context, prefix string, limit int) ([]string, error) {
+	var names []string
+	err := r.db.WithContext(ctx).
+		Model(&models.Topic{}).
+		Where("name LIKE ?", escapeLike(prefix)+"%").
+		Order("post_count DESC").
+		Limit(limit).
+		Pluck("name", &names).Error
+	return names, err
+}
+
+// SuggestTags 以 prefix 开头的标签，按使用该标签的帖子数排序
+func (r *PostRepository) SuggestTags(ctx context.Context, prefix string, limit int) ([]string, error) {
+	var tags []string
+	err := r.db.WithContext(ctx).
+		Model(&models.Tag{}).
+		Where("name LIKE ? AND post_count > 0", escapeLike(prefix)+"%").
+		Order("post_count DESC").
+		Limit(limit).
+		Pluck("name", &tags).Error
+	return tags, err
+}
+
+// SuggestPostTitles 以 prefix 开头的热门帖子标题
+func (r *PostRepository) SuggestPostTitles(ctx context.Context, prefix string, limit int) ([]string, error) {
+	var titles []string
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published).
+		Where("title LIKE ?", escapeLike(prefix)+"%").
+		Order("like_count DESC, view_count DESC").
+		Limit(limit).
+		Pluck("title", &titles).Error
+	return titles, err
+}
+
+// escapeLike 转义 LIKE 通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
-// GetRelatedPosts 获取相关帖子（预留接口）
-func (r *PostRepository) GetRelatedPosts(ctx context.Context, postID string, limit int) ([]*models.Post, error) {
-	// TODO: 基于标签、分类、内容相似度等实现相关帖子推荐
-	// 可以集成机器学习算法或ElasticSearch的more_like_this功能
-	return []*models.Post{}, nil
+// GetRelatedPosts 获取相关帖子：从标签或话题相同、同分类的帖子中挑选候选，按相似度排序
+// 匿名帖子不出现在相关推荐中，excludeUserIDs 为查看者拉黑和屏蔽的用户
+func (r *PostRepository) GetRelatedPosts(ctx context.Context, target *models.Post, limit int, excludeUserIDs []string) ([]*models.Post, error) {
+	candidateQuery := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Model(&models.Post{}).
+			Scopes(models.Published, relation.ExcludeUsers("user_id", excludeUserIDs)).
+			Where("id <> ? AND is_anonymous = ?", target.ID, false).
+			Order("created_at DESC").
+			Limit(relatedCandidateLimit)
+	}
+
+	// 标签或话题相同的帖子
+	var matched []*models.Post
+	var conditions []string
+	var args []interface{}
+	if len(target.Tags) > 0 {
+		conditions = append(conditions, models.TaggedWithAnyCondition)
+		args = append(args, []string(target.Tags))
+	}
+	if target.TopicID != nil {
+		conditions = append(conditions, "topic_id = ?")
+		args = append(args, *target.TopicID)
+	}
+	if len(conditions) > 0 {
+		if err := candidateQuery().Where(strings.Join(conditions, " OR "), args...).Find(&matched).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// 同分类的最新帖子
+	var sameCategory []*models.Post
+	if err := candidateQuery().Where("category = ?", target.Category).Find(&sameCategory).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(matched)+len(sameCategory))
+	candidates := make([]*models.Post, 0, len(matched)+len(sameCategory))
+	for _, p := range append(matched, sameCategory...) {
+		if !seen[p.ID] {
+			seen[p.ID] = true
+			candidates = append(candidates, p)
+		}
+	}
+	return search.RankRelated(target, candidates, limit), nil
 }
 
 // OptimizeDatabase 数据库优化方法
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/models"
+)
+
+// GetScheduledPosts 获取用户未发布的帖子，定时发布的按发布时间正序，已取消的排在最后
+func (r *PostRepository) GetScheduledPosts(ctx context.Context, userID string, page, pageSize int32) ([]*models.Post, int64, error) {
+	var posts []*models.Post
+	var total int64
+	offset := (page - 1) * pageSize
+
+	query := r.db.WithContext(ctx).Model(&models.Post{}).
+		Where("user_id = ? AND status IN ?", userID, []models.PostStatus{models.PostStatusScheduled, models.PostStatusDraft})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("status ASC, publish_at ASC, id ASC").
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&posts).Error
+
+	return posts, total, err
+}
+
+// ReschedulePost 修改未发布帖子的发布时间，已取消的帖子重新进入定时发布
+func (r *PostRepository) ReschedulePost(ctx context.Context, postID, userID string, publishAt time.Time) (*models.Post, error) {
+	return r.updateUnpublished(ctx, postID, userID,
+		[]models.PostStatus{models.PostStatusScheduled, models.PostStatusDraft},
+		map[string]interface{}{
+			"status":     models.PostStatusScheduled,
+			"publish_at": publishAt,
+			"updated_at": time.Now(),
+		})
+}
+
+// CancelScheduledPost 取消定时发布，帖子保留为仅作者可见
+func (r *PostRepository) CancelScheduledPost(ctx context.Context, postID, userID string) (*models.Post, error) {
+	return r.updateUnpublished(ctx, postID, userID,
+		[]models.PostStatus{models.PostStatusScheduled},
+		map[string]interface{}{
+			"status":     models.PostStatusDraft,
+			"publish_at": nil,
+			"updated_at": time.Now(),
+		})
+}
+
+// updateUnpublished 按状态条件更新帖子，与定时发布任务并发时以先提交者为准
+func (r *PostRepository) updateUnpublished(ctx context.Context, postID, userID string, from []models.PostStatus, updates map[string]interface{}) (*models.Post, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Where("id = ? AND user_id = ? AND status IN ?", postID, userID, from).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	post, err := r.GetPost(ctx, postID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("post not found")
+		}
+		return nil, err
+	}
+	if post.UserID != userID {
+		return nil, fmt.Errorf("post not found")
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("post not scheduled")
+	}
+	return post, nil
+}
+
+// GetDueScheduledPostIDs 获取发布时间已到的定时帖子
+func (r *PostRepository) GetDueScheduledPostIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var postIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Where("status = ? AND publish_at <= ?", models.PostStatusScheduled, now).
+		Order("publish_at ASC, id ASC").
+		Limit(limit).
+		Pluck("id", &postIDs).Error
+	return postIDs, err
+}
+
+// PublishScheduledPost 发布到期的定时帖子，帖子的创建时间改为计划发布时间，并计入话题和标签的帖子数
+// 帖子已被发布、取消、改期或删除时返回 nil，保证每个帖子只发布一次
+func (r *PostRepository) PublishScheduledPost(ctx context.Context, postID string, now time.Time) (*models.Post, error) {
+	var published *models.Post
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var post models.Post
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ? AND publish_at <= ?", postID, models.PostStatusScheduled, now).
+			First(&post).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil
+			}
+			return err
+		}
+
+		post.Status = models.PostStatusPublished
+		post.CreatedAt = *post.PublishAt
+		post.UpdatedAt = now
+		if err := tx.Model(&models.Post{}).Where("id = ?", post.ID).Updates(map[string]interface{}{
+			"status":     post.Status,
+			"created_at": post.CreatedAt,
+			"updated_at": post.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if post.TopicID != nil {
+			if err := tx.Model(&models.Topic{}).Where("id = ?", *post.TopicID).
+				Update("post_count", gorm.Expr("post_count + ?", 1)).Error; err != nil {
+				return err
+			}
+		}
+		if err := adjustTagCounts(tx, post.ID, 1); err != nil {
+			return err
+		}
+		published = &post
+		return nil
+	})
+	return published, err
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+
+	"hupu/shared/models"
+	"hupu/shared/relation"
+	"hupu/shared/utils"
+)
+
+// SubscribeTopic 订阅话题并返回最新的订阅人数
+func (r *PostRepository) SubscribeTopic(ctx context.Context, userID, topicID string) (int32, error) {
+	var topic models.Topic
+	err := r.db.WithContext(ctx).Select("id").Where("id = ?", topicID).First(&topic).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return 0, fmt.Errorf("topic not found")
+		}
+		return 0, err
+	}
+
+	subscribed, err := r.IsTopicSubscribed(ctx, userID, topicID)
+	if err != nil {
+		return 0, err
+	}
+	if subscribed {
+		return 0, fmt.Errorf("topic already subscribed")
+	}
+
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	subscription := &models.TopicSubscription{
+		ID:        xid.New().String(),
+		UserID:    userID,
+		TopicID:   topicID,
+		CreatedAt: time.Now(),
+	}
+	if err := tx.Create(subscription).Error; err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to create subscription: %w", err)
+	}
+	if err := tx.Model(&models.Topic{}).Where("id = ?", topicID).
+		UpdateColumn("subscriber_count", gorm.Expr("subscriber_count + ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return r.topicSubscriberCount(ctx, topicID)
+}
+
+// UnsubscribeTopic 取消订阅话题并返回最新的订阅人数
+func (r *PostRepository) UnsubscribeTopic(ctx context.Context, userID, topicID string) (int32, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Where("user_id = ? AND topic_id = ?", userID, topicID).Delete(&models.TopicSubscription{})
+	if result.Error != nil {
+		tx.Rollback()
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return 0, fmt.Errorf("topic not subscribed")
+	}
+	if err := tx.Model(&models.Topic{}).Where("id = ? AND subscriber_count > 0", topicID).
+		UpdateColumn("subscriber_count", gorm.Expr("subscriber_count - ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, err
+	}
+	return r.topicSubscriberCount(ctx, topicID)
+}
+
+func (r *PostRepository) topicSubscriberCount(ctx context.Context, topicID string) (int32, error) {
+	var count int32
+	err := r.db.WithContext(ctx).Model(&models.Topic{}).
+		Select("subscriber_count").
+		Where("id = ?", topicID).
+		Scan(&count).Error
+	return count, err
+}
+
+// IsTopicSubscribed 检查用户是否已订阅话题
+func (r *PostRepository) IsTopicSubscribed(ctx context.Context, userID, topicID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.TopicSubscription{}).
+		Where("user_id = ? AND topic_id = ?", userID, topicID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetSubscribedTopics 获取用户订阅的话题，按订阅时间倒序
+func (r *PostRepository) GetSubscribedTopics(ctx context.Context, userID string, page, pageSize int32) ([]*models.Topic, error) {
+	var topics []*models.Topic
+	offset := (page - 1) * pageSize
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN topic_subscriptions ON topic_subscriptions.topic_id = topics.id").
+		Where("topic_subscriptions.user_id = ?", userID).
+		Order("topic_subscriptions.created_at DESC, topic_subscriptions.id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&topics).Error
+	return topics, err
+}
+
+// GetSubscribedTopicFeed 获取用户订阅话题下的帖子，按发布时间倒序，不含用户拉黑和屏蔽的作者的帖子
+// 传入游标时按 (created_at, id) 翻页，忽略 page
+func (r *PostRepository) GetSubscribedTopicFeed(ctx context.Context, userID string, cursor *utils.Cursor, page, pageSize int32, excludeUserIDs []string) ([]*models.Post, error) {
+	var posts []*models.Post
+	subscribed := r.db.Model(&models.TopicSubscription{}).Select("topic_id").Where("user_id = ?", userID)
+
+	query := r.db.WithContext(ctx).
+		Scopes(models.Published, relation.ExcludeNamedUsers("user_id", excludeUserIDs)).
+		Where("topic_id IN (?)", subscribed).
+		Order("created_at DESC, id DESC").
+		Limit(int(pageSize) + 1) // 多取一条用于判断 has_more
+	if cursor != nil {
+		query = query.Scopes(utils.TimeCursorScope("created_at", "id", cursor))
+	} else {
+		query = query.Offset(int((page - 1) * pageSize))
+	}
+	err := query.Find(&posts).Error
+	return posts, err
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+	"hupu/shared/relation"
+	"hupu/shared/search"
+)
+
+// ensureTags 创建不存在的标签，返回标签名到标签ID的映射
+func ensureTags(tx *gorm.DB, names []string) (map[string]string, error) {
+	now := time.Now()
+	tags := make([]*models.Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &models.Tag{ID: xid.New().String(), Name: name, CreatedAt: now, UpdatedAt: now})
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	var existing []*models.Tag
+	if err := tx.Select("id", "name").Where("name IN ?", names).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(existing))
+	for _, tag := range existing {
+		ids[tag.Name] = tag.ID
+	}
+	return ids, nil
+}
+
+// syncPostTags 在事务内将帖子的标签关联从 oldTags 更新为 newTags
+// published 为 true 时同步标签的帖子数，未发布的帖子在发布时才计入
+func syncPostTags(tx *gorm.DB, postID string, oldTags, newTags []string, published bool) error {
+	added, removed := search.DiffTags(oldTags, newTags)
+
+	if len(added) > 0 {
+		ids, err := ensureTags(tx, added)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		tagIDs := make([]string, 0, len(added))
+		postTags := make([]*models.PostTag, 0, len(added))
+		for _, name := range added {
+			tagIDs = append(tagIDs, ids[name])
+			postTags = append(postTags, &models.PostTag{PostID: postID, TagID: ids[name], CreatedAt: now})
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&postTags).Error; err != nil {
+			return err
+		}
+		if published {
+			if err := tx.Model(&models.Tag{}).Where("id IN ?", tagIDs).
+				UpdateColumn("post_count", gorm.Expr("post_count + ?", 1)).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(removed) > 0 {
+		var tagIDs []string
+		if err := tx.Model(&models.Tag{}).Where("name IN ?", removed).Pluck("id", &tagIDs).Error; err != nil {
+			return err
+		}
+		if len(tagIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("post_id = ? AND tag_id IN ?", postID, tagIDs).Delete(&models.PostTag{}).Error; err != nil {
+			return err
+		}
+		if published {
+			if err := tx.Model(&models.Tag{}).Where("id IN ? AND post_count > 0", tagIDs).
+				UpdateColumn("post_count", gorm.Expr("post_count - ?", 1)).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// adjustTagCounts 帖子发布、删除或恢复时调整其标签的帖子数
+func adjustTagCounts(tx *gorm.DB, postID string, delta int) error {
+	var tagIDs []string
+	if err := tx.Model(&models.PostTag{}).Where("post_id = ?", postID).Pluck("tag_id", &tagIDs).Error; err != nil {
+		return err
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	query := tx.Model(&models.Tag{}).Where("id IN ?", tagIDs)
+	if delta < 0 {
+		query = query.Where("post_count > 0")
+	}
+	return query.UpdateColumn("post_count", gorm.Expr("post_count + ?", delta)).Error
+}
+
+// GetTagByName 根据规范化后的标签名获取标签
+func (r *PostRepository) GetTagByName(ctx context.Context, name string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.WithContext(ctx).Where("name = ?", name).First(&tag).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("tag not found")
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetTagsByNames 批量获取标签，返回标签名到标签的映射
+func (r *PostRepository) GetTagsByNames(ctx context.Context, names []string) (map[string]*models.Tag, error) {
+	tags := make(map[string]*models.Tag, len(names))
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	var tagList []*models.Tag
+	if err := r.db.WithContext(ctx).Where("name IN ?", names).Find(&tagList).Error; err != nil {
+		return nil, err
+	}
+	for _, tag := range tagList {
+		tags[tag.Name] = tag
+	}
+	return tags, nil
+}
+
+// GetTagPosts 获取带有指定标签的已发布帖子，sortType 为 hot 时按互动数排序，否则按时间倒序
+func (r *PostRepository) GetTagPosts(ctx context.Context, name string, page, pageSize int32, sortType string, excludeUserIDs []string) ([]*models.Post, error) {
+	var posts []*models.Post
+	offset := (page - 1) * pageSize
+
+	query := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published, models.TaggedWith(name), relation.ExcludeNamedUsers("posts.user_id", excludeUserIDs))
+
+	switch sortType {
+	case constants.SortTypeHot:
+		query = query.Order("posts.like_count DESC, posts.comment_count DESC, posts.created_at DESC, posts.id DESC")
+	default:
+		query = query.Order("posts.created_at DESC, posts.id DESC")
+	}
+
+	err := query.
+		Offset(int(offset)).
+		Limit(int(pageSize) + 1). // 多取一条用于判断 has_more
+		Find(&posts).Error
+
+	return posts, err
+}
@@ -12,6 +12,7 @@ import (
 	"hupu/shared/log"
 	"hupu/shared/middleware"
 	"hupu/shared/models"
+	"hupu/shared/moderation"
 	"hupu/shared/utils"
 )
 
@@ -86,9 +87,11 @@ func (h *UserHandler) Login(ctx context.Context, req *service.LoginRequest) (*se
 		}, nil
 	}
 
-	getUser, err := h.db.GetUser(&models.User{
-		Username: req.Username,
-		Status:   models.UserStatusActive,
+	// 被封禁或暂停使用的用户可以登录，以便查看通知和提交申诉，发帖和评论由各服务限制
+	getUser, err := h.db.GetLoginUser(req.Username, []models.UserStatus{
+		models.UserStatusActive,
+		models.UserStatusBanned,
+		models.UserStatusSuspended,
 	})
 	if err != nil {
 		return &service.LoginResponse{
@@ -318,6 +321,13 @@ func (h *UserHandler) CreateAnonymousProfile(ctx context.Context, req *service.C
 			Message: constants.GetErrorMessage(constants.ValidationErrorCode),
 		}, nil
 	}
+	// 被封禁或暂停使用的用户不能创建马甲
+	if code := moderation.RestrictedCode(utils.GetDB().WithContext(ctx), req.UserId); code != constants.SuccessCode {
+		return &service.CreateAnonymousProfileResponse{
+			Code:    code,
+			Message: constants.GetErrorMessage(code),
+		}, nil
+	}
 
 	avatar := &models.AnonymousAvatar{
 		UserID:   req.UserId,
@@ -73,6 +73,15 @@ func (ur *UserRepository) GetUser(user *models.User) (*models.User, error) {
 	return &userModel, nil
 }
 
+// GetLoginUser 按用户名查找处于指定状态的用户
+func (ur *UserRepository) GetLoginUser(username string, statuses []models.UserStatus) (*models.User, error) {
+	var userModel models.User
+	if err := ur.db.Where("username = ? and status IN ?", username, statuses).First(&userModel).Error; err != nil {
+		return nil, err
+	}
+	return &userModel, nil
+}
+
 func (ur *UserRepository) GetUserByUsername(user *models.User) (*models.User, error) {
 	var userModel models.User
 	if err := ur.db.Where("id = ? ", user.ID).First(&userModel).Error; err != nil {
@@ -143,15 +152,36 @@ func (ur *UserRepository) FollowUser(userID, targetUserID string) error {
 		return fmt.Errorf("failed to create follow relationship: %w", err)
 	}
 
+	// 更新双方的关注数和粉丝数
+	if err := tx.Model(&models.User{}).Where("id = ?", userID).
+		UpdateColumn("following_count", gorm.Expr("following_count + ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update following count: %w", err)
+	}
+	if err := tx.Model(&models.User{}).Where("id = ?", targetUserID).
+		UpdateColumn("follower_count", gorm.Expr("follower_count + ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update follower count: %w", err)
+	}
+
 	return tx.Commit().Error
 }
 
 func (ur *UserRepository) UnfollowUser(userID, targetUserID string) error {
-	err := ur.db.Where("follower_id = ? AND following_id = ?", userID, targetUserID).Delete(&models.Follow{}).Error
-	if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
-	}
-	return nil
+	return ur.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("follower_id = ? AND following_id = ?", userID, targetUserID).Delete(&models.Follow{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		// 更新双方的关注数和粉丝数，不会减到负数
+		if err := tx.Model(&models.User{}).Where("id = ? AND following_count > 0", userID).
+			UpdateColumn("following_count", gorm.Expr("following_count - ?", 1)).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.User{}).Where("id = ? AND follower_count > 0", targetUserID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count - ?", 1)).Error
+	})
 }
 
 func (ur *UserRepository) GetFollowerList(userID string, page, pageSize int32) ([]*models.User, error) {
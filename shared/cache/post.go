@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"hupu/shared/log"
+	"hupu/shared/models"
+)
+
+const (
+	postListKeyPrefix = "post:list:"
+	postRefKeyPrefix  = "post:list:ref:post:"
+	tagRefKeyPrefix   = "post:list:ref:tag:"
+
+	// emptyListValue 空结果占位，用于负缓存
+	emptyListValue = "__empty__"
+
+	DefaultPostListTTL    = 5 * time.Minute
+	DefaultPostListJitter = time.Minute
+	DefaultNegativeTTL    = 30 * time.Second
+)
+
+// 列表缓存标签，写操作按标签批量失效
+const (
+	TagLatest = "latest" // 按时间排序的列表，新帖发布或恢复时失效
+)
+
+// CollectedTag 用户收藏列表标签
+func CollectedTag(userID string) string {
+	return "collected:" + userID
+}
+
+// RelatedTag 帖子的相关推荐列表标签，帖子内容修改时失效
+func RelatedTag(postID string) string {
+	return "related:" + postID
+}
+
+// PostCache 帖子列表的 cache-aside 缓存
+// 每个缓存的列表都会登记到其包含帖子的反向索引中，帖子变更时精确失效包含它的列表
+type PostCache struct {
+	store       Store
+	group       singleflight.Group
+	ttl         time.Duration
+	jitter      time.Duration
+	negativeTTL time.Duration
+}
+
+// NewPostCache 创建帖子列表缓存
+func NewPostCache(store Store) *PostCache {
+	return &PostCache{
+		store:       store,
+		ttl:         DefaultPostListTTL,
+		jitter:      DefaultPostListJitter,
+		negativeTTL: DefaultNegativeTTL,
+	}
+}
+
+// GetPosts 先读缓存，未命中时执行 query 并回填；同一 key 的并发未命中只会查询一次
+// tags 用于登记额外的失效标签
+func (c *PostCache) GetPosts(ctx context.Context, key string, query func() ([]*models.Post, error), tags ...string) ([]*models.Post, error) {
+	fullKey := postListKeyPrefix + key
+	if posts, ok := c.load(fullKey); ok {
+		return posts, nil
+	}
+
+	v, err, _ := c.group.Do(fullKey, func() (interface{}, error) {
+		// 二次检查，排队等待的请求可能已被前一个请求回填
+		if posts, ok := c.load(fullKey); ok {
+			return posts, nil
+		}
+		posts, err := query()
+		if err != nil {
+			return nil, err
+		}
+		c.save(fullKey, posts, tags)
+		return posts, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*models.Post), nil
+}
+
+// InvalidatePost 失效所有包含该帖子的列表缓存
+func (c *PostCache) InvalidatePost(ctx context.Context, postID string) error {
+	return c.invalidateRef(postRefKeyPrefix + postID)
+}
+
+// InvalidateTag 失效登记在该标签下的列表缓存
+func (c *PostCache) InvalidateTag(ctx context.Context, tag string) error {
+	return c.invalidateRef(tagRefKeyPrefix + tag)
+}
+
+func (c *PostCache) invalidateRef(refKey string) error {
+	keys, err := c.store.SMembers(refKey)
+	if err != nil {
+		return err
+	}
+	_, err = c.store.Del(append(keys, refKey)...)
+	return err
+}
+
+// load 读取缓存，读取失败按未命中处理，保证缓存故障时可降级到数据库
+func (c *PostCache) load(key string) ([]*models.Post, bool) {
+	val, err := c.store.Get(key)
+	if err != nil {
+		log.GetLogger().Warnf("PostCache get %s failed: %v", key, err)
+		return nil, false
+	}
+	if val == "" {
+		return nil, false
+	}
+	if val == emptyListValue {
+		return []*models.Post{}, true
+	}
+	var posts []*models.Post
+	if err := json.Unmarshal([]byte(val), &posts); err != nil {
+		log.GetLogger().Warnf("PostCache unmarshal %s failed: %v", key, err)
+		return nil, false
+	}
+	return posts, true
+}
+
+// save 回填缓存并登记反向索引，写入失败只记录日志
+func (c *PostCache) save(key string, posts []*models.Post, tags []string) {
+	ttl := c.ttl + c.jitterDuration()
+	if len(posts) == 0 {
+		ttl = c.negativeTTL
+		if err := c.store.Set(key, emptyListValue, ttl); err != nil {
+			log.GetLogger().Warnf("PostCache set %s failed: %v", key, err)
+			return
+		}
+	} else {
+		data, err := json.Marshal(posts)
+		if err != nil {
+			log.GetLogger().Warnf("PostCache marshal %s failed: %v", key, err)
+			return
+		}
+		if err := c.store.Set(key, string(data), ttl); err != nil {
+			log.GetLogger().Warnf("PostCache set %s failed: %v", key, err)
+			return
+		}
+	}
+
+	refKeys := make([]string, 0, len(posts)+len(tags))
+	for _, p := range posts {
+		refKeys = append(refKeys, postRefKeyPrefix+p.ID)
+	}
+	for _, tag := range tags {
+		refKeys = append(refKeys, tagRefKeyPrefix+tag)
+	}
+	// 反向索引比列表多保留一个抖动周期，确保列表存活期间都能被失效
+	refTTL := c.ttl + 2*c.jitter
+	for _, refKey := range refKeys {
+		if _, err := c.store.SAdd(refKey, key); err != nil {
+			log.GetLogger().Warnf("PostCache sadd %s failed: %v", refKey, err)
+			continue
+		}
+		if _, err := c.store.Expire(refKey, refTTL); err != nil {
+			log.GetLogger().Warnf("PostCache expire %s failed: %v", refKey, err)
+		}
+	}
+}
+
+// jitterDuration 随机抖动，避免大量缓存同时过期
+func (c *PostCache) jitterDuration() time.Duration {
+	if c.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(c.jitter)))
+}
@@ -0,0 +1,243 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hupu/shared/models"
+)
+
+// countingQuery 返回固定结果并记录调用次数
+func countingQuery(calls *int32, posts []*models.Post) func() ([]*models.Post, error) {
+	return func() ([]*models.Post, error) {
+		atomic.AddInt32(calls, 1)
+		return posts, nil
+	}
+}
+
+func postIDs(posts []*models.Post) []string {
+	ids := make([]string, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGetPostsHitAndMiss(t *testing.T) {
+	ctx := context.Background()
+	c := NewPostCache(NewMemoryStore())
+	want := []*models.Post{{ID: "p1", Title: "a"}, {ID: "p2", Title: "b"}}
+
+	var calls int32
+	for i := 0; i < 3; i++ {
+		got, err := c.GetPosts(ctx, "latest:1", countingQuery(&calls, want))
+		if err != nil {
+			t.Fatalf("GetPosts: %v", err)
+		}
+		if !equalIDs(postIDs(got), []string{"p1", "p2"}) {
+			t.Fatalf("GetPosts = %v, want [p1 p2]", postIDs(got))
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("query called %d times, want 1", calls)
+	}
+
+	// 不同的 key 各自回源
+	if _, err := c.GetPosts(ctx, "latest:2", countingQuery(&calls, want)); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("query called %d times, want 2", calls)
+	}
+}
+
+func TestGetPostsQueryErrorNotCached(t *testing.T) {
+	ctx := context.Background()
+	c := NewPostCache(NewMemoryStore())
+	queryErr := errors.New("db down")
+
+	_, err := c.GetPosts(ctx, "latest:1", func() ([]*models.Post, error) { return nil, queryErr })
+	if !errors.Is(err, queryErr) {
+		t.Fatalf("GetPosts err = %v, want %v", err, queryErr)
+	}
+
+	var calls int32
+	if _, err := c.GetPosts(ctx, "latest:1", countingQuery(&calls, []*models.Post{{ID: "p1"}})); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("query called %d times after error, want 1", calls)
+	}
+}
+
+func TestGetPostsSingleflight(t *testing.T) {
+	ctx := context.Background()
+	c := NewPostCache(NewMemoryStore())
+
+	var calls int32
+	release := make(chan struct{})
+	query := func() ([]*models.Post, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []*models.Post{{ID: "p1"}}, nil
+	}
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			posts, err := c.GetPosts(ctx, "hot:1", query)
+			if err == nil && !equalIDs(postIDs(posts), []string{"p1"}) {
+				err = errors.New("unexpected posts")
+			}
+			errs <- err
+		}()
+	}
+	// 等待请求进入 singleflight 后再放行查询，之后到达的请求命中缓存
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("GetPosts: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("query called %d times for concurrent misses, want 1", calls)
+	}
+}
+
+func TestGetPostsNegativeCache(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	c := NewPostCache(store)
+
+	var calls int32
+	for i := 0; i < 2; i++ {
+		got, err := c.GetPosts(ctx, "tag:none", countingQuery(&calls, []*models.Post{}))
+		if err != nil {
+			t.Fatalf("GetPosts: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Fatalf("GetPosts = %v, want empty non-nil list", got)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("query called %d times for empty result, want 1", calls)
+	}
+	if val, _ := store.Get(postListKeyPrefix + "tag:none"); val != emptyListValue {
+		t.Fatalf("cached value = %q, want placeholder %q", val, emptyListValue)
+	}
+
+	// 空结果只缓存 negativeTTL
+	c.negativeTTL = 10 * time.Millisecond
+	if _, err := c.GetPosts(ctx, "tag:short", countingQuery(&calls, nil)); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, err := c.GetPosts(ctx, "tag:short", countingQuery(&calls, nil)); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("query called %d times after negative ttl expired, want 3", calls)
+	}
+}
+
+func TestInvalidatePost(t *testing.T) {
+	ctx := context.Background()
+	c := NewPostCache(NewMemoryStore())
+
+	var calls int32
+	lists := map[string][]*models.Post{
+		"latest:1": {{ID: "p1"}, {ID: "p2"}},
+		"hot:1":    {{ID: "p2"}, {ID: "p3"}},
+		"topic:1":  {{ID: "p3"}},
+	}
+	for key, posts := range lists {
+		if _, err := c.GetPosts(ctx, key, countingQuery(&calls, posts)); err != nil {
+			t.Fatalf("GetPosts %s: %v", key, err)
+		}
+	}
+
+	if err := c.InvalidatePost(ctx, "p2"); err != nil {
+		t.Fatalf("InvalidatePost: %v", err)
+	}
+
+	tests := []struct {
+		key       string
+		wantQuery bool
+	}{
+		{"latest:1", true},
+		{"hot:1", true},
+		{"topic:1", false},
+	}
+	for _, tt := range tests {
+		calls = 0
+		if _, err := c.GetPosts(ctx, tt.key, countingQuery(&calls, lists[tt.key])); err != nil {
+			t.Fatalf("GetPosts %s: %v", tt.key, err)
+		}
+		if got := calls == 1; got != tt.wantQuery {
+			t.Errorf("%s queried = %v after InvalidatePost(p2), want %v", tt.key, got, tt.wantQuery)
+		}
+	}
+}
+
+func TestInvalidateTag(t *testing.T) {
+	ctx := context.Background()
+	c := NewPostCache(NewMemoryStore())
+
+	var calls int32
+	if _, err := c.GetPosts(ctx, "latest:1", countingQuery(&calls, []*models.Post{{ID: "p1"}}), TagLatest); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	// 空列表也要登记标签，新帖发布时能失效负缓存
+	if _, err := c.GetPosts(ctx, "latest:2", countingQuery(&calls, nil), TagLatest); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+	if _, err := c.GetPosts(ctx, "collected:u1", countingQuery(&calls, []*models.Post{{ID: "p1"}}), CollectedTag("u1")); err != nil {
+		t.Fatalf("GetPosts: %v", err)
+	}
+
+	if err := c.InvalidateTag(ctx, TagLatest); err != nil {
+		t.Fatalf("InvalidateTag: %v", err)
+	}
+
+	tests := []struct {
+		key       string
+		wantQuery bool
+	}{
+		{"latest:1", true},
+		{"latest:2", true},
+		{"collected:u1", false},
+	}
+	for _, tt := range tests {
+		calls = 0
+		if _, err := c.GetPosts(ctx, tt.key, countingQuery(&calls, []*models.Post{{ID: "p1"}})); err != nil {
+			t.Fatalf("GetPosts %s: %v", tt.key, err)
+		}
+		if got := calls == 1; got != tt.wantQuery {
+			t.Errorf("%s queried = %v after InvalidateTag(latest), want %v", tt.key, got, tt.wantQuery)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Store 缓存存储接口
+// *utils.RedisClient 直接满足该接口，测试或本地调试时可使用 MemoryStore 代替
+type Store interface {
+	Get(key string) (string, error)
+	Set(key string, value interface{}, expiration time.Duration) error
+	Del(keys ...string) (int64, error)
+	SAdd(key string, members ...interface{}) (int64, error)
+	SMembers(key string) ([]string, error)
+	Expire(key string, expiration time.Duration) (bool, error)
+}
+
+type memoryEntry struct {
+	value    string
+	set      map[string]struct{}
+	expireAt time.Time
+}
+
+func (e *memoryEntry) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && now.After(e.expireAt)
+}
+
+// MemoryStore 基于内存的 Store 实现，语义与 Redis 保持一致：未命中时 Get 返回空字符串
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+}
+
+// NewMemoryStore 创建内存缓存存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]*memoryEntry)}
+}
+
+// lookup 获取未过期的条目，调用方需持有锁
+func (m *MemoryStore) lookup(key string) *memoryEntry {
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil
+	}
+	if entry.expired(time.Now()) {
+		delete(m.entries, key)
+		return nil
+	}
+	return entry
+}
+
+// Get 获取字符串值
+func (m *MemoryStore) Get(key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := m.lookup(key)
+	if entry == nil {
+		return "", nil
+	}
+	if entry.set != nil {
+		return "", fmt.Errorf("WRONGTYPE operation against a key holding the wrong kind of value")
+	}
+	return entry.value, nil
+}
+
+// Set 设置字符串值，expiration 为0表示不过期
+func (m *MemoryStore) Set(key string, value interface{}, expiration time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := &memoryEntry{}
+	switch v := value.(type) {
+	case string:
+		entry.value = v
+	case []byte:
+		entry.value = string(v)
+	default:
+		entry.value = fmt.Sprint(v)
+	}
+	if expiration > 0 {
+		entry.expireAt = time.Now().Add(expiration)
+	}
+	m.entries[key] = entry
+	return nil
+}
+
+// Del 删除键，返回实际删除的数量
+func (m *MemoryStore) Del(keys ...string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for _, key := range keys {
+		if m.lookup(key) != nil {
+			delete(m.entries, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// SAdd 向集合添加成员，返回新增的数量
+func (m *MemoryStore) SAdd(key string, members ...interface{}) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := m.lookup(key)
+	if entry == nil {
+		entry = &memoryEntry{set: make(map[string]struct{})}
+		m.entries[key] = entry
+	}
+	if entry.set == nil {
+		return 0, fmt.Errorf("WRONGTYPE operation against a key holding the wrong kind of value")
+	}
+	var added int64
+	for _, member := range members {
+		s := fmt.Sprint(member)
+		if _, ok := entry.set[s]; !ok {
+			entry.set[s] = struct{}{}
+			added++
+		}
+	}
+	return added, nil
+}
+
+// SMembers 获取集合所有成员
+func (m *MemoryStore) SMembers(key string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := m.lookup(key)
+	if entry == nil {
+		return []string{}, nil
+	}
+	members := make([]string, 0, len(entry.set))
+	for member := range entry.set {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// Expire 设置过期时间
+func (m *MemoryStore) Expire(key string, expiration time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := m.lookup(key)
+	if entry == nil {
+		return false, nil
+	}
+	entry.expireAt = time.Now().Add(expiration)
+	return true, nil
+}
@@ -7,14 +7,19 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	MySQL    MySQLConfig    `mapstructure:"mysql"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	Kafka    KafkaConfig    `mapstructure:"kafka"`
-	OSS      OSSConfig      `mapstructure:"oss"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Services ServicesConfig `mapstructure:"services"`
-	Log      LogConfig      `mapstructure:"log"`
+	Server     ServerConfig     `mapstructure:"server"`
+	MySQL      MySQLConfig      `mapstructure:"mysql"`
+	Redis      RedisConfig      `mapstructure:"redis"`
+	Kafka      KafkaConfig      `mapstructure:"kafka"`
+	OSS        OSSConfig        `mapstructure:"oss"`
+	JWT        JWTConfig        `mapstructure:"jwt"`
+	Services   ServicesConfig   `mapstructure:"services"`
+	Log        LogConfig        `mapstructure:"log"`
+	Post       PostConfig       `mapstructure:"post"`
+	Tag        TagConfig        `mapstructure:"tag"`
+	Reconcile  ReconcileConfig  `mapstructure:"reconcile"`
+	Storage    StorageConfig    `mapstructure:"storage"`
+	Moderation ModerationConfig `mapstructure:"moderation"`
 }
 
 type ServerConfig struct {
@@ -74,6 +79,56 @@ type LogConfig struct {
 	Level string `mapstructure:"level"`
 }
 
+// PostConfig 帖子服务相关配置
+type PostConfig struct {
+	TrashRetentionDays    int     `mapstructure:"trash_retention_days"`     // 回收站保留天数
+	TrashPurgeIntervalMin int     `mapstructure:"trash_purge_interval_min"` // 回收站清理间隔(分钟)
+	DraftRetentionDays    int     `mapstructure:"draft_retention_days"`     // 草稿未更新超过该天数后自动清理
+	HotDecayHours         int     `mapstructure:"hot_decay_hours"`          // 热度衰减周期(小时)，晚发布一个周期的帖子需要十倍互动量才能持平
+	HotWindowDays         int     `mapstructure:"hot_window_days"`          // 参与热榜的帖子天数
+	HotRebuildIntervalMin int     `mapstructure:"hot_rebuild_interval_min"` // 热榜重建间隔(分钟)
+	RatingPriorWeight     float64 `mapstructure:"rating_prior_weight"`      // 评分榜贝叶斯先验权重
+	RatingMinVotes        int     `mapstructure:"rating_min_votes"`         // 进入评分榜的最少评分人数
+	SearchBackend         string  `mapstructure:"search_backend"`           // 搜索后端: mysql / memory
+	SearchRecencyBoost    float64 `mapstructure:"search_recency_boost"`     // 搜索结果时间加权
+	SearchScoreBoost      float64 `mapstructure:"search_score_boost"`       // 搜索结果评分加权
+	RecommendWeightFollow float64 `mapstructure:"recommend_weight_follow"`  // 推荐流: 关注的人
+	RecommendWeightTopic  float64 `mapstructure:"recommend_weight_topic"`   // 推荐流: 参与的话题
+	RecommendWeightTag    float64 `mapstructure:"recommend_weight_tag"`     // 推荐流: 兴趣标签
+	RecommendWeightHot    float64 `mapstructure:"recommend_weight_hot"`     // 推荐流: 全站热榜
+	RecommendWindowDays   int     `mapstructure:"recommend_window_days"`    // 推荐候选帖子天数
+	TopicShareBaseURL     string  `mapstructure:"topic_share_base_url"`     // 话题分享链接前缀
+	TopicHotPostRank      int     `mapstructure:"topic_hot_post_rank"`      // 帖子进入热榜前多少名时通知话题订阅者
+	ViewDedupeWindowMin   int     `mapstructure:"view_dedupe_window_min"`   // 同一用户或设备重复浏览只计一次的窗口(分钟)
+	ViewFlushIntervalSec  int     `mapstructure:"view_flush_interval_sec"`  // 浏览量写回数据库间隔(秒)
+	HistoryMaxSize        int     `mapstructure:"history_max_size"`         // 每个用户保留的浏览历史条数
+	ScheduleMaxDays       int     `mapstructure:"schedule_max_days"`        // 定时发布最多提前的天数
+	ScheduleIntervalSec   int     `mapstructure:"schedule_interval_sec"`    // 定时发布扫描间隔(秒)
+}
+
+// TagConfig 标签相关配置
+type TagConfig struct {
+	Synonyms map[string]string `mapstructure:"synonyms"` // 同义词到标准标签名的映射，两侧均按规范化后的形式匹配
+}
+
+// ReconcileConfig 冗余计数校对任务配置
+type ReconcileConfig struct {
+	IntervalMin int  `mapstructure:"interval_min"` // 定时校对间隔(分钟)
+	BatchSize   int  `mapstructure:"batch_size"`   // 每批校对的行数
+	AutoFix     bool `mapstructure:"auto_fix"`     // 定时校对时是否修复偏差，否则只报告
+}
+
+// StorageConfig 对象存储配置，oss 后端使用 OSSConfig 中的凭证
+type StorageConfig struct {
+	Backend         string `mapstructure:"backend"`           // 存储后端: oss / local
+	PublicURL       string `mapstructure:"public_url"`        // 对象访问地址前缀，oss 后端为空时使用 bucket 域名
+	LocalDir        string `mapstructure:"local_dir"`         // 本地存储目录，仅 local 后端
+	LocalSecret     string `mapstructure:"local_secret"`      // 本地上传地址的签名密钥，仅 local 后端
+	UploadExpireSec int    `mapstructure:"upload_expire_sec"` // 上传凭证有效期(秒)
+	MaxImageSizeKB  int    `mapstructure:"max_image_size_kb"` // 单张图片大小上限(KB)
+	ThumbnailSize   int    `mapstructure:"thumbnail_size"`    // 九宫格缩略图边长(像素)
+}
+
 var GlobalConfig *Config
 
 func Init(configPath string) {
@@ -88,3 +143,14 @@ func Init(configPath string) {
 		log.Fatalf("Failed to unmarshal config: %v", err)
 	}
 }
+
+// ModerationConfig 内容审核配置
+type ModerationConfig struct {
+	DictPath          string `mapstructure:"dict_path"`           // 敏感词词库文件
+	ReloadIntervalSec int    `mapstructure:"reload_interval_sec"` // 词库变更检查间隔(秒)
+	SpamWindowMin     int    `mapstructure:"spam_window_min"`     // 重复内容检测窗口(分钟)
+	SpamUserRepeat    int    `mapstructure:"spam_user_repeat"`    // 同一用户窗口内发布相似内容达到该次数时拒绝
+	SpamGlobalRepeat  int    `mapstructure:"spam_global_repeat"`  // 全站窗口内出现相似内容达到该次数时送审
+	SimhashDistance   int    `mapstructure:"simhash_distance"`    // simhash 海明距离不超过该值视为相似
+	ReportHideCount   int    `mapstructure:"report_hide_count"`   // 不同用户举报达到该人数时自动隐藏帖子或评论
+}
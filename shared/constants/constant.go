@@ -6,6 +6,11 @@ const (
 	TraceIdKey = "trace_id"
 )
 
+// 请求头常量
+const (
+	HeaderDeviceID = "X-Device-Id" // 客户端设备标识，未登录时用于浏览量去重
+)
+
 // 引用共享常量
 const (
 	// HTTP Status Codes
@@ -50,6 +55,12 @@ const (
 	ParamDate        = "date"
 	ParamKeyword     = "keyword"
 	ParamLimit       = "limit"
+	ParamCursor      = "cursor"
+	ParamQuery       = "q"
+	ParamCategoryID  = "category_id"
+	ParamWindow      = "window"
+	ParamVersion     = "version"
+	ParamFolderID    = "folder_id"
 )
 
 // 排序类型常量
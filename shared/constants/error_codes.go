@@ -35,6 +35,12 @@ const (
 	UserFollowFailCode         = 2011
 	UserNotFollowedCode        = 2012
 	UserProfileUpdateFailCode  = 2013
+	UserBlockSelfCode          = 2014
+	UserAlreadyBlockedCode     = 2015
+	UserNotBlockedCode         = 2016
+	UserAlreadyMutedCode       = 2017
+	UserNotMutedCode           = 2018
+	UserBlockedCode            = 2019
 )
 
 // 帖子相关错误码 (3000-3999)
@@ -55,17 +61,59 @@ const (
 	PostRatingNotFoundCode   = 3013
 	PostViewCountFailCode    = 3014
 	PostRatingRankFailCode   = 3015
+	PostPermissionDeniedCode = 3016
+	PostRevisionNotFoundCode = 3017
+	PostRestoreFailCode      = 3018
+	PostRestoreExpiredCode   = 3019
+	PostDraftNotFoundCode    = 3020
+	PostDraftConflictCode    = 3021
+	PostDraftSaveFailCode    = 3022
+	PostScheduleInvalidCode  = 3023
+	PostNotScheduledCode     = 3024
+	PostPollNotFoundCode     = 3025
+	PostPollClosedCode       = 3026
+	PostPollAlreadyVotedCode = 3027
+	PostPollNotVotedCode     = 3028
+	PostPollInvalidCode      = 3029
+	PostPollVoteFailCode     = 3030
+	PostTagNotFoundCode      = 3031
+	PostFolderNotFoundCode   = 3032
+	PostFolderNameExistsCode = 3033
+	PostFolderLimitCode      = 3034
+	PostFolderDefaultCode    = 3035
+	PostContentRejectedCode  = 3036
+	PostContentSpamCode      = 3037
+	PostReviewingCode        = 3038
+	PostReviewNotFoundCode   = 3039
+	PostReviewResolvedCode   = 3040
+	PostReportNotFoundCode   = 3041
+	PostReportSelfCode       = 3042
+	PostReportDuplicateCode  = 3043
+	PostCaseNotFoundCode     = 3044
+	PostReportResolvedCode   = 3045
+	PostRemovedCode          = 3046
+	PostAppealNotAllowedCode = 3047
+	PostAppealExistsCode     = 3048
+	PostAppealUpheldCode     = 3049
+	PostAppealNotFoundCode   = 3050
+	PostAppealResolvedCode   = 3051
+	PostAppealReviewerCode   = 3052
 )
 
 // 话题相关错误码 (4000-4999)
 const (
-	TopicNotFoundCode      = 4000
-	TopicCreateFailCode    = 4001
-	TopicUpdateFailCode    = 4002
-	TopicDeleteFailCode    = 4003
-	TopicNameEmptyCode     = 4004
-	TopicNameTooLongCode   = 4005
-	TopicAlreadyExistsCode = 4006
+	TopicNotFoundCode          = 4000
+	TopicCreateFailCode        = 4001
+	TopicUpdateFailCode        = 4002
+	TopicDeleteFailCode        = 4003
+	TopicNameEmptyCode         = 4004
+	TopicNameTooLongCode       = 4005
+	TopicAlreadyExistsCode     = 4006
+	TopicShareFailCode         = 4007
+	TopicCategoryNotFoundCode  = 4008
+	TopicSubscribeFailCode     = 4009
+	TopicAlreadySubscribedCode = 4010
+	TopicNotSubscribedCode     = 4011
 )
 
 // 评论相关错误码 (5000-5999)
@@ -77,6 +125,8 @@ const (
 	CommentContentEmptyCode     = 5004
 	CommentContentTooLongCode   = 5005
 	CommentPermissionDeniedCode = 5006
+	CommentContentRejectedCode  = 5007
+	CommentContentSpamCode      = 5008
 )
 
 // 点赞相关错误码 (6000-6999)
@@ -119,6 +169,7 @@ const (
 	FileSizeTooLargeCode     = 9003
 	FileTypeNotSupportedCode = 9004
 	FileNameInvalidCode      = 9005
+	FileUploadTicketCode     = 9006
 )
 
 // ErrorCodeMessage 错误码对应的消息
@@ -154,6 +205,12 @@ var ErrorCodeMessage = map[int32]string{
 	UserAlreadyFollowedCode:    "已经关注过该用户",
 	UserNotFollowedCode:        "未关注该用户",
 	UserProfileUpdateFailCode:  "用户信息更新失败",
+	UserBlockSelfCode:          "不能拉黑或屏蔽自己",
+	UserAlreadyBlockedCode:     "已经拉黑该用户",
+	UserNotBlockedCode:         "未拉黑该用户",
+	UserAlreadyMutedCode:       "已经屏蔽该用户",
+	UserNotMutedCode:           "未屏蔽该用户",
+	UserBlockedCode:            "由于对方的设置，无法进行此操作",
 
 	// 帖子相关错误
 	PostNotFoundCode:         "帖子不存在",
@@ -172,15 +229,57 @@ var ErrorCodeMessage = map[int32]string{
 	PostRatingNotFoundCode:   "评分记录不存在",
 	PostViewCountFailCode:    "浏览次数更新失败",
 	PostRatingRankFailCode:   "帖子排名获取失败",
+	PostPermissionDeniedCode: "无权限操作该帖子",
+	PostRevisionNotFoundCode: "帖子版本不存在",
+	PostRestoreFailCode:      "帖子恢复失败",
+	PostRestoreExpiredCode:   "帖子已超过可恢复期限",
+	PostDraftNotFoundCode:    "草稿不存在",
+	PostDraftConflictCode:    "草稿已在其他设备修改",
+	PostDraftSaveFailCode:    "草稿保存失败",
+	PostScheduleInvalidCode:  "定时发布时间无效",
+	PostNotScheduledCode:     "帖子已发布或未在定时发布中",
+	PostPollNotFoundCode:     "投票不存在",
+	PostPollClosedCode:       "投票已截止",
+	PostPollAlreadyVotedCode: "已经投过票",
+	PostPollNotVotedCode:     "尚未投票",
+	PostPollInvalidCode:      "投票选项无效",
+	PostPollVoteFailCode:     "投票失败",
+	PostTagNotFoundCode:      "标签不存在",
+	PostFolderNotFoundCode:   "收藏夹不存在",
+	PostFolderNameExistsCode: "收藏夹名称已存在",
+	PostFolderLimitCode:      "收藏夹数量已达上限",
+	PostFolderDefaultCode:    "默认收藏夹不能删除",
+	PostContentRejectedCode:  "内容包含违规信息",
+	PostContentSpamCode:      "请勿重复发布相似内容",
+	PostReviewingCode:        "帖子正在审核中",
+	PostReviewNotFoundCode:   "审核记录不存在",
+	PostReviewResolvedCode:   "审核记录已处理",
+	PostReportNotFoundCode:   "举报对象不存在",
+	PostReportSelfCode:       "不能举报自己",
+	PostReportDuplicateCode:  "已经举报过了",
+	PostCaseNotFoundCode:     "举报单不存在",
+	PostReportResolvedCode:   "举报单已处理",
+	PostRemovedCode:          "帖子已被隐藏或移除",
+	PostAppealNotAllowedCode: "当前状态不能申诉",
+	PostAppealExistsCode:     "申诉正在复核中",
+	PostAppealUpheldCode:     "申诉已被驳回",
+	PostAppealNotFoundCode:   "申诉不存在",
+	PostAppealResolvedCode:   "申诉已复核",
+	PostAppealReviewerCode:   "不能复核自己作出的处理",
 
 	// 话题相关错误
-	TopicNotFoundCode:      "话题不存在",
-	TopicCreateFailCode:    "话题创建失败",
-	TopicUpdateFailCode:    "话题更新失败",
-	TopicDeleteFailCode:    "话题删除失败",
-	TopicNameEmptyCode:     "话题名称不能为空",
-	TopicNameTooLongCode:   "话题名称过长",
-	TopicAlreadyExistsCode: "话题已存在",
+	TopicNotFoundCode:          "话题不存在",
+	TopicCreateFailCode:        "话题创建失败",
+	TopicUpdateFailCode:        "话题更新失败",
+	TopicDeleteFailCode:        "话题删除失败",
+	TopicNameEmptyCode:         "话题名称不能为空",
+	TopicNameTooLongCode:       "话题名称过长",
+	TopicAlreadyExistsCode:     "话题已存在",
+	TopicShareFailCode:         "话题分享失败",
+	TopicCategoryNotFoundCode:  "话题分类不存在",
+	TopicSubscribeFailCode:     "话题订阅失败",
+	TopicAlreadySubscribedCode: "已订阅该话题",
+	TopicNotSubscribedCode:     "未订阅该话题",
 
 	// 评论相关错误
 	CommentNotFoundCode:         "评论不存在",
@@ -190,6 +289,8 @@ var ErrorCodeMessage = map[int32]string{
 	CommentContentEmptyCode:     "评论内容不能为空",
 	CommentContentTooLongCode:   "评论内容过长",
 	CommentPermissionDeniedCode: "无权限操作该评论",
+	CommentContentRejectedCode:  "评论包含违规信息",
+	CommentContentSpamCode:      "请勿重复发布相似评论",
 
 	// 点赞相关错误
 	LikeNotFoundCode:      "点赞记录不存在",
@@ -224,6 +325,7 @@ var ErrorCodeMessage = map[int32]string{
 	FileSizeTooLargeCode:     "文件大小超限",
 	FileTypeNotSupportedCode: "文件类型不支持",
 	FileNameInvalidCode:      "文件名无效",
+	FileUploadTicketCode:     "上传凭证无效或已过期",
 }
 
 // GetErrorMessage 根据错误码获取错误消息
@@ -23,4 +23,10 @@ const (
 	MsgGetFollowCountFailed    = "获取关注数量失败"
 	MsgGetFollowerCountFailed  = "获取粉丝数量失败"
 	MsgGetMutualFollowsFailed  = "获取共同关注失败"
+	MsgBlockFailed             = "拉黑失败"
+	MsgUnblockFailed           = "取消拉黑失败"
+	MsgGetBlockListFailed      = "获取黑名单失败"
+	MsgMuteFailed              = "屏蔽失败"
+	MsgUnmuteFailed            = "取消屏蔽失败"
+	MsgGetMuteListFailed       = "获取屏蔽列表失败"
 )
@@ -25,4 +25,5 @@ const (
 const (
 	TargetTypePost    = "post"
 	TargetTypeComment = "comment"
+	TargetTypeUser    = "user"
 )
@@ -1,9 +1,12 @@
 package constants
 
+import "time"
+
 // 帖子服务特有的错误消息常量
 const (
 	MsgPostIDEmpty        = "帖子ID不能为空"
 	MsgTopicIDEmpty       = "话题ID不能为空"
+	MsgTagNameEmpty       = "标签名不能为空"
 	MsgKeywordEmpty       = "搜索关键词不能为空"
 	MsgCreatePostFailed   = "创建帖子失败"
 	MsgGetPostFailed      = "获取帖子失败"
@@ -24,8 +27,75 @@ const (
 	MsgUpdateRatingFailed = "更新评分失败"
 	MsgDeleteRatingFailed = "删除评分失败"
 	MsgGetRankFailed      = "获取排行榜失败"
+	MsgGetRevisionsFailed = "获取帖子历史版本失败"
+	MsgVersionInvalid     = "版本号无效"
+	MsgGetDeletedFailed   = "获取最近删除失败"
+	MsgRestorePostFailed  = "恢复帖子失败"
+	MsgRatingDistFailed   = "获取评分分布失败"
+	MsgGetRelatedFailed   = "获取相关帖子失败"
+	MsgSubscribeFailed    = "订阅话题失败"
+	MsgUnsubscribeFailed  = "取消订阅话题失败"
+	MsgGetSubTopicsFailed = "获取订阅话题失败"
+	MsgGetTopicFeedFailed = "获取订阅话题帖子失败"
+	MsgDraftIDEmpty       = "草稿ID不能为空"
+	MsgSaveDraftFailed    = "保存草稿失败"
+	MsgGetDraftFailed     = "获取草稿失败"
+	MsgDeleteDraftFailed  = "删除草稿失败"
+	MsgPublishDraftFailed = "发布草稿失败"
+	MsgGetHistoryFailed   = "获取浏览历史失败"
+	MsgClearHistoryFailed = "清空浏览历史失败"
+	MsgDelHistoryFailed   = "删除浏览历史失败"
+	MsgGetScheduledFailed = "获取定时发布帖子失败"
+	MsgRescheduleFailed   = "修改定时发布时间失败"
+	MsgCancelSchedFailed  = "取消定时发布失败"
+	MsgVotePollFailed     = "投票失败"
+	MsgChangeVoteFailed   = "修改投票失败"
+	MsgGetTrendTagsFailed = "获取热门标签失败"
+	MsgGetTagFailed       = "获取标签详情失败"
+	MsgFolderIDEmpty      = "收藏夹ID不能为空"
+	MsgGetFoldersFailed   = "获取收藏夹失败"
+	MsgCreateFolderFailed = "创建收藏夹失败"
+	MsgUpdateFolderFailed = "修改收藏夹失败"
+	MsgDeleteFolderFailed = "删除收藏夹失败"
+	MsgGetFolderPostsFail = "获取收藏夹帖子失败"
+	MsgMoveFavoritesFail  = "移动收藏失败"
+	MsgReviewIDEmpty      = "审核记录ID不能为空"
+	MsgGetReviewsFailed   = "获取审核队列失败"
+	MsgResolveReviewFail  = "处理审核失败"
+	MsgReportFailed       = "举报失败"
+	MsgReportCaseIDEmpty  = "举报单ID不能为空"
+	MsgGetReportsFailed   = "获取举报队列失败"
+	MsgResolveReportFail  = "处理举报失败"
+	MsgAppealFailed       = "申诉失败"
+	MsgAppealIDEmpty      = "申诉ID不能为空"
+	MsgGetAppealsFailed   = "获取申诉失败"
+	MsgResolveAppealFail  = "复核申诉失败"
+)
+
+// 回收站默认配置
+const (
+	DefaultTrashRetentionDays  = 30
+	DefaultTrashPurgeInterval  = time.Hour
+	DefaultTrashPurgeBatchSize = 100
 )
 
+// 草稿箱默认配置
+const (
+	DefaultDraftRetentionDays  = 90
+	DefaultDraftPurgeInterval  = time.Hour
+	DefaultDraftPurgeBatchSize = 500
+)
+
+// 定时发布默认配置
+const (
+	DefaultScheduleMaxDays   = 30
+	DefaultScheduleInterval  = 10 * time.Second
+	DefaultScheduleBatchSize = 100
+)
+
+// MaxMoveFavorites 单次移动收藏的最大帖子数
+const MaxMoveFavorites = 100
+
 type PostTopic int32
 
 const (
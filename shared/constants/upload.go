@@ -0,0 +1,12 @@
+package constants
+
+// 上传相关的错误消息常量
+const (
+	MsgStorageUnavailable = "图片存储不可用"
+	MsgCreateUploadFailed = "获取上传凭证失败"
+	MsgUploadFailed       = "上传失败"
+	MsgImageTypeInvalid   = "图片格式不支持，仅支持 jpeg、png、gif"
+	MsgImageTooLarge      = "图片大小超过限制"
+	MsgUploadTicketBad    = "上传凭证无效或已过期"
+	MsgFileNotFound       = "文件不存在"
+)
@@ -38,3 +38,9 @@ const (
 	MsgGetAnonymousFailed      = "获取匿名马甲失败"
 	MsgUpdateAnonymousFailed   = "更新匿名马甲失败"
 )
+
+// 用户角色
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
@@ -237,6 +237,40 @@ func ValidatePostCreation(userID, title, content string) []ValidationError {
 	return errors
 }
 
+// ValidateDraftSave 验证草稿保存参数，草稿允许标题和内容为空，但长度限制与发帖一致
+func ValidateDraftSave(userID, title, content string) []ValidationError {
+	var errors []ValidationError
+
+	// 验证用户ID
+	userIDValidator := &UserIDValidator{Required: true}
+	if err := userIDValidator.Validate(userID); err != nil {
+		if ve, ok := err.(ValidationError); ok {
+			ve.Field = "user_id"
+			errors = append(errors, ve)
+		}
+	}
+
+	// 验证标题
+	titleValidator := &StringValidator{MaxLength: 100}
+	if err := titleValidator.Validate(title); err != nil {
+		if ve, ok := err.(ValidationError); ok {
+			ve.Field = "title"
+			errors = append(errors, ve)
+		}
+	}
+
+	// 验证内容
+	contentValidator := &StringValidator{MaxLength: 10000}
+	if err := contentValidator.Validate(content); err != nil {
+		if ve, ok := err.(ValidationError); ok {
+			ve.Field = "content"
+			errors = append(errors, ve)
+		}
+	}
+
+	return errors
+}
+
 // ValidateFollowOperation 验证关注操作参数
 func ValidateFollowOperation(userID, targetUserID string) []ValidationError {
 	var errors []ValidationError
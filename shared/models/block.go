@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Block 拉黑关系，被拉黑的用户不能评论、评分、点赞拉黑者的内容，也不能关注拉黑者
+// 被拉黑用户的内容不出现在拉黑者的信息流和评论列表中，触发的通知也不再发给拉黑者
+type Block struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID    string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_block_pair" json:"user_id"` // 拉黑者
+	BlockedID string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_block_pair;index" json:"blocked_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (Block) TableName() string {
+	return "blocks"
+}
+
+// Mute 屏蔽关系，只在屏蔽者的信息流和评论列表中隐藏被屏蔽用户的内容
+type Mute struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID    string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_mute_pair" json:"user_id"` // 屏蔽者
+	MutedID   string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_mute_pair" json:"muted_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (Mute) TableName() string {
+	return "mutes"
+}
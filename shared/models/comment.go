@@ -5,6 +5,22 @@ import (
 	"time"
 )
 
+// CommentStatus 评论审核状态
+type CommentStatus uint8
+
+const (
+	CommentStatusNormal    CommentStatus = 0
+	CommentStatusReviewing CommentStatus = 1 // 内容审核中，不出现在评论列表，不计入评论数
+	CommentStatusRejected  CommentStatus = 2 // 内容审核未通过
+	CommentStatusHidden    CommentStatus = 3 // 被多人举报后自动隐藏，等待管理员处理
+	CommentStatusRemoved   CommentStatus = 4 // 被管理员移除
+)
+
+// NormalComments 只查询正常状态的评论，审核中、未通过、隐藏和移除的评论对列表和计数不可见
+func NormalComments(db *gorm.DB) *gorm.DB {
+	return db.Where("comments.status = ?", CommentStatusNormal)
+}
+
 type Comment struct {
 	ID             string         `gorm:"primaryKey;type:varchar(32)" json:"id"`
 	PostID         string         `gorm:"type:varchar(32);not null;index" json:"post_id"`
@@ -19,6 +35,7 @@ type Comment struct {
 	Images         StringArray    `gorm:"type:json" json:"images"`
 	IsDeleted      bool           `gorm:"default:false" json:"is_deleted"`
 	Location       *string        `gorm:"type:varchar(255)" json:"location"`
+	Status         CommentStatus  `gorm:"type:int;default:0" json:"status"`
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
 	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
@@ -30,3 +30,37 @@ func (s *StringArray) Scan(value interface{}) error {
 		return fmt.Errorf("cannot scan %T into StringArray", value)
 	}
 }
+
+// RatingHistogram 评分分布，下标 i 对应 i+1 分的评分人数
+type RatingHistogram []int32
+
+func (h RatingHistogram) Value() (driver.Value, error) {
+	if len(h) == 0 {
+		return "[]", nil
+	}
+	b, err := json.Marshal(h)
+	return string(b), err
+}
+
+func (h *RatingHistogram) Scan(value interface{}) error {
+	if value == nil {
+		*h = RatingHistogram{}
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, h)
+	case string:
+		return json.Unmarshal([]byte(v), h)
+	default:
+		return fmt.Errorf("cannot scan %T into RatingHistogram", value)
+	}
+}
+
+// Count 获取指定分值的评分人数
+func (h RatingHistogram) Count(score int32) int32 {
+	if score < 1 || int(score) > len(h) {
+		return 0
+	}
+	return h[score-1]
+}
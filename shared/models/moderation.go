@@ -0,0 +1,113 @@
+package models
+
+import "time"
+
+// 审核记录的人工复审状态
+const (
+	ReviewStatusNone     = ""         // 无需人工复审
+	ReviewStatusPending  = "pending"  // 等待复审
+	ReviewStatusApproved = "approved" // 复审通过
+	ReviewStatusRejected = "rejected" // 复审未通过
+)
+
+// ModerationRecord 内容审核记录，帖子和评论的每次审核决定都会记录，用于审计和人工复审
+type ModerationRecord struct {
+	ID           string      `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	TargetType   string      `gorm:"type:varchar(20);not null;index:idx_target" json:"target_type"`
+	TargetID     string      `gorm:"type:varchar(32);index:idx_target" json:"target_id"` // 被拒绝的内容没有创建，为空
+	UserID       string      `gorm:"type:varchar(32);not null;index" json:"user_id"`
+	Action       string      `gorm:"type:varchar(10);not null" json:"action"` // pass / mask / review / reject
+	Reason       string      `gorm:"type:varchar(20)" json:"reason"`          // sensitive / spam
+	Hits         StringArray `gorm:"type:json" json:"hits"`                   // 命中的敏感词
+	Simhash      string      `gorm:"type:varchar(16)" json:"simhash"`
+	Content      string      `gorm:"type:text" json:"content"` // 审核时的原始内容
+	ReviewStatus string      `gorm:"type:varchar(10);index:idx_review_created" json:"review_status"`
+	ReviewerID   *string     `gorm:"type:varchar(32)" json:"reviewer_id"`
+	ReviewedAt   *time.Time  `json:"reviewed_at"`
+	CreatedAt    time.Time   `gorm:"index:idx_review_created" json:"created_at"`
+}
+
+func (ModerationRecord) TableName() string {
+	return "moderation_records"
+}
+
+// 举报原因
+const (
+	ReportReasonSpam    = "spam"    // 垃圾广告
+	ReportReasonAbuse   = "abuse"   // 辱骂攻击
+	ReportReasonPorn    = "porn"    // 色情低俗
+	ReportReasonIllegal = "illegal" // 违法违规
+	ReportReasonOther   = "other"   // 其他
+)
+
+// ReportReasons 全部举报原因
+var ReportReasons = []string{ReportReasonSpam, ReportReasonAbuse, ReportReasonPorn, ReportReasonIllegal, ReportReasonOther}
+
+// 举报单的处理状态
+const (
+	ReportCasePending  = "pending"  // 等待处理
+	ReportCaseApproved = "approved" // 举报不成立，内容保留
+	ReportCaseRemoved  = "removed"  // 内容已移除
+	ReportCaseBanned   = "banned"   // 作者已封禁
+)
+
+// ReportCase 举报单，同一对象的举报合并为一个举报单，按举报人数进入管理员处理队列
+type ReportCase struct {
+	ID            string     `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	TargetType    string     `gorm:"type:varchar(20);not null;uniqueIndex:idx_report_target" json:"target_type"` // post / comment / user
+	TargetID      string     `gorm:"type:varchar(32);not null;uniqueIndex:idx_report_target" json:"target_id"`
+	TargetUserID  string     `gorm:"type:varchar(32);not null;index" json:"target_user_id"` // 被举报内容的作者，举报用户时为该用户
+	ReporterCount int32      `gorm:"default:0" json:"reporter_count"`                       // 不同举报人数
+	Hidden        bool       `gorm:"default:false" json:"hidden"`                           // 是否已因举报自动隐藏
+	Status        string     `gorm:"type:varchar(10);not null;index:idx_report_status" json:"status"`
+	HandlerID     *string    `gorm:"type:varchar(32)" json:"handler_id"`
+	HandledAt     *time.Time `json:"handled_at"`                    // 最近一次处理时间，处理过的举报单再次被举报时不再自动隐藏
+	Note          string     `gorm:"type:varchar(255)" json:"note"` // 处理说明，移除和封禁时随通知发给作者
+	CreatedAt     time.Time  `gorm:"index:idx_report_status" json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (ReportCase) TableName() string {
+	return "report_cases"
+}
+
+// Report 一次举报，每个用户对同一举报单只能举报一次
+type Report struct {
+	ID         string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	CaseID     string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_case_reporter" json:"case_id"`
+	ReporterID string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_case_reporter" json:"reporter_id"`
+	Reason     string    `gorm:"type:varchar(20);not null" json:"reason"`
+	Detail     string    `gorm:"type:varchar(500)" json:"detail"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (Report) TableName() string {
+	return "reports"
+}
+
+// 申诉状态
+const (
+	AppealStatusOpen       = "open"       // 等待复核
+	AppealStatusUpheld     = "upheld"     // 维持原处理
+	AppealStatusOverturned = "overturned" // 撤销原处理，内容或账号已恢复
+)
+
+// Appeal 被移除帖子的作者或被封禁、暂停使用的用户提交的申诉，由作出原处理以外的管理员复核
+type Appeal struct {
+	ID         string     `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID     string     `gorm:"type:varchar(32);not null;index:idx_appeal_target" json:"user_id"`
+	TargetType string     `gorm:"type:varchar(20);not null;index:idx_appeal_target" json:"target_type"` // post / user
+	TargetID   string     `gorm:"type:varchar(32);not null;index:idx_appeal_target" json:"target_id"`   // 申诉账号时为用户本人
+	Statement  string     `gorm:"type:text" json:"statement"`
+	Status     string     `gorm:"type:varchar(10);not null;index:idx_appeal_status" json:"status"`
+	DeciderID  *string    `gorm:"type:varchar(32)" json:"decider_id"` // 作出原处理的管理员，无法确定时为空
+	ReviewerID *string    `gorm:"type:varchar(32)" json:"reviewer_id"`
+	ReviewNote string     `gorm:"type:varchar(255)" json:"review_note"` // 复核说明，随通知发给用户
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	CreatedAt  time.Time  `gorm:"index:idx_appeal_status" json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func (Appeal) TableName() string {
+	return "appeals"
+}
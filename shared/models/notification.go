@@ -13,6 +13,7 @@ type Notification struct {
 	Content   string         `gorm:"type:text;not null" json:"content"`
 	Type      int32          `gorm:"not null;index" json:"type"` // 1: like, 2: comment, 3: follow
 	TargetID  string         `gorm:"type:varchar(32);not null" json:"target_id"`
+	SenderID  *string        `gorm:"type:varchar(32);index" json:"sender_id,omitempty"` // 触发通知的用户，系统通知为空
 	IsRead    bool           `gorm:"default:false" json:"is_read"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	kitex_gen_post "hupu/kitex_gen/post"
+)
+
+// 投票选项数量和长度限制
+const (
+	PollOptionMin       = 2
+	PollOptionMax       = 10
+	PollOptionMaxLength = 50
+)
+
+// Poll 帖子附带的投票，每个帖子最多一个
+type Poll struct {
+	ID          string        `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	PostID      string        `gorm:"type:varchar(32);not null;uniqueIndex" json:"post_id"`
+	Multiple    bool          `gorm:"default:false" json:"multiple"`
+	HideResults bool          `gorm:"default:false" json:"hide_results"` // 投票前隐藏结果，截止后对所有人可见
+	Deadline    *time.Time    `json:"deadline"`
+	VoterCount  int32         `gorm:"default:0" json:"voter_count"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	Options     []*PollOption `gorm:"-" json:"options"`
+}
+
+func (Poll) TableName() string {
+	return "polls"
+}
+
+// Closed 投票是否已截止
+func (p *Poll) Closed(now time.Time) bool {
+	return p.Deadline != nil && !now.Before(*p.Deadline)
+}
+
+// PollOption 投票选项，票数随投票在同一事务内维护
+type PollOption struct {
+	ID        string `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	PollID    string `gorm:"type:varchar(32);not null;index:idx_poll_position" json:"poll_id"`
+	Position  int32  `gorm:"not null;index:idx_poll_position" json:"position"`
+	Content   string `gorm:"type:varchar(100);not null" json:"content"`
+	VoteCount int32  `gorm:"default:0" json:"vote_count"`
+}
+
+func (PollOption) TableName() string {
+	return "poll_options"
+}
+
+// PollVote 用户选择的投票选项，多选时每个选项一条记录
+type PollVote struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	PollID    string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_poll_user_option" json:"poll_id"`
+	UserID    string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_poll_user_option;index" json:"user_id"`
+	OptionID  string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_poll_user_option" json:"option_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (PollVote) TableName() string {
+	return "poll_votes"
+}
+
+// PollToKitexPoll 转换为 RPC 投票结构，votedOptionIDs 为当前用户的选择
+// 设置了投票前隐藏结果时，只有已投票的用户或投票截止后才返回票数
+func PollToKitexPoll(poll *Poll, votedOptionIDs []string, now time.Time) *kitex_gen_post.Poll {
+	closed := poll.Closed(now)
+	visible := !poll.HideResults || closed || len(votedOptionIDs) > 0
+
+	options := make([]*kitex_gen_post.PollOption, 0, len(poll.Options))
+	for _, o := range poll.Options {
+		option := &kitex_gen_post.PollOption{
+			Id:      o.ID,
+			Content: o.Content,
+		}
+		if visible {
+			voteCount := o.VoteCount
+			option.VoteCount = &voteCount
+		}
+		options = append(options, option)
+	}
+
+	if votedOptionIDs == nil {
+		votedOptionIDs = []string{}
+	}
+	kitexPoll := &kitex_gen_post.Poll{
+		Id:             poll.ID,
+		Multiple:       poll.Multiple,
+		HideResults:    poll.HideResults,
+		Options:        options,
+		IsClosed:       closed,
+		ResultsVisible: visible,
+		VotedOptionIds: votedOptionIDs,
+	}
+	if poll.Deadline != nil {
+		deadline := poll.Deadline.Unix()
+		kitexPoll.Deadline = &deadline
+	}
+	if visible {
+		voterCount := poll.VoterCount
+		kitexPoll.VoterCount = &voterCount
+	}
+	return kitexPoll
+}
@@ -6,6 +6,7 @@ import (
 	"gorm.io/gorm"
 
 	kitex_gen_post "hupu/kitex_gen/post"
+	"hupu/shared/storage"
 )
 
 type PostCategory uint8
@@ -19,26 +20,101 @@ const (
 	PostCategoryOther   PostCategory = 5
 )
 
+// PostStatus 帖子发布状态
+type PostStatus uint8
+
+const (
+	PostStatusPublished PostStatus = 0
+	PostStatusScheduled PostStatus = 1 // 定时发布，到点前仅作者可见
+	PostStatusDraft     PostStatus = 2 // 取消定时发布后保留，仅作者可见
+	PostStatusReviewing PostStatus = 3 // 内容审核中，仅作者可见，审核通过后发布
+	PostStatusRejected  PostStatus = 4 // 内容审核未通过，仅作者可见
+	PostStatusHidden    PostStatus = 5 // 被多人举报后自动隐藏，等待管理员处理，仅作者可见
+	PostStatusRemoved   PostStatus = 6 // 被管理员移除，仅作者可见
+)
+
+// 评分分值范围
+const (
+	RatingScoreMin int32 = 1
+	RatingScoreMax int32 = 5
+)
+
 type Topic struct {
-	ID          string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
-	Name        string    `gorm:"type:varchar(100);not null;uniqueIndex" json:"name"`
-	Description *string   `gorm:"type:text" json:"description"`
-	PostCount   int32     `gorm:"default:0" json:"post_count"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID               string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	Name             string    `gorm:"type:varchar(100);not null;uniqueIndex" json:"name"`
+	Description      *string   `gorm:"type:text" json:"description"`
+	CategoryID       *string   `gorm:"type:varchar(32);index" json:"category_id"`
+	Icon             string    `gorm:"type:varchar(255)" json:"icon"`
+	Color            string    `gorm:"type:varchar(20)" json:"color"`
+	PostCount        int32     `gorm:"default:0" json:"post_count"`
+	ParticipantCount int32     `gorm:"default:0;index" json:"participant_count"` // 去重参与人数，由 HyperLogLog 估算
+	ShareCount       int32     `gorm:"default:0" json:"share_count"`
+	SubscriberCount  int32     `gorm:"default:0" json:"subscriber_count"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 func (Topic) TableName() string {
 	return "topics"
 }
 
+// TopicToKitexTopic 转换为 RPC 话题结构
+func TopicToKitexTopic(topic *Topic) *kitex_gen_post.Topic {
+	t := &kitex_gen_post.Topic{
+		Id:               topic.ID,
+		Name:             topic.Name,
+		Icon:             topic.Icon,
+		Color:            topic.Color,
+		ParticipantCount: topic.ParticipantCount,
+		PostCount:        topic.PostCount,
+		ShareCount:       topic.ShareCount,
+		SubscriberCount:  topic.SubscriberCount,
+		CreatedAt:        topic.CreatedAt.Unix(),
+		UpdatedAt:        topic.UpdatedAt.Unix(),
+	}
+	if topic.Description != nil {
+		t.Description = *topic.Description
+	}
+	if topic.CategoryID != nil {
+		t.CategoryId = *topic.CategoryID
+	}
+	return t
+}
+
+// TopicCategory 话题分类
+type TopicCategory struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex" json:"name"`
+	Icon      string    `gorm:"type:varchar(255)" json:"icon"`
+	SortOrder int32     `gorm:"default:0;index" json:"sort_order"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (TopicCategory) TableName() string {
+	return "topic_categories"
+}
+
+// TopicSubscription 用户订阅的话题
+type TopicSubscription struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID    string    `gorm:"type:varchar(32);not null;index:idx_user_created;uniqueIndex:idx_user_topic_subscription" json:"user_id"`
+	TopicID   string    `gorm:"type:varchar(32);not null;index;uniqueIndex:idx_user_topic_subscription" json:"topic_id"`
+	CreatedAt time.Time `gorm:"index:idx_user_created" json:"created_at"`
+}
+
+func (TopicSubscription) TableName() string {
+	return "topic_subscriptions"
+}
+
 type Post struct {
 	ID            string         `gorm:"primaryKey;type:varchar(32)" json:"id"`
 	UserID        string         `gorm:"type:varchar(32);not null;index:idx_user_created" json:"user_id"`
 	TopicID       *string        `gorm:"type:varchar(32);index" json:"topic_id"`
 	Title         string         `gorm:"type:varchar(255);not null" json:"title"`
 	Content       string         `gorm:"type:text;not null" json:"content"`
-	Images        StringArray    `gorm:"type:json" json:"images"`
+	Images        StringArray    `gorm:"type:json" json:"images"`     // 图片 key，接入对象存储前的旧数据为图片地址
+	Thumbnails    StringArray    `gorm:"type:json" json:"thumbnails"` // 九宫格缩略图 key，与 images 一一对应，未生成时为空
 	Category      PostCategory   `gorm:"type:int;default:0;index:idx_category_created,idx_category_hot" json:"category"`
 	IsAnonymous   bool           `gorm:"default:false;index:idx_anonymous_created" json:"is_anonymous"`
 	AnonymousName *string        `gorm:"type:varchar(50)" json:"anonymous_name"`
@@ -51,23 +127,68 @@ type Post struct {
 	IsTop         bool           `gorm:"default:false;index" json:"is_top"`
 	Location      *string        `gorm:"type:varchar(255)" json:"location"`
 	Tags          StringArray    `gorm:"type:json" json:"tags"`
+	EditedAt      *time.Time     `json:"edited_at"`
+	HasPoll       bool           `gorm:"default:false" json:"has_poll"`
+	Status        PostStatus     `gorm:"type:int;default:0;index:idx_status_publish" json:"status"`
+	PublishAt     *time.Time     `gorm:"index:idx_status_publish" json:"publish_at"` // 定时发布时间，发布后 created_at 更新为实际发布时间
 	CreatedAt     time.Time      `gorm:"index:idx_created_category,idx_user_created,idx_category_created,idx_anonymous_created,idx_category_hot" json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 评分聚合，随评分的增删改在同一事务内维护
+	RatingSum       int64           `gorm:"default:0" json:"rating_sum"`
+	RatingCount     int32           `gorm:"default:0" json:"rating_count"`
+	RatingHistogram RatingHistogram `gorm:"type:json" json:"rating_histogram"`
+
+	// 发帖时附带的投票，随帖子在同一事务内创建
+	Poll *Poll `gorm:"-" json:"-"`
 }
 
 func (Post) TableName() string {
 	return "posts"
 }
 
+// Published 只查询已发布的帖子，定时发布和已取消的帖子对列表和搜索不可见
+func Published(db *gorm.DB) *gorm.DB {
+	return db.Where("posts.status = ?", PostStatusPublished)
+}
+
+// AverageScore 平均评分，无评分时为0
+func (p *Post) AverageScore() float64 {
+	if p.RatingCount <= 0 {
+		return 0
+	}
+	return float64(p.RatingSum) / float64(p.RatingCount)
+}
+
+// ApplyRating 按一次评分变化更新聚合，oldScore 或 newScore 为0表示没有对应评分
+func (p *Post) ApplyRating(oldScore, newScore int32) {
+	if len(p.RatingHistogram) < int(RatingScoreMax) {
+		histogram := make(RatingHistogram, RatingScoreMax)
+		copy(histogram, p.RatingHistogram)
+		p.RatingHistogram = histogram
+	}
+	if oldScore > 0 {
+		p.RatingSum -= int64(oldScore)
+		p.RatingCount--
+		p.RatingHistogram[oldScore-1]--
+	}
+	if newScore > 0 {
+		p.RatingSum += int64(newScore)
+		p.RatingCount++
+		p.RatingHistogram[newScore-1]++
+	}
+}
+
 func PostToKitexPost(post *Post) *kitex_gen_post.Post {
-	return &kitex_gen_post.Post{
+	kitexPost := &kitex_gen_post.Post{
 		Id:            post.ID,
 		UserId:        post.UserID,
 		TopicId:       post.TopicID,
 		Title:         post.Title,
 		Content:       post.Content,
-		Images:        post.Images,
+		Images:        storage.ImageURLs(post.Images),
+		Thumbnails:    storage.ThumbnailURLs(post.Images, post.Thumbnails),
 		Category:      kitex_gen_post.PostCategory(post.Category),
 		IsAnonymous:   post.IsAnonymous,
 		AnonymousName: post.AnonymousName,
@@ -82,6 +203,97 @@ func PostToKitexPost(post *Post) *kitex_gen_post.Post {
 		Tags:          post.Tags,
 		CreatedAt:     post.CreatedAt.Unix(),
 		UpdatedAt:     post.UpdatedAt.Unix(),
+		IsEdited:      post.EditedAt != nil,
+		Score:         post.AverageScore(),
+		RatingCount:   post.RatingCount,
+		Status:        kitex_gen_post.PostStatus(post.Status),
+	}
+	if post.EditedAt != nil {
+		editedAt := post.EditedAt.Unix()
+		kitexPost.EditedAt = &editedAt
+	}
+	if post.Status != PostStatusPublished && post.PublishAt != nil {
+		publishAt := post.PublishAt.Unix()
+		kitexPost.PublishAt = &publishAt
+	}
+	return kitexPost
+}
+
+// PostRevision 帖子历史版本，每次编辑前保存一份旧内容
+type PostRevision struct {
+	ID        string       `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	PostID    string       `gorm:"type:varchar(32);not null;uniqueIndex:idx_post_version" json:"post_id"`
+	Version   int32        `gorm:"not null;uniqueIndex:idx_post_version" json:"version"`
+	EditorID  string       `gorm:"type:varchar(32);not null" json:"editor_id"`
+	TopicID   *string      `gorm:"type:varchar(32)" json:"topic_id"`
+	Title     string       `gorm:"type:varchar(255);not null" json:"title"`
+	Content   string       `gorm:"type:text;not null" json:"content"`
+	Images    StringArray  `gorm:"type:json" json:"images"`
+	Category  PostCategory `gorm:"type:int;default:0" json:"category"`
+	Location  *string      `gorm:"type:varchar(255)" json:"location"`
+	Tags      StringArray  `gorm:"type:json" json:"tags"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
+
+func PostRevisionToKitexPostRevision(revision *PostRevision) *kitex_gen_post.PostRevision {
+	return &kitex_gen_post.PostRevision{
+		Id:        revision.ID,
+		PostId:    revision.PostID,
+		Version:   revision.Version,
+		EditorId:  revision.EditorID,
+		Title:     revision.Title,
+		Content:   revision.Content,
+		Images:    storage.ImageURLs(revision.Images),
+		TopicId:   revision.TopicID,
+		Category:  kitex_gen_post.PostCategory(revision.Category),
+		Location:  revision.Location,
+		Tags:      revision.Tags,
+		CreatedAt: revision.CreatedAt.Unix(),
+	}
+}
+
+// PostDraft 帖子草稿，保存发帖请求的全部字段，发布后删除
+type PostDraft struct {
+	ID                 string       `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID             string       `gorm:"type:varchar(32);not null;index:idx_user_updated" json:"user_id"`
+	TopicID            *string      `gorm:"type:varchar(32)" json:"topic_id"`
+	Title              string       `gorm:"type:varchar(255)" json:"title"`
+	Content            string       `gorm:"type:text" json:"content"`
+	Images             StringArray  `gorm:"type:json" json:"images"`
+	Category           PostCategory `gorm:"type:int;default:0" json:"category"`
+	IsAnonymous        bool         `gorm:"default:false" json:"is_anonymous"`
+	AnonymousProfileID *string      `gorm:"type:varchar(32)" json:"anonymous_profile_id"`
+	Location           *string      `gorm:"type:varchar(255)" json:"location"`
+	Tags               StringArray  `gorm:"type:json" json:"tags"`
+	Version            int32        `gorm:"not null;default:1" json:"version"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `gorm:"index:idx_user_updated;index" json:"updated_at"`
+}
+
+func (PostDraft) TableName() string {
+	return "post_drafts"
+}
+
+func PostDraftToKitexPostDraft(draft *PostDraft) *kitex_gen_post.PostDraft {
+	return &kitex_gen_post.PostDraft{
+		Id:                 draft.ID,
+		UserId:             draft.UserID,
+		Title:              draft.Title,
+		Content:            draft.Content,
+		Images:             storage.ImageURLs(draft.Images),
+		TopicId:            draft.TopicID,
+		Category:           kitex_gen_post.PostCategory(draft.Category),
+		IsAnonymous:        draft.IsAnonymous,
+		Location:           draft.Location,
+		Tags:               draft.Tags,
+		AnonymousProfileId: draft.AnonymousProfileID,
+		Version:            draft.Version,
+		CreatedAt:          draft.CreatedAt.Unix(),
+		UpdatedAt:          draft.UpdatedAt.Unix(),
 	}
 }
 
@@ -89,7 +301,8 @@ type PostFavorite struct {
 	ID        string         `gorm:"primaryKey;type:varchar(32)" json:"id"`
 	UserID    string         `gorm:"type:varchar(32);not null;index:idx_user_created;uniqueIndex:idx_user_post_favorite" json:"user_id"`
 	PostID    string         `gorm:"type:varchar(32);not null;index;uniqueIndex:idx_user_post_favorite" json:"post_id"`
-	CreatedAt time.Time      `gorm:"index:idx_user_created" json:"created_at"`
+	FolderID  string         `gorm:"type:varchar(32);not null;default:'';index:idx_folder_created" json:"folder_id"` // 为空表示收藏夹功能上线前的收藏，创建默认收藏夹时归入
+	CreatedAt time.Time      `gorm:"index:idx_user_created;index:idx_folder_created" json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
@@ -98,6 +311,42 @@ func (PostFavorite) TableName() string {
 	return "post_favorites"
 }
 
+// 收藏夹限制
+const (
+	FavoriteFolderMax         = 50
+	FavoriteFolderNameMax     = 20
+	DefaultFavoriteFolderName = "默认收藏夹"
+)
+
+// FavoriteFolder 收藏夹，每个用户有一个默认收藏夹，在首次使用收藏夹时创建
+type FavoriteFolder struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	UserID    string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_user_folder_name" json:"user_id"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_user_folder_name" json:"name"`
+	IsPublic  bool      `gorm:"default:false" json:"is_public"`
+	IsDefault bool      `gorm:"default:false" json:"is_default"`
+	PostCount int32     `gorm:"-" json:"post_count"` // 收藏夹中已发布帖子的数量，查询时统计
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (FavoriteFolder) TableName() string {
+	return "favorite_folders"
+}
+
+func FavoriteFolderToKitexFolder(folder *FavoriteFolder) *kitex_gen_post.FavoriteFolder {
+	return &kitex_gen_post.FavoriteFolder{
+		Id:        folder.ID,
+		UserId:    folder.UserID,
+		Name:      folder.Name,
+		IsPublic:  folder.IsPublic,
+		IsDefault: folder.IsDefault,
+		PostCount: folder.PostCount,
+		CreatedAt: folder.CreatedAt.Unix(),
+		UpdatedAt: folder.UpdatedAt.Unix(),
+	}
+}
+
 type PostRating struct {
 	ID        string         `gorm:"primaryKey;type:varchar(32)" json:"id"`
 	UserID    string         `gorm:"type:varchar(32);not null;index;uniqueIndex:idx_user_post_rating" json:"user_id"`
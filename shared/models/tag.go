@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	kitex_gen_post "hupu/kitex_gen/post"
+)
+
+// TagMaxLength 规范化后标签的最大字数
+const TagMaxLength = 20
+
+// Tag 标签，名称为规范化后的形式
+type Tag struct {
+	ID        string    `gorm:"primaryKey;type:varchar(32)" json:"id"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex" json:"name"`
+	PostCount int32     `gorm:"default:0;index" json:"post_count"` // 使用该标签的已发布帖子数，随帖子在同一事务内维护
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// PostTag 帖子与标签的关联，帖子上的 tags 字段保留一份用于展示
+type PostTag struct {
+	PostID    string    `gorm:"primaryKey;type:varchar(32)" json:"post_id"`
+	TagID     string    `gorm:"primaryKey;type:varchar(32);index" json:"tag_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (PostTag) TableName() string {
+	return "post_tags"
+}
+
+// TaggedWith 只查询带有全部指定标签的帖子，names 须为规范化后的标签名
+// 标签名唯一，每个标签最多关联一行，不会产生重复的帖子
+func TaggedWith(names ...string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for i, name := range names {
+			db = db.Joins(fmt.Sprintf("JOIN post_tags pt%[1]d ON pt%[1]d.post_id = posts.id"+
+				" JOIN tags t%[1]d ON t%[1]d.id = pt%[1]d.tag_id AND t%[1]d.name = ?", i), name)
+		}
+		return db
+	}
+}
+
+// TaggedWithAnyCondition 帖子带有任一指定标签的查询条件，参数为规范化后的标签名列表
+// 通过 post_tags 子查询匹配，帖子命中多个标签时不会重复
+const TaggedWithAnyCondition = "posts.id IN (SELECT pta.post_id FROM post_tags pta JOIN tags ta ON ta.id = pta.tag_id WHERE ta.name IN ?)"
+
+// TaggedWithAny 只查询带有任一指定标签的帖子，names 须为规范化后的标签名
+func TaggedWithAny(names ...string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(TaggedWithAnyCondition, names)
+	}
+}
+
+func TagToKitexTag(tag *Tag) *kitex_gen_post.Tag {
+	return &kitex_gen_post.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		PostCount: tag.PostCount,
+		CreatedAt: tag.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,318 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+)
+
+// CreateAppeal 对被移除、复审未通过的帖子或被封禁、暂停使用的账号提交申诉，申诉账号时 targetID 为用户本人
+// 同一对象同时只能有一个待复核的申诉，申诉被驳回后不能再次申诉，除非之后又被重新处理
+func (m *Moderator) CreateAppeal(ctx context.Context, userID, targetType, targetID, statement string) (*models.Appeal, error) {
+	var appeal models.Appeal
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定申诉人，同一用户的申诉串行创建
+		var user models.User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").Where("id = ?", userID).First(&user).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("appeal target not found")
+			}
+			return err
+		}
+
+		switch targetType {
+		case constants.TargetTypePost:
+			var post models.Post
+			err := tx.Select("id", "user_id", "status").Where("id = ?", targetID).First(&post).Error
+			if err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return fmt.Errorf("appeal target not found")
+				}
+				return err
+			}
+			if post.UserID != userID || (post.Status != models.PostStatusRemoved && post.Status != models.PostStatusRejected) {
+				return fmt.Errorf("appeal not allowed")
+			}
+		case constants.TargetTypeUser:
+			if targetID != userID || (user.Status != models.UserStatusBanned && user.Status != models.UserStatusSuspended) {
+				return fmt.Errorf("appeal not allowed")
+			}
+		default:
+			return fmt.Errorf("invalid target type")
+		}
+
+		deciderID, decidedAt, err := findDecision(tx, targetType, targetID)
+		if err != nil {
+			return err
+		}
+
+		var last models.Appeal
+		err = tx.Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+			Order("created_at DESC").First(&last).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		if err == nil {
+			if last.Status == models.AppealStatusOpen {
+				return fmt.Errorf("appeal already exists")
+			}
+			if last.Status == models.AppealStatusUpheld && (decidedAt == nil || last.ReviewedAt == nil || last.ReviewedAt.After(*decidedAt)) {
+				return fmt.Errorf("appeal already upheld")
+			}
+		}
+
+		now := time.Now()
+		appeal = models.Appeal{
+			ID:         xid.New().String(),
+			UserID:     userID,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Statement:  statement,
+			Status:     models.AppealStatusOpen,
+			DeciderID:  deciderID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		if err := tx.Create(&appeal).Error; err != nil {
+			return err
+		}
+		subject := appealSubject(tx, &appeal)
+		return notify(tx, userID, "申诉已提交", fmt.Sprintf("你对%s的申诉已提交，我们会尽快复核", subject), appeal.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &appeal, nil
+}
+
+// findDecision 查找作出原处理的管理员和处理时间，找不到时(如后台直接暂停账号)返回空
+// 帖子可能由举报处理移除，也可能在内容复审中被拒绝，取两者中最近的一次
+func findDecision(tx *gorm.DB, targetType, targetID string) (*string, *time.Time, error) {
+	var reportCase models.ReportCase
+	query := tx.Model(&models.ReportCase{})
+	if targetType == constants.TargetTypePost {
+		query = query.Where("target_type = ? AND target_id = ? AND status IN ?",
+			targetType, targetID, []string{models.ReportCaseRemoved, models.ReportCaseBanned})
+	} else {
+		query = query.Where("target_user_id = ? AND status = ?", targetID, models.ReportCaseBanned)
+	}
+	var deciderID *string
+	var decidedAt *time.Time
+	err := query.Order("handled_at DESC").First(&reportCase).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, nil, err
+	}
+	if err == nil {
+		deciderID, decidedAt = reportCase.HandlerID, reportCase.HandledAt
+	}
+	if targetType != constants.TargetTypePost {
+		return deciderID, decidedAt, nil
+	}
+
+	var record models.ModerationRecord
+	err = tx.Where("target_type = ? AND target_id = ? AND review_status = ?", targetType, targetID, models.ReviewStatusRejected).
+		Order("reviewed_at DESC").First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return deciderID, decidedAt, nil
+		}
+		return nil, nil, err
+	}
+	if decidedAt == nil || (record.ReviewedAt != nil && record.ReviewedAt.After(*decidedAt)) {
+		deciderID, decidedAt = record.ReviewerID, record.ReviewedAt
+	}
+	return deciderID, decidedAt, nil
+}
+
+// appealSubject 通知中描述申诉对象
+func appealSubject(tx *gorm.DB, appeal *models.Appeal) string {
+	if appeal.TargetType != constants.TargetTypePost {
+		return "账号"
+	}
+	var post models.Post
+	if err := tx.Unscoped().Select("id", "title").Where("id = ?", appeal.TargetID).First(&post).Error; err != nil {
+		return "帖子"
+	}
+	return fmt.Sprintf("帖子《%s》", post.Title)
+}
+
+// ListAppeals 分页获取申诉，待复核的按提交时间先后排列，其他状态按复核时间倒序
+func (m *Moderator) ListAppeals(ctx context.Context, status string, page, pageSize int) ([]*models.Appeal, int64, error) {
+	var appeals []*models.Appeal
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&models.Appeal{}).Where("status = ?", status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "reviewed_at DESC, id DESC"
+	if status == models.AppealStatusOpen {
+		order = "created_at ASC, id ASC"
+	}
+	err := query.Order(order).Offset((page - 1) * pageSize).Limit(pageSize).Find(&appeals).Error
+	return appeals, total, err
+}
+
+// ListUserAppeals 分页获取用户自己的申诉，按提交时间倒序
+func (m *Moderator) ListUserAppeals(ctx context.Context, userID string, page, pageSize int) ([]*models.Appeal, int64, error) {
+	var appeals []*models.Appeal
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&models.Appeal{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC, id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&appeals).Error
+	return appeals, total, err
+}
+
+// ResolveAppeal 复核申诉，复核人不能是作出原处理的管理员或申诉人本人
+// 撤销原处理时恢复帖子或账号，无论结果如何都通知申诉人，note 为复核说明
+// 返回内容被恢复的帖子，用于刷新帖子的缓存、热度和索引
+func (m *Moderator) ResolveAppeal(ctx context.Context, appealID, reviewerID string, overturn bool, note string) (*models.Appeal, []string, error) {
+	var appeal models.Appeal
+	var restoredPostIDs []string
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", appealID).First(&appeal).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("appeal not found")
+			}
+			return err
+		}
+		if appeal.Status != models.AppealStatusOpen {
+			return fmt.Errorf("appeal already resolved")
+		}
+		if reviewerID == appeal.UserID || (appeal.DeciderID != nil && *appeal.DeciderID == reviewerID) {
+			return fmt.Errorf("reviewer made the original decision")
+		}
+		// 申诉创建后原处理可能被重新作出，按当前的处理人再校验一次
+		deciderID, _, err := findDecision(tx, appeal.TargetType, appeal.TargetID)
+		if err != nil {
+			return err
+		}
+		if deciderID != nil && *deciderID == reviewerID {
+			return fmt.Errorf("reviewer made the original decision")
+		}
+
+		subject := appealSubject(tx, &appeal)
+		title, content := "申诉未通过", fmt.Sprintf("你对%s的申诉未通过，维持原处理", subject)
+		appeal.Status = models.AppealStatusUpheld
+		if overturn {
+			restoredPostIDs, err = restoreTarget(tx, &appeal)
+			if err != nil {
+				return err
+			}
+			title, content = "申诉已通过", fmt.Sprintf("你对%s的申诉已通过，已恢复正常", subject)
+			appeal.Status = models.AppealStatusOverturned
+		}
+
+		now := time.Now()
+		appeal.ReviewerID = &reviewerID
+		appeal.ReviewNote = note
+		appeal.ReviewedAt = &now
+		appeal.UpdatedAt = now
+		if err := tx.Model(&models.Appeal{}).Where("id = ?", appeal.ID).Updates(map[string]interface{}{
+			"status":      appeal.Status,
+			"reviewer_id": reviewerID,
+			"review_note": note,
+			"reviewed_at": now,
+			"updated_at":  now,
+		}).Error; err != nil {
+			return err
+		}
+		return notify(tx, appeal.UserID, title, withNote(content, note), appeal.ID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &appeal, restoredPostIDs, nil
+}
+
+// restoreTarget 撤销原处理，帖子恢复发布，账号恢复正常并恢复随封禁一起移除的内容
+// 对应的举报单改为举报不成立、复审记录改为通过，之后再被举报时重新进入处理队列
+// 返回内容被恢复的帖子
+func restoreTarget(tx *gorm.DB, appeal *models.Appeal) ([]string, error) {
+	if appeal.TargetType == constants.TargetTypeUser {
+		if err := tx.Model(&models.User{}).
+			Where("id = ? AND status IN ?", appeal.TargetID, []models.UserStatus{models.UserStatusBanned, models.UserStatusSuspended}).
+			Updates(map[string]interface{}{
+				"status":     models.UserStatusActive,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return nil, err
+		}
+		return restoreBannedContent(tx, appeal.TargetID)
+	}
+
+	if _, err := setPostStatus(tx, appeal.TargetID,
+		[]models.PostStatus{models.PostStatusRemoved, models.PostStatusRejected}, models.PostStatusPublished); err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&models.ModerationRecord{}).
+		Where("target_type = ? AND target_id = ? AND review_status = ?", constants.TargetTypePost, appeal.TargetID, models.ReviewStatusRejected).
+		Update("review_status", models.ReviewStatusApproved).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&models.ReportCase{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", constants.TargetTypePost, appeal.TargetID, models.ReportCaseRemoved).
+		Updates(map[string]interface{}{
+			"status":     models.ReportCaseApproved,
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+		return nil, err
+	}
+	return []string{appeal.TargetID}, nil
+}
+
+// restoreBannedContent 撤销封禁时恢复处理举报封禁用户时一起移除的帖子和评论，对应的举报单改为举报不成立
+// 返回内容被恢复的帖子（评论返回所属的帖子）
+func restoreBannedContent(tx *gorm.DB, userID string) ([]string, error) {
+	var cases []*models.ReportCase
+	if err := tx.Where("target_user_id = ? AND status = ?", userID, models.ReportCaseBanned).Find(&cases).Error; err != nil {
+		return nil, err
+	}
+	if len(cases) == 0 {
+		return nil, nil
+	}
+
+	caseIDs := make([]string, 0, len(cases))
+	var postIDs []string
+	for _, c := range cases {
+		caseIDs = append(caseIDs, c.ID)
+		switch c.TargetType {
+		case constants.TargetTypePost:
+			post, err := setPostStatus(tx, c.TargetID, []models.PostStatus{models.PostStatusRemoved}, models.PostStatusPublished)
+			if err != nil {
+				return nil, err
+			}
+			if post != nil {
+				postIDs = append(postIDs, post.ID)
+			}
+		case constants.TargetTypeComment:
+			comment, err := setCommentStatus(tx, c.TargetID, []models.CommentStatus{models.CommentStatusRemoved}, models.CommentStatusNormal)
+			if err != nil {
+				return nil, err
+			}
+			if comment != nil {
+				postIDs = append(postIDs, comment.PostID)
+			}
+		}
+	}
+
+	err := tx.Model(&models.ReportCase{}).Where("id IN ?", caseIDs).Updates(map[string]interface{}{
+		"status":     models.ReportCaseApproved,
+		"updated_at": time.Now(),
+	}).Error
+	return postIDs, err
+}
@@ -0,0 +1,183 @@
+package moderation
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+)
+
+// notificationTypeSystem 对应 notification.thrift 的 SYSTEM
+const notificationTypeSystem = 7
+
+// setPostStatus 在事务内将处于 from 状态的帖子改为 to 状态，帖子不存在或不处于 from 状态时返回 nil
+// 帖子进入或离开已发布状态时同步调整话题和标签的帖子数
+func setPostStatus(tx *gorm.DB, postID string, from []models.PostStatus, to models.PostStatus) (*models.Post, error) {
+	var post models.Post
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND status IN ?", postID, from).
+		First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Model(&models.Post{}).Where("id = ?", postID).Updates(map[string]interface{}{
+		"status":     to,
+		"updated_at": time.Now(),
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	delta := 0
+	switch {
+	case post.Status == models.PostStatusPublished && to != models.PostStatusPublished:
+		delta = -1
+	case post.Status != models.PostStatusPublished && to == models.PostStatusPublished:
+		delta = 1
+	}
+	if delta != 0 {
+		if err := adjustPostCounts(tx, &post, delta); err != nil {
+			return nil, err
+		}
+	}
+	post.Status = to
+	return &post, nil
+}
+
+// adjustPostCounts 调整帖子所属话题和标签的帖子数
+func adjustPostCounts(tx *gorm.DB, post *models.Post, delta int) error {
+	if post.TopicID != nil && *post.TopicID != "" {
+		query := tx.Model(&models.Topic{}).Where("id = ?", *post.TopicID)
+		if delta < 0 {
+			query = query.Where("post_count > 0")
+		}
+		if err := query.Update("post_count", gorm.Expr("post_count + ?", delta)).Error; err != nil {
+			return err
+		}
+	}
+
+	var tagIDs []string
+	if err := tx.Model(&models.PostTag{}).Where("post_id = ?", post.ID).Pluck("tag_id", &tagIDs).Error; err != nil {
+		return err
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	query := tx.Model(&models.Tag{}).Where("id IN ?", tagIDs)
+	if delta < 0 {
+		query = query.Where("post_count > 0")
+	}
+	return query.UpdateColumn("post_count", gorm.Expr("post_count + ?", delta)).Error
+}
+
+// setCommentStatus 在事务内将处于 from 状态的评论改为 to 状态，评论不存在或不处于 from 状态时返回 nil
+// 评论进入或离开正常状态时同步调整帖子评论数和父评论回复数
+func setCommentStatus(tx *gorm.DB, commentID string, from []models.CommentStatus, to models.CommentStatus) (*models.Comment, error) {
+	var comment models.Comment
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND status IN ?", commentID, from).
+		First(&comment).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Model(&models.Comment{}).Where("id = ?", commentID).Update("status", to).Error; err != nil {
+		return nil, err
+	}
+
+	delta := 0
+	switch {
+	case comment.Status == models.CommentStatusNormal && to != models.CommentStatusNormal:
+		delta = -1
+	case comment.Status != models.CommentStatusNormal && to == models.CommentStatusNormal:
+		delta = 1
+	}
+	if delta != 0 {
+		query := tx.Model(&models.Post{}).Where("id = ?", comment.PostID)
+		if delta < 0 {
+			query = query.Where("comment_count > 0")
+		}
+		if err := query.UpdateColumn("comment_count", gorm.Expr("comment_count + ?", delta)).Error; err != nil {
+			return nil, err
+		}
+		if comment.ParentID != nil && *comment.ParentID != "" {
+			query := tx.Model(&models.Comment{}).Where("id = ?", *comment.ParentID)
+			if delta < 0 {
+				query = query.Where("reply_count > 0")
+			}
+			if err := query.UpdateColumn("reply_count", gorm.Expr("reply_count + ?", delta)).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+	comment.Status = to
+	return &comment, nil
+}
+
+// setUserStatus 在事务内修改用户状态
+func setUserStatus(tx *gorm.DB, userID string, status models.UserStatus) error {
+	return tx.Model(&models.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// notify 在事务内给用户发送系统通知
+func notify(tx *gorm.DB, userID, title, content, targetID string) error {
+	now := time.Now()
+	return tx.Create(&models.Notification{
+		ID:        xid.New().String(),
+		UserID:    userID,
+		Title:     title,
+		Content:   content,
+		Type:      notificationTypeSystem,
+		TargetID:  targetID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}).Error
+}
+
+// excerpt 截取内容开头用于通知，超出部分以省略号代替
+func excerpt(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n]) + "…"
+}
+
+// UserRestricted 用户是否被封禁或暂停使用
+// 被限制的用户仍可登录，但只能查看内容、查看通知和提交申诉，所有写操作都应通过 RestrictedCode 拒绝
+func UserRestricted(db *gorm.DB, userID string) (bool, error) {
+	var user models.User
+	err := db.Select("id", "status").Where("id = ?", userID).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return user.Status == models.UserStatusBanned || user.Status == models.UserStatusSuspended, nil
+}
+
+// RestrictedCode 写操作前校验账号状态，返回成功码、账号受限错误码或数据库错误码
+func RestrictedCode(db *gorm.DB, userID string) int32 {
+	restricted, err := UserRestricted(db, userID)
+	if err != nil {
+		return constants.DatabaseErrorCode
+	}
+	if restricted {
+		return constants.UserAccountDisabledCode
+	}
+	return constants.SuccessCode
+}
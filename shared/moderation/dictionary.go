@@ -0,0 +1,160 @@
+package moderation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"hupu/shared/config"
+	"hupu/shared/log"
+)
+
+const DefaultReloadInterval = 30 * time.Second
+
+// reloadInterval 词库变更检查间隔
+func reloadInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.ReloadIntervalSec > 0 {
+		return time.Duration(config.GlobalConfig.Moderation.ReloadIntervalSec) * time.Second
+	}
+	return DefaultReloadInterval
+}
+
+// ParseRules 解析词库，每行一条 "词语|动作"，动作省略时为 mask，# 开头的行为注释
+func ParseRules(r io.Reader) ([]*Rule, error) {
+	var rules []*Rule
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		word, action, _ := strings.Cut(line, "|")
+		rule := &Rule{Word: strings.TrimSpace(word), Action: ActionMask}
+		if action = strings.TrimSpace(action); action != "" {
+			rule.Action = Action(action)
+		}
+		if rule.Word == "" {
+			return nil, fmt.Errorf("line %d: empty word", lineNo)
+		}
+		if rule.Action != ActionMask && rule.Action != ActionReview && rule.Action != ActionReject {
+			return nil, fmt.Errorf("line %d: unknown action %q", lineNo, action)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
+
+// Dictionary 敏感词词库，文件变更后由 StartReloader 重新加载，加载失败时沿用旧词库
+type Dictionary struct {
+	path    string
+	matcher atomic.Pointer[matcher]
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// NewDictionary 从文件加载词库，加载失败时记录日志并使用空词库
+func NewDictionary(path string) *Dictionary {
+	d := &Dictionary{path: path}
+	d.matcher.Store(newMatcher(nil))
+	if _, err := d.Reload(); err != nil {
+		log.GetLogger().Errorf("load sensitive words %s failed: %v", path, err)
+	}
+	return d
+}
+
+// NewDictionaryFromRules 使用给定规则创建词库，不关联文件
+func NewDictionaryFromRules(rules []*Rule) *Dictionary {
+	d := &Dictionary{}
+	d.matcher.Store(newMatcher(rules))
+	return d
+}
+
+var (
+	defaultDict     *Dictionary
+	defaultDictOnce sync.Once
+)
+
+// DefaultDictionary 按配置加载的词库，进程内共享
+func DefaultDictionary() *Dictionary {
+	defaultDictOnce.Do(func() {
+		path := ""
+		if config.GlobalConfig != nil {
+			path = config.GlobalConfig.Moderation.DictPath
+		}
+		if path == "" {
+			defaultDict = NewDictionaryFromRules(nil)
+			return
+		}
+		defaultDict = NewDictionary(path)
+	})
+	return defaultDict
+}
+
+// Reload 文件的修改时间或大小变化时重新加载，返回是否已重新加载
+func (d *Dictionary) Reload() (bool, error) {
+	if d.path == "" {
+		return false, nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	fi, err := os.Stat(d.path)
+	if err != nil {
+		return false, err
+	}
+	if fi.ModTime().Equal(d.modTime) && fi.Size() == d.size {
+		return false, nil
+	}
+
+	f, err := os.Open(d.path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	rules, err := ParseRules(f)
+	if err != nil {
+		return false, err
+	}
+
+	d.matcher.Store(newMatcher(rules))
+	d.modTime, d.size = fi.ModTime(), fi.Size()
+	log.GetLogger().Infof("loaded %d sensitive words from %s", len(rules), d.path)
+	return true, nil
+}
+
+// Match 查找文本中的敏感词
+func (d *Dictionary) Match(text string) []*Hit {
+	return d.matcher.Load().match(text)
+}
+
+// StartReloader 定时检查词库文件并重新加载，ctx取消时退出
+func (d *Dictionary) StartReloader(ctx context.Context) {
+	if d.path == "" {
+		return
+	}
+	ticker := time.NewTicker(reloadInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := d.Reload(); err != nil {
+			log.GetLogger().Errorf("reload sensitive words %s failed: %v", d.path, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package moderation
+
+import (
+	"unicode"
+)
+
+// Rule 一条敏感词规则
+type Rule struct {
+	Word   string
+	Action Action
+}
+
+// Hit 一次敏感词命中，Start 和 End 为原文中的字符下标，左闭右开
+type Hit struct {
+	Start int
+	End   int
+	Rule  *Rule
+}
+
+// acNode Aho-Corasick 自动机的节点
+type acNode struct {
+	next  map[rune]int32
+	fail  int32
+	out   []int32 // 在该节点结束的规则下标，已合并 fail 链上的输出
+	depth int32
+}
+
+// matcher 敏感词自动机，构建后只读，可并发使用
+type matcher struct {
+	nodes   []acNode
+	rules   []*Rule
+	lengths []int // 规则中参与匹配的字符数
+}
+
+// foldRune 匹配前统一字符形式：全角转半角、转为小写
+func foldRune(r rune) rune {
+	switch {
+	case r == '　':
+		r = ' '
+	case r >= '！' && r <= '～':
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+// significant 是否参与匹配，标点、空白和符号被跳过，避免用分隔符绕过敏感词
+func significant(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// newMatcher 构建自动机，规范化后为空的词条被忽略
+func newMatcher(rules []*Rule) *matcher {
+	m := &matcher{nodes: []acNode{{next: map[rune]int32{}}}}
+	for _, rule := range rules {
+		state := int32(0)
+		for _, r := range rule.Word {
+			r = foldRune(r)
+			if !significant(r) {
+				continue
+			}
+			next, ok := m.nodes[state].next[r]
+			if !ok {
+				next = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{next: map[rune]int32{}, depth: m.nodes[state].depth + 1})
+				m.nodes[state].next[r] = next
+			}
+			state = next
+		}
+		if state == 0 {
+			continue
+		}
+		m.nodes[state].out = append(m.nodes[state].out, int32(len(m.rules)))
+		m.rules = append(m.rules, rule)
+		m.lengths = append(m.lengths, int(m.nodes[state].depth))
+	}
+
+	// 按层构建 fail 指针
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[state].next {
+			fail := m.nodes[state].fail
+			for fail > 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if target, ok := m.nodes[fail].next[r]; ok && target != child {
+				m.nodes[child].fail = target
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// match 查找文本中的全部命中，匹配时跳过不参与匹配的字符
+func (m *matcher) match(text string) []*Hit {
+	if m == nil || len(m.rules) == 0 {
+		return nil
+	}
+
+	// positions 记录参与匹配的字符在原文中的下标
+	runes := []rune(text)
+	positions := make([]int, 0, len(runes))
+	var hits []*Hit
+	state := int32(0)
+	for i, r := range runes {
+		r = foldRune(r)
+		if !significant(r) {
+			continue
+		}
+		positions = append(positions, i)
+
+		for state > 0 {
+			if _, ok := m.nodes[state].next[r]; ok {
+				break
+			}
+			state = m.nodes[state].fail
+		}
+		state = m.nodes[state].next[r]
+
+		for _, idx := range m.nodes[state].out {
+			hits = append(hits, &Hit{
+				Start: positions[len(positions)-m.lengths[idx]],
+				End:   i + 1,
+				Rule:  m.rules[idx],
+			})
+		}
+	}
+	return hits
+}
@@ -0,0 +1,278 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+// Action 审核动作，按严重程度从低到高
+type Action string
+
+const (
+	ActionPass   Action = "pass"   // 直接通过
+	ActionMask   Action = "mask"   // 敏感词替换为*后通过
+	ActionReview Action = "review" // 送人工复审，复审通过前仅作者可见
+	ActionReject Action = "reject" // 拒绝发布
+)
+
+// 审核原因
+const (
+	ReasonSensitive = "sensitive" // 命中敏感词
+	ReasonSpam      = "spam"      // 与近期内容重复
+)
+
+const (
+	DefaultSpamWindow       = time.Hour
+	DefaultSpamUserRepeat   = 3
+	DefaultSpamGlobalRepeat = 5
+	DefaultSimhashDistance  = 6
+
+	spamUserKeyPrefix = "moderation:simhash:user:"
+	spamGlobalKey     = "moderation:simhash:global"
+	spamUserKeep      = 50   // 每个用户保留的最近指纹数
+	spamGlobalKeep    = 2000 // 全站保留的最近指纹数
+)
+
+// severity 动作的严重程度，多条规则命中时取最严重的
+var severity = map[Action]int{
+	ActionPass:   0,
+	ActionMask:   1,
+	ActionReview: 2,
+	ActionReject: 3,
+}
+
+// spamWindow 重复内容检测窗口
+func spamWindow() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.SpamWindowMin > 0 {
+		return time.Duration(config.GlobalConfig.Moderation.SpamWindowMin) * time.Minute
+	}
+	return DefaultSpamWindow
+}
+
+// spamUserRepeat 同一用户窗口内已发布相似内容达到该次数时拒绝
+func spamUserRepeat() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.SpamUserRepeat > 0 {
+		return config.GlobalConfig.Moderation.SpamUserRepeat
+	}
+	return DefaultSpamUserRepeat
+}
+
+// spamGlobalRepeat 全站窗口内已出现相似内容达到该次数时送审
+func spamGlobalRepeat() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.SpamGlobalRepeat > 0 {
+		return config.GlobalConfig.Moderation.SpamGlobalRepeat
+	}
+	return DefaultSpamGlobalRepeat
+}
+
+// simhashDistance 海明距离不超过该值视为相似
+func simhashDistance() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.SimhashDistance > 0 {
+		return config.GlobalConfig.Moderation.SimhashDistance
+	}
+	return DefaultSimhashDistance
+}
+
+// Content 待审核的内容
+type Content struct {
+	TargetType string // constants.TargetTypePost / constants.TargetTypeComment
+	UserID     string
+	Title      string // 评论没有标题
+	Body       string
+}
+
+// text 参与重复检测和审计的完整文本
+func (c *Content) text() string {
+	if c.Title == "" {
+		return c.Body
+	}
+	return c.Title + "\n" + c.Body
+}
+
+// Decision 审核决定，Title 和 Body 为处理后的内容，动作为 mask 时敏感词已替换为*
+type Decision struct {
+	Action  Action
+	Reason  string
+	Hits    []string
+	Title   string
+	Body    string
+	Simhash uint64
+
+	content *Content
+}
+
+// Moderator 帖子和评论共用的审核流水线：敏感词匹配后按规则拒绝、打码或送审，并检测近似重复的垃圾内容
+type Moderator struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+	dict  *Dictionary
+}
+
+// NewModerator 创建审核器，使用按配置加载的词库
+func NewModerator(db *gorm.DB, redisClient *utils.RedisClient) *Moderator {
+	return &Moderator{db: db, redis: redisClient, dict: DefaultDictionary()}
+}
+
+// CheckText 只做敏感词审核，用于编辑已有内容
+func (m *Moderator) CheckText(c *Content) *Decision {
+	d := &Decision{Action: ActionPass, Title: c.Title, Body: c.Body, content: c}
+	titleHits := m.dict.Match(c.Title)
+	bodyHits := m.dict.Match(c.Body)
+
+	seen := make(map[string]bool)
+	for _, hit := range append(titleHits, bodyHits...) {
+		if severity[hit.Rule.Action] > severity[d.Action] {
+			d.Action = hit.Rule.Action
+		}
+		if !seen[hit.Rule.Word] {
+			seen[hit.Rule.Word] = true
+			d.Hits = append(d.Hits, hit.Rule.Word)
+		}
+	}
+	if len(d.Hits) > 0 {
+		d.Reason = ReasonSensitive
+	}
+	// 送审和通过的内容同样打码，复审通过后展示的也是打码后的内容
+	if d.Action != ActionReject {
+		d.Title = mask(c.Title, titleHits)
+		d.Body = mask(c.Body, bodyHits)
+	}
+	return d
+}
+
+// Clean 文本不含任何级别的敏感词，用于热搜等直接公开展示的用户输入
+func (m *Moderator) Clean(text string) bool {
+	return len(m.dict.Match(text)) == 0
+}
+
+// Check 敏感词审核后做重复检测：同一用户反复发布相似内容时拒绝，全站大量出现相似内容时送审
+// 重复检测依赖 Redis，失败时只记录日志，不影响发布
+func (m *Moderator) Check(ctx context.Context, c *Content) *Decision {
+	d := m.CheckText(c)
+	if d.Action == ActionReject {
+		return d
+	}
+
+	fingerprint, ok := Simhash(c.text())
+	if !ok {
+		return d
+	}
+	d.Simhash = fingerprint
+
+	now := time.Now()
+	userKey := spamUserKeyPrefix + c.UserID
+	userRepeats, err := m.repeats(userKey, fingerprint, now)
+	if err != nil {
+		log.GetLogger().Warnf("moderation check user repeats failed: %v", err)
+		return d
+	}
+	globalRepeats, err := m.repeats(spamGlobalKey, fingerprint, now)
+	if err != nil {
+		log.GetLogger().Warnf("moderation check global repeats failed: %v", err)
+		return d
+	}
+	if err := m.remember(userKey, fingerprint, now, spamUserKeep); err != nil {
+		log.GetLogger().Warnf("moderation remember fingerprint failed: %v", err)
+	}
+	if err := m.remember(spamGlobalKey, fingerprint, now, spamGlobalKeep); err != nil {
+		log.GetLogger().Warnf("moderation remember fingerprint failed: %v", err)
+	}
+
+	switch {
+	case userRepeats >= spamUserRepeat():
+		d.Action, d.Reason = ActionReject, ReasonSpam
+	case globalRepeats >= spamGlobalRepeat() && severity[d.Action] < severity[ActionReview]:
+		d.Action, d.Reason = ActionReview, ReasonSpam
+	}
+	return d
+}
+
+// repeats 窗口内与指纹相似的内容数
+func (m *Moderator) repeats(key string, fingerprint uint64, now time.Time) (int, error) {
+	members, err := m.redis.ZRangeByScore(key, &redis.ZRangeBy{
+		Min: strconv.FormatInt(now.Add(-spamWindow()).UnixMilli(), 10),
+		Max: "+inf",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	distance := simhashDistance()
+	for _, member := range members {
+		hex, _, _ := strings.Cut(member, ":")
+		other, err := strconv.ParseUint(hex, 16, 64)
+		if err != nil {
+			continue
+		}
+		if HammingDistance(fingerprint, other) <= distance {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// remember 记录指纹，成员带随机后缀以保留相同的指纹，只保留窗口内最近 keep 条
+func (m *Moderator) remember(key string, fingerprint uint64, now time.Time, keep int64) error {
+	window := spamWindow()
+	_, err := m.redis.TxPipeline(func(pipe redis.Pipeliner) error {
+		ctx := context.Background()
+		pipe.ZAdd(ctx, key, redis.Z{
+			Score:  float64(now.UnixMilli()),
+			Member: fmt.Sprintf("%016x:%s", fingerprint, xid.New().String()),
+		})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+		pipe.ZRemRangeByRank(ctx, key, 0, -keep-1)
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	return err
+}
+
+// mask 将命中的字符替换为*，跳过的分隔符一并替换
+func mask(text string, hits []*Hit) string {
+	if len(hits) == 0 {
+		return text
+	}
+	runes := []rune(text)
+	for _, hit := range hits {
+		for i := hit.Start; i < hit.End; i++ {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+// Record 记录审核决定，targetID 为创建后的帖子或评论ID，被拒绝的内容为空；送审的记录进入复审队列
+func (m *Moderator) Record(ctx context.Context, d *Decision, targetID string) error {
+	record := &models.ModerationRecord{
+		ID:         xid.New().String(),
+		TargetType: d.content.TargetType,
+		TargetID:   targetID,
+		UserID:     d.content.UserID,
+		Action:     string(d.Action),
+		Reason:     d.Reason,
+		Hits:       d.Hits,
+		Content:    d.content.text(),
+		CreatedAt:  time.Now(),
+	}
+	if d.Simhash != 0 {
+		record.Simhash = fmt.Sprintf("%016x", d.Simhash)
+	}
+	if d.Action == ActionReview {
+		record.ReviewStatus = models.ReviewStatusPending
+	}
+	return m.db.WithContext(ctx).Create(record).Error
+}
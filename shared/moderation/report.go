@@ -0,0 +1,339 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/models"
+)
+
+// DefaultReportHideCount 不同用户举报达到该人数时自动隐藏
+const DefaultReportHideCount = 5
+
+// 举报单的处理动作
+const (
+	ReportActionApprove = "approve" // 举报不成立，恢复被隐藏的内容
+	ReportActionRemove  = "remove"  // 移除内容并通知作者
+	ReportActionBan     = "ban"     // 移除内容并封禁作者
+)
+
+// reportHideCount 自动隐藏的举报人数
+func reportHideCount() int32 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Moderation.ReportHideCount > 0 {
+		return int32(config.GlobalConfig.Moderation.ReportHideCount)
+	}
+	return DefaultReportHideCount
+}
+
+// ValidReportReason 是否为有效的举报原因
+func ValidReportReason(reason string) bool {
+	for _, r := range models.ReportReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Report 举报帖子、评论或用户，同一对象的举报合并到一个举报单，每个用户只能举报一次
+// 未处理过的帖子和评论被不同用户举报达到一定人数后自动隐藏，等待管理员处理
+func (m *Moderator) Report(ctx context.Context, reporterID, targetType, targetID, reason, detail string) (*models.ReportCase, error) {
+	var reportCase models.ReportCase
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		targetUserID, err := reportTargetUser(tx, targetType, targetID)
+		if err != nil {
+			return err
+		}
+		if targetUserID == reporterID {
+			return fmt.Errorf("cannot report yourself")
+		}
+
+		// 并发创建同一对象的举报单时以先创建者为准
+		now := time.Now()
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ReportCase{
+			ID:           xid.New().String(),
+			TargetType:   targetType,
+			TargetID:     targetID,
+			TargetUserID: targetUserID,
+			Status:       models.ReportCasePending,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("target_type = ? AND target_id = ?", targetType, targetID).
+			First(&reportCase).Error; err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Report{
+			ID:         xid.New().String(),
+			CaseID:     reportCase.ID,
+			ReporterID: reporterID,
+			Reason:     reason,
+			Detail:     detail,
+			CreatedAt:  now,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("already reported")
+		}
+
+		// 举报不成立的举报单再次被举报时重新进入队列
+		reportCase.ReporterCount++
+		if reportCase.Status == models.ReportCaseApproved {
+			reportCase.Status = models.ReportCasePending
+		}
+		if reportCase.Status == models.ReportCasePending && reportCase.HandledAt == nil && !reportCase.Hidden &&
+			reportCase.ReporterCount >= reportHideCount() {
+			hidden, err := hideTarget(tx, targetType, targetID)
+			if err != nil {
+				return err
+			}
+			reportCase.Hidden = hidden
+		}
+		reportCase.UpdatedAt = now
+		return tx.Model(&models.ReportCase{}).Where("id = ?", reportCase.ID).Updates(map[string]interface{}{
+			"reporter_count": reportCase.ReporterCount,
+			"status":         reportCase.Status,
+			"hidden":         reportCase.Hidden,
+			"updated_at":     now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &reportCase, nil
+}
+
+// reportTargetUser 获取被举报对象的作者，只能举报所有人可见(或已因举报被隐藏)的帖子和评论
+func reportTargetUser(tx *gorm.DB, targetType, targetID string) (string, error) {
+	var userID string
+	var err error
+	switch targetType {
+	case constants.TargetTypePost:
+		var post models.Post
+		err = tx.Select("id", "user_id").
+			Where("id = ? AND status IN ?", targetID, []models.PostStatus{models.PostStatusPublished, models.PostStatusHidden}).
+			First(&post).Error
+		userID = post.UserID
+	case constants.TargetTypeComment:
+		var comment models.Comment
+		err = tx.Select("id", "user_id").
+			Where("id = ? AND status IN ?", targetID, []models.CommentStatus{models.CommentStatusNormal, models.CommentStatusHidden}).
+			First(&comment).Error
+		userID = comment.UserID
+	case constants.TargetTypeUser:
+		var user models.User
+		err = tx.Select("id").Where("id = ?", targetID).First(&user).Error
+		userID = user.ID
+	default:
+		return "", fmt.Errorf("invalid target type")
+	}
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", fmt.Errorf("report target not found")
+		}
+		return "", err
+	}
+	return userID, nil
+}
+
+// hideTarget 隐藏被举报的帖子或评论，返回是否已隐藏，用户不会被自动处理
+func hideTarget(tx *gorm.DB, targetType, targetID string) (bool, error) {
+	switch targetType {
+	case constants.TargetTypePost:
+		post, err := setPostStatus(tx, targetID, []models.PostStatus{models.PostStatusPublished}, models.PostStatusHidden)
+		return post != nil, err
+	case constants.TargetTypeComment:
+		comment, err := setCommentStatus(tx, targetID, []models.CommentStatus{models.CommentStatusNormal}, models.CommentStatusHidden)
+		return comment != nil, err
+	}
+	return false, nil
+}
+
+// ListReportCases 分页获取举报单，待处理的按举报人数倒序，其他状态按处理时间倒序
+func (m *Moderator) ListReportCases(ctx context.Context, status string, page, pageSize int) ([]*models.ReportCase, int64, error) {
+	var cases []*models.ReportCase
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&models.ReportCase{}).Where("status = ?", status)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "handled_at DESC, id DESC"
+	if status == models.ReportCasePending {
+		order = "reporter_count DESC, created_at ASC, id ASC"
+	}
+	err := query.Order(order).Offset((page - 1) * pageSize).Limit(pageSize).Find(&cases).Error
+	return cases, total, err
+}
+
+// ReportReasonCounts 统计举报单中各举报原因的人数
+func (m *Moderator) ReportReasonCounts(ctx context.Context, caseIDs []string) (map[string]map[string]int32, error) {
+	counts := make(map[string]map[string]int32, len(caseIDs))
+	if len(caseIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CaseID string
+		Reason string
+		Count  int32
+	}
+	err := m.db.WithContext(ctx).Model(&models.Report{}).
+		Select("case_id, reason, COUNT(*) AS count").
+		Where("case_id IN ?", caseIDs).
+		Group("case_id, reason").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if counts[row.CaseID] == nil {
+			counts[row.CaseID] = make(map[string]int32)
+		}
+		counts[row.CaseID][row.Reason] = row.Count
+	}
+	return counts, nil
+}
+
+// ResolveReportCase 处理举报单，在同一事务内处理被举报的对象并通知作者
+// 举报不成立时恢复被隐藏的内容；移除内容和封禁作者时给作者发送系统通知，note 为处理说明
+func (m *Moderator) ResolveReportCase(ctx context.Context, caseID, handlerID, action, note string) (*models.ReportCase, error) {
+	var reportCase models.ReportCase
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", caseID).First(&reportCase).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("report case not found")
+			}
+			return err
+		}
+		if reportCase.Status != models.ReportCasePending {
+			return fmt.Errorf("report case already resolved")
+		}
+		if action == ReportActionRemove && reportCase.TargetType == constants.TargetTypeUser {
+			return fmt.Errorf("invalid action")
+		}
+
+		switch action {
+		case ReportActionApprove:
+			if reportCase.Hidden {
+				if err := unhideTarget(tx, reportCase.TargetType, reportCase.TargetID); err != nil {
+					return err
+				}
+			}
+			reportCase.Status = models.ReportCaseApproved
+		case ReportActionRemove:
+			if err := removeTarget(tx, reportCase.TargetType, reportCase.TargetID, note); err != nil {
+				return err
+			}
+			reportCase.Status = models.ReportCaseRemoved
+		case ReportActionBan:
+			if err := removeTarget(tx, reportCase.TargetType, reportCase.TargetID, note); err != nil {
+				return err
+			}
+			if err := BanUser(tx, reportCase.TargetUserID, note); err != nil {
+				return err
+			}
+			reportCase.Status = models.ReportCaseBanned
+		default:
+			return fmt.Errorf("invalid action")
+		}
+
+		now := time.Now()
+		reportCase.Hidden = false
+		reportCase.HandlerID = &handlerID
+		reportCase.HandledAt = &now
+		reportCase.Note = note
+		reportCase.UpdatedAt = now
+		return tx.Model(&models.ReportCase{}).Where("id = ?", reportCase.ID).Updates(map[string]interface{}{
+			"status":     reportCase.Status,
+			"hidden":     false,
+			"handler_id": handlerID,
+			"handled_at": now,
+			"note":       note,
+			"updated_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &reportCase, nil
+}
+
+// unhideTarget 恢复因举报被隐藏的帖子或评论
+func unhideTarget(tx *gorm.DB, targetType, targetID string) error {
+	var err error
+	switch targetType {
+	case constants.TargetTypePost:
+		_, err = setPostStatus(tx, targetID, []models.PostStatus{models.PostStatusHidden}, models.PostStatusPublished)
+	case constants.TargetTypeComment:
+		_, err = setCommentStatus(tx, targetID, []models.CommentStatus{models.CommentStatusHidden}, models.CommentStatusNormal)
+	}
+	return err
+}
+
+// removeTarget 移除帖子或评论并通知作者，内容已被作者删除时不再通知
+func removeTarget(tx *gorm.DB, targetType, targetID, note string) error {
+	switch targetType {
+	case constants.TargetTypePost:
+		post, err := setPostStatus(tx, targetID,
+			[]models.PostStatus{models.PostStatusPublished, models.PostStatusHidden}, models.PostStatusRemoved)
+		if err != nil || post == nil {
+			return err
+		}
+		content := fmt.Sprintf("你的帖子《%s》因违反社区规范已被移除", post.Title)
+		return notify(tx, post.UserID, "帖子已被移除", withNote(content, note), post.ID)
+	case constants.TargetTypeComment:
+		comment, err := setCommentStatus(tx, targetID,
+			[]models.CommentStatus{models.CommentStatusNormal, models.CommentStatusHidden}, models.CommentStatusRemoved)
+		if err != nil || comment == nil {
+			return err
+		}
+		content := fmt.Sprintf("你的评论「%s」因违反社区规范已被移除", excerpt(comment.Content, 30))
+		return notify(tx, comment.UserID, "评论已被移除", withNote(content, note), comment.ID)
+	}
+	return nil
+}
+
+// BanUser 在事务内封禁用户并发送系统通知
+func BanUser(tx *gorm.DB, userID, note string) error {
+	if err := setUserStatus(tx, userID, models.UserStatusBanned); err != nil {
+		return err
+	}
+	return notify(tx, userID, "账号已被封禁", withNote("你的账号因违反社区规范已被封禁", note), userID)
+}
+
+// withNote 通知内容附加处理说明
+func withNote(content, note string) string {
+	if note == "" {
+		return content
+	}
+	return content + "，说明：" + note
+}
+
+// TargetPostID 获取举报对象所属的帖子，用于处理后刷新帖子的缓存、热度和索引，用户返回空
+func (m *Moderator) TargetPostID(ctx context.Context, targetType, targetID string) string {
+	switch targetType {
+	case constants.TargetTypePost:
+		return targetID
+	case constants.TargetTypeComment:
+		var postID string
+		m.db.WithContext(ctx).Model(&models.Comment{}).Where("id = ?", targetID).Pluck("post_id", &postID)
+		return postID
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+)
+
+// ListReviews 分页获取审核记录，status 为空时获取全部送审的记录，按送审时间倒序
+func (m *Moderator) ListReviews(ctx context.Context, status string, page, pageSize int) ([]*models.ModerationRecord, int64, error) {
+	var records []*models.ModerationRecord
+	var total int64
+
+	query := m.db.WithContext(ctx).Model(&models.ModerationRecord{})
+	if status != "" {
+		query = query.Where("review_status = ?", status)
+	} else {
+		query = query.Where("review_status <> ?", models.ReviewStatusNone)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&records).Error
+	return records, total, err
+}
+
+// Resolve 处理送审的内容，在同一事务内更新审核记录和内容状态
+// 帖子通过后转为定时发布，由定时发布任务完成发布及其后续处理；评论通过后计入评论数和回复数
+// 内容在复审前已被删除时只更新审核记录
+func (m *Moderator) Resolve(ctx context.Context, recordID, reviewerID string, approve bool) (*models.ModerationRecord, error) {
+	var record models.ModerationRecord
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", recordID).First(&record).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("review not found")
+			}
+			return err
+		}
+		if record.ReviewStatus != models.ReviewStatusPending {
+			return fmt.Errorf("review already resolved")
+		}
+
+		now := time.Now()
+		record.ReviewStatus = models.ReviewStatusRejected
+		if approve {
+			record.ReviewStatus = models.ReviewStatusApproved
+		}
+		record.ReviewerID = &reviewerID
+		record.ReviewedAt = &now
+		if err := tx.Model(&models.ModerationRecord{}).Where("id = ?", record.ID).Updates(map[string]interface{}{
+			"review_status": record.ReviewStatus,
+			"reviewer_id":   reviewerID,
+			"reviewed_at":   now,
+		}).Error; err != nil {
+			return err
+		}
+
+		switch record.TargetType {
+		case constants.TargetTypePost:
+			return resolvePost(tx, record.TargetID, approve, now)
+		case constants.TargetTypeComment:
+			return resolveComment(tx, record.TargetID, approve)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// resolvePost 帖子复审通过时立即发布，作者指定的发布时间未到时按原定时间进入定时发布；未通过时保留为仅作者可见
+func resolvePost(tx *gorm.DB, postID string, approve bool, now time.Time) error {
+	var post models.Post
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND status = ?", postID, models.PostStatusReviewing).
+		First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if !approve {
+		return tx.Model(&models.Post{}).Where("id = ?", postID).Updates(map[string]interface{}{
+			"status":     models.PostStatusRejected,
+			"updated_at": now,
+		}).Error
+	}
+	if post.PublishAt != nil && post.PublishAt.After(now) {
+		return tx.Model(&models.Post{}).Where("id = ?", postID).Updates(map[string]interface{}{
+			"status":     models.PostStatusScheduled,
+			"updated_at": now,
+		}).Error
+	}
+	if err := tx.Model(&models.Post{}).Where("id = ?", postID).Updates(map[string]interface{}{
+		"status":     models.PostStatusPublished,
+		"created_at": now,
+		"updated_at": now,
+	}).Error; err != nil {
+		return err
+	}
+	return adjustPostCounts(tx, &post, 1)
+}
+
+// resolveComment 评论复审通过时恢复正常并计入帖子评论数和父评论回复数
+func resolveComment(tx *gorm.DB, commentID string, approve bool) error {
+	to := models.CommentStatusRejected
+	if approve {
+		to = models.CommentStatusNormal
+	}
+	_, err := setCommentStatus(tx, commentID, []models.CommentStatus{models.CommentStatusReviewing}, to)
+	return err
+}
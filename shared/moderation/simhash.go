@@ -0,0 +1,44 @@
+package moderation
+
+import (
+	"hash/fnv"
+	"math/bits"
+
+	"hupu/shared/search"
+)
+
+// simhashMinTokens 切分后少于该数量的短文本不做重复检测，避免"顶""+1"之类的短回复被误判
+const simhashMinTokens = 8
+
+// Simhash 计算文本的64位 simhash，特征为中文二元组和英文单词，ok 为 false 表示文本过短
+func Simhash(text string) (fingerprint uint64, ok bool) {
+	tokens := search.Tokenize(text)
+	if len(tokens) < simhashMinTokens {
+		return 0, false
+	}
+
+	var weights [64]int
+	for _, token := range tokens {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<i) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+	for i, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << i
+		}
+	}
+	return fingerprint, true
+}
+
+// HammingDistance 两个指纹不同的位数
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,408 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+const (
+	hotKeyAll            = "post:hot:all"
+	hotCategoryKeyPrefix = "post:hot:category:"
+	hotTagKeyPrefix      = "post:hot:tag:"
+	hotInterKeyPrefix    = "post:hot:inter:"
+	hotMemberKeyPrefix   = "post:hot:member:"
+	hotRegistryKey       = "post:hot:keys"
+	hotRebuildLockKey    = "post:hot:rebuild:lock"
+	hotRebuildSuffix     = ":rebuild"
+
+	hotInterTTL        = time.Minute
+	hotRebuildBatch    = 500
+	hotRebuildZAddSize = 1000
+
+	DefaultHotDecayHours      = 12
+	DefaultHotWindowDays      = 7
+	DefaultHotRebuildInterval = 10 * time.Minute
+)
+
+// 热度权重，收藏和评分代表更强的认可，权重高于点赞
+const (
+	hotWeightLike     = 1.0
+	hotWeightComment  = 2.0
+	hotWeightFavorite = 3.0
+	hotWeightRating   = 2.0
+	hotWeightShare    = 3.0
+	hotWeightView     = 0.05
+)
+
+// hotEpoch 热度中发布时间的起点(2024-01-01 UTC)，减小分值的量级
+const hotEpoch = 1704067200
+
+func engagement(post *models.Post) float64 {
+	return float64(post.LikeCount)*hotWeightLike +
+		float64(post.CommentCount)*hotWeightComment +
+		float64(post.FavoriteCount)*hotWeightFavorite +
+		float64(post.RatingCount)*hotWeightRating +
+		float64(post.ShareCount)*hotWeightShare +
+		float64(post.ViewCount)*hotWeightView
+}
+
+// HotScore 计算帖子热度：log10(互动分 + 1) + 发布时间 / 衰减周期
+// 分值不随当前时间变化，不同时刻写入的分值可以直接比较；晚发布一个衰减周期的帖子需要十倍互动量才能排在前面
+func HotScore(post *models.Post, decay time.Duration) float64 {
+	return math.Log10(engagement(post)+1) + float64(post.CreatedAt.Unix()-hotEpoch)/decay.Seconds()
+}
+
+// HotValue 计算帖子在 now 时刻的热度值：(互动分 + 1) × 10^(-发布时长 / 衰减周期)
+// 与 HotScore 排序一致且恒为正数，用于同一时刻按权重累加热度
+func HotValue(post *models.Post, decay time.Duration, now time.Time) float64 {
+	age := now.Sub(post.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	return (engagement(post) + 1) * math.Pow(10, -age.Seconds()/decay.Seconds())
+}
+
+// HotRanker 维护按分类、标签划分的热榜有序集合
+type HotRanker struct {
+	db       *gorm.DB
+	redis    *utils.RedisClient
+	notifier *TopicNotifier
+}
+
+// NewHotRanker 创建热榜维护器
+func NewHotRanker(db *gorm.DB, redisClient *utils.RedisClient) *HotRanker {
+	return &HotRanker{
+		db:       db,
+		redis:    redisClient,
+		notifier: NewTopicNotifier(db, redisClient),
+	}
+}
+
+func decay() time.Duration {
+	hours := DefaultHotDecayHours
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.HotDecayHours > 0 {
+		hours = config.GlobalConfig.Post.HotDecayHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+func window() time.Duration {
+	days := DefaultHotWindowDays
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.HotWindowDays > 0 {
+		days = config.GlobalConfig.Post.HotWindowDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// RebuildInterval 热榜重建间隔
+func RebuildInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.HotRebuildIntervalMin > 0 {
+		return time.Duration(config.GlobalConfig.Post.HotRebuildIntervalMin) * time.Minute
+	}
+	return DefaultHotRebuildInterval
+}
+
+// hotKeysOf 帖子所属的全部热榜
+func hotKeysOf(post *models.Post) []string {
+	keys := []string{hotKeyAll, fmt.Sprintf("%s%d", hotCategoryKeyPrefix, post.Category)}
+	seen := make(map[string]bool)
+	for _, tag := range post.Tags {
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		keys = append(keys, hotTagKeyPrefix+tag)
+	}
+	return keys
+}
+
+// Refresh 重新计算单个帖子的热度并写入所属热榜
+// 帖子已删除、尚未发布或超出热榜时间窗口时从热榜移除
+func (h *HotRanker) Refresh(ctx context.Context, postID string) error {
+	var post models.Post
+	err := h.db.WithContext(ctx).Unscoped().Where("id = ?", postID).First(&post).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return h.Remove(ctx, postID)
+		}
+		return err
+	}
+
+	now := time.Now()
+	if post.DeletedAt.Valid || post.Status != models.PostStatusPublished || now.Sub(post.CreatedAt) > window() {
+		return h.Remove(ctx, postID)
+	}
+
+	score := HotScore(&post, decay())
+	keys := hotKeysOf(&post)
+
+	// 移除帖子已不再属于的热榜（如编辑后更换了标签）
+	memberKey := hotMemberKeyPrefix + postID
+	oldKeys, err := h.redis.SMembers(memberKey)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		current[key] = true
+	}
+
+	_, err = h.redis.TxPipeline(func(pipe redis.Pipeliner) error {
+		for _, key := range oldKeys {
+			if !current[key] {
+				pipe.ZRem(ctx, key, postID)
+				pipe.SRem(ctx, memberKey, key)
+			}
+		}
+		for _, key := range keys {
+			pipe.ZAdd(ctx, key, redis.Z{Score: score, Member: postID})
+			pipe.SAdd(ctx, memberKey, key)
+			pipe.SAdd(ctx, hotRegistryKey, key)
+		}
+		pipe.Expire(ctx, memberKey, window())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 通知失败不影响热度刷新
+	if err := h.notifier.NotifyHotPost(ctx, &post); err != nil {
+		log.GetLogger().Warnf("notify topic subscribers of hot post %s failed: %v", postID, err)
+	}
+	return nil
+}
+
+// Remove 从所有热榜中移除帖子
+func (h *HotRanker) Remove(ctx context.Context, postID string) error {
+	memberKey := hotMemberKeyPrefix + postID
+	keys, err := h.redis.SMembers(memberKey)
+	if err != nil {
+		return err
+	}
+	_, err = h.redis.TxPipeline(func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.ZRem(ctx, key, postID)
+		}
+		pipe.Del(ctx, memberKey)
+		return nil
+	})
+	return err
+}
+
+// Rebuild 全量重算时间窗口内帖子的热度，移出超出时间窗口的帖子并修正漏掉的实时更新
+// 新榜单先写入临时key再整体替换，重建期间读请求不受影响
+func (h *HotRanker) Rebuild(ctx context.Context) error {
+	since := time.Now().Add(-window())
+	d := decay()
+
+	members := make(map[string][]redis.Z)
+	postKeys := make(map[string][]string)
+	lastID := ""
+	for {
+		var posts []*models.Post
+		query := h.db.WithContext(ctx).Scopes(models.Published).Where("created_at >= ?", since).Order("id ASC").Limit(hotRebuildBatch)
+		if lastID != "" {
+			query = query.Where("id > ?", lastID)
+		}
+		if err := query.Find(&posts).Error; err != nil {
+			return err
+		}
+		if len(posts) == 0 {
+			break
+		}
+
+		for _, p := range posts {
+			score := HotScore(p, d)
+			keys := hotKeysOf(p)
+			for _, key := range keys {
+				members[key] = append(members[key], redis.Z{Score: score, Member: p.ID})
+			}
+			postKeys[p.ID] = keys
+		}
+
+		lastID = posts[len(posts)-1].ID
+		if len(posts) < hotRebuildBatch {
+			break
+		}
+	}
+
+	for key, zs := range members {
+		tmpKey := key + hotRebuildSuffix
+		if _, err := h.redis.Del(tmpKey); err != nil {
+			return err
+		}
+		for start := 0; start < len(zs); start += hotRebuildZAddSize {
+			end := start + hotRebuildZAddSize
+			if end > len(zs) {
+				end = len(zs)
+			}
+			if _, err := h.redis.ZAdd(tmpKey, zs[start:end]...); err != nil {
+				return err
+			}
+		}
+		if err := h.redis.Rename(tmpKey, key); err != nil {
+			return err
+		}
+	}
+
+	// 清理已没有帖子的旧热榜
+	oldKeys, err := h.redis.SMembers(hotRegistryKey)
+	if err != nil {
+		return err
+	}
+	for _, key := range oldKeys {
+		if _, ok := members[key]; !ok {
+			if _, err := h.redis.Del(key); err != nil {
+				return err
+			}
+			if _, err := h.redis.SRem(hotRegistryKey, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = h.redis.TxPipeline(func(pipe redis.Pipeliner) error {
+		for key := range members {
+			pipe.SAdd(ctx, hotRegistryKey, key)
+		}
+		for postID, keys := range postKeys {
+			memberKey := hotMemberKeyPrefix + postID
+			pipe.Del(ctx, memberKey)
+			for _, key := range keys {
+				pipe.SAdd(ctx, memberKey, key)
+			}
+			pipe.Expire(ctx, memberKey, window())
+		}
+		return nil
+	})
+	return err
+}
+
+// resolveKey 根据筛选条件确定热榜key，同时按分类和标签筛选时使用短期缓存的交集
+func (h *HotRanker) resolveKey(category, tag string) (string, error) {
+	switch {
+	case category != "" && tag != "":
+		interKey := hotInterKeyPrefix + category + ":" + tag
+		exists, err := h.redis.Exists(interKey)
+		if err != nil {
+			return "", err
+		}
+		if exists == 0 {
+			if _, err := h.redis.ZInterStore(interKey, &redis.ZStore{
+				Keys:      []string{hotCategoryKeyPrefix + category, hotTagKeyPrefix + tag},
+				Aggregate: "MAX",
+			}); err != nil {
+				return "", err
+			}
+			if _, err := h.redis.Expire(interKey, hotInterTTL); err != nil {
+				return "", err
+			}
+		}
+		return interKey, nil
+	case category != "":
+		return hotCategoryKeyPrefix + category, nil
+	case tag != "":
+		return hotTagKeyPrefix + tag, nil
+	default:
+		return hotKeyAll, nil
+	}
+}
+
+// Page 按热度倒序分页获取帖子ID，多取一条用于判断是否还有下一页
+func (h *HotRanker) Page(ctx context.Context, category, tag string, page, pageSize int32) ([]string, error) {
+	start := int64(page-1) * int64(pageSize)
+	return h.Range(ctx, category, tag, start, start+int64(pageSize))
+}
+
+// Range 按热度倒序获取排名在 [start, stop] 内的帖子ID
+func (h *HotRanker) Range(ctx context.Context, category, tag string, start, stop int64) ([]string, error) {
+	key, err := h.resolveKey(category, tag)
+	if err != nil {
+		return nil, err
+	}
+	return h.redis.ZRevRange(key, start, stop)
+}
+
+// After 按 (热度, ID) 游标获取下一页帖子ID，最多返回 limit 条
+func (h *HotRanker) After(ctx context.Context, category, tag string, cursor *utils.Cursor, limit int) ([]string, error) {
+	key, err := h.resolveKey(category, tag)
+	if err != nil {
+		return nil, err
+	}
+	max := strconv.FormatFloat(cursor.Score, 'f', -1, 64)
+	// 与游标同分的成员按ID倒序排列，多取这部分后跳过ID不小于游标的成员
+	ties, err := h.redis.ZCount(key, max, max)
+	if err != nil {
+		return nil, err
+	}
+	members, err := h.redis.ZRevRangeByScoreWithScores(key, &redis.ZRangeBy{
+		Max:   max,
+		Min:   "-inf",
+		Count: int64(limit) + ties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, limit)
+	for _, m := range members {
+		id, _ := m.Member.(string)
+		if m.Score == cursor.Score && id >= cursor.ID {
+			continue
+		}
+		ids = append(ids, id)
+		if len(ids) == limit {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// Score 获取帖子在热榜中的分值，用于生成翻页游标
+func (h *HotRanker) Score(ctx context.Context, category, tag, postID string) (float64, error) {
+	key, err := h.resolveKey(category, tag)
+	if err != nil {
+		return 0, err
+	}
+	return h.redis.ZScore(key, postID)
+}
+
+// StartRebuildWorker 启动热榜定时重建任务，ctx取消时退出
+func (h *HotRanker) StartRebuildWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(RebuildInterval())
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		// 多实例部署时只允许一个实例执行重建
+		locked, err := h.redis.SetNX(hotRebuildLockKey, 1, RebuildInterval()/2)
+		if err != nil {
+			logger.Errorf("HotRanker acquire rebuild lock failed: %v", err)
+		} else if !locked {
+			logger.Debugf("HotRanker rebuild skipped, lock held by another instance")
+		} else if err := h.Rebuild(ctx); err != nil {
+			logger.Errorf("HotRanker rebuild failed: %v", err)
+		} else {
+			logger.Infof("HotRanker rebuild finished in %v", time.Since(start))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
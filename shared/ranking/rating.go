@@ -0,0 +1,110 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/models"
+)
+
+const (
+	DefaultRatingPriorWeight = 5.0
+	DefaultRatingMinVotes    = 3
+
+	ratingPriorTTL = 10 * time.Minute
+)
+
+// RatingPrior 评分榜单使用的贝叶斯先验
+// Mean 为全站平均分，Weight 相当于每个帖子额外带有 Weight 个平均分评分
+type RatingPrior struct {
+	Mean     float64
+	Weight   float64
+	MinVotes int32
+}
+
+// Score 贝叶斯平均：(Mean*Weight + 评分总分) / (Weight + 评分人数)
+// 评分人数少的帖子被拉向全站均值，避免一个满分评价压过几百人的高分
+func (p *RatingPrior) Score(sum int64, count int32) float64 {
+	if p.Weight+float64(count) <= 0 {
+		return 0
+	}
+	return (p.Mean*p.Weight + float64(sum)) / (p.Weight + float64(count))
+}
+
+// ScoreExpr 与 Score 等价的 SQL 表达式，table 为 posts 表名或别名
+func (p *RatingPrior) ScoreExpr(table string) string {
+	return fmt.Sprintf("((%f + %s.rating_sum) / (%f + %s.rating_count))", p.Mean*p.Weight, table, p.Weight, table)
+}
+
+// RatingRanker 计算评分榜单的先验，全站均值定期重新统计
+type RatingRanker struct {
+	db *gorm.DB
+
+	mu        sync.Mutex
+	mean      float64
+	expiresAt time.Time
+}
+
+// NewRatingRanker 创建评分榜单先验计算器
+func NewRatingRanker(db *gorm.DB) *RatingRanker {
+	return &RatingRanker{db: db}
+}
+
+func ratingPriorWeight() float64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.RatingPriorWeight > 0 {
+		return config.GlobalConfig.Post.RatingPriorWeight
+	}
+	return DefaultRatingPriorWeight
+}
+
+func ratingMinVotes() int32 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.RatingMinVotes > 0 {
+		return int32(config.GlobalConfig.Post.RatingMinVotes)
+	}
+	return DefaultRatingMinVotes
+}
+
+// Prior 获取当前先验，统计失败时沿用上一次的全站均值
+func (r *RatingRanker) Prior(ctx context.Context) (*RatingPrior, error) {
+	mean, err := r.globalMean(ctx)
+	return &RatingPrior{
+		Mean:     mean,
+		Weight:   ratingPriorWeight(),
+		MinVotes: ratingMinVotes(),
+	}, err
+}
+
+// globalMean 全站平均分，没有任何评分时取分值区间的中点
+func (r *RatingRanker) globalMean(ctx context.Context) (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Now().Before(r.expiresAt) {
+		return r.mean, nil
+	}
+	if r.mean == 0 {
+		r.mean = float64(models.RatingScoreMin+models.RatingScoreMax) / 2
+	}
+
+	var result struct {
+		Sum   float64
+		Count float64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Select("COALESCE(SUM(rating_sum), 0) AS sum, COALESCE(SUM(rating_count), 0) AS count").
+		Scan(&result).Error
+	if err != nil {
+		return r.mean, err
+	}
+	if result.Count > 0 {
+		r.mean = result.Sum / result.Count
+	}
+	r.expiresAt = time.Now().Add(ratingPriorTTL)
+	return r.mean, nil
+}
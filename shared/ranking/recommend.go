@@ -0,0 +1,369 @@
+package ranking
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/constants"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/relation"
+	"hupu/shared/utils"
+)
+
+const (
+	recommendFeedKeyPrefix = "post:recommend:feed:"
+	seenKeyPrefix          = "post:seen:"
+
+	recommendFeedTTL = 5 * time.Minute
+	// recommendFeedSize 每次生成的推荐流最大长度
+	recommendFeedSize = 500
+	// recommendSourceLimit 每个来源的候选帖子数
+	recommendSourceLimit = 200
+	// recommendInterestLimit 统计兴趣标签时读取的最近互动帖子数
+	recommendInterestLimit = 200
+	// recommendTagCount 参与推荐的兴趣标签数
+	recommendTagCount = 10
+	// recommendHighRating 评分不低于该值视为喜欢
+	recommendHighRating = 4
+
+	seenMaxSize = 1000
+	seenTTL     = 30 * 24 * time.Hour
+
+	DefaultRecommendWeightFollow = 3.0
+	DefaultRecommendWeightTopic  = 2.0
+	DefaultRecommendWeightTag    = 1.5
+	DefaultRecommendWeightHot    = 1.0
+	DefaultRecommendWindowDays   = 7
+)
+
+// 推荐理由
+const (
+	ReasonFollow = "你关注的人"
+	ReasonTopic  = "你参与的话题"
+	ReasonTag    = "你常看的标签"
+	ReasonHot    = "热门推荐"
+)
+
+// Recommendation 推荐流中的一条
+type Recommendation struct {
+	PostID string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// recommendSource 一路召回的候选帖子
+type recommendSource struct {
+	reason string
+	weight float64
+	posts  []*models.Post
+}
+
+// Recommender 根据关注、话题、兴趣标签和热榜为用户生成个性化推荐流
+// 推荐流在第一页时重新生成并缓存，后续翻页读取缓存，保证翻页期间顺序稳定
+type Recommender struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+	hot   *HotRanker
+}
+
+// NewRecommender 创建个性化推荐器
+func NewRecommender(db *gorm.DB, redisClient *utils.RedisClient, hot *HotRanker) *Recommender {
+	return &Recommender{
+		db:    db,
+		redis: redisClient,
+		hot:   hot,
+	}
+}
+
+func recommendWeight(configured, fallback float64) float64 {
+	if configured > 0 {
+		return configured
+	}
+	return fallback
+}
+
+// recommendWeights 各召回来源的权重，未配置时使用默认值
+func recommendWeights() (follow, topic, tag, hot float64) {
+	var cfg config.PostConfig
+	if config.GlobalConfig != nil {
+		cfg = config.GlobalConfig.Post
+	}
+	return recommendWeight(cfg.RecommendWeightFollow, DefaultRecommendWeightFollow),
+		recommendWeight(cfg.RecommendWeightTopic, DefaultRecommendWeightTopic),
+		recommendWeight(cfg.RecommendWeightTag, DefaultRecommendWeightTag),
+		recommendWeight(cfg.RecommendWeightHot, DefaultRecommendWeightHot)
+}
+
+func recommendWindow() time.Duration {
+	days := DefaultRecommendWindowDays
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.RecommendWindowDays > 0 {
+		days = config.GlobalConfig.Post.RecommendWindowDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// MarkSeen 记录用户看过的帖子，只保留最近 seenMaxSize 条
+func (r *Recommender) MarkSeen(ctx context.Context, userID, postID string) error {
+	key := seenKeyPrefix + userID
+	pipe := r.redis.Pipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(time.Now().Unix()), Member: postID})
+	pipe.ZRemRangeByRank(ctx, key, 0, -seenMaxSize-1)
+	pipe.Expire(ctx, key, seenTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Page 获取推荐流的一页，多返回一条用于判断 has_more
+func (r *Recommender) Page(ctx context.Context, userID string, page, pageSize int32) ([]*Recommendation, error) {
+	key := recommendFeedKeyPrefix + userID
+
+	var feed []*Recommendation
+	if page > 1 {
+		data, err := r.redis.GetBytes(key)
+		if err != nil {
+			log.GetLogger().Warnf("get recommend feed %s failed: %v", userID, err)
+		} else if data != nil {
+			if err := json.Unmarshal(data, &feed); err != nil {
+				feed = nil
+			}
+		}
+	}
+
+	if feed == nil {
+		var err error
+		feed, err = r.build(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if data, err := json.Marshal(feed); err == nil {
+			if err := r.redis.Set(key, data, recommendFeedTTL); err != nil {
+				log.GetLogger().Warnf("cache recommend feed %s failed: %v", userID, err)
+			}
+		}
+	}
+
+	offset := int((page - 1) * pageSize)
+	if offset >= len(feed) {
+		return []*Recommendation{}, nil
+	}
+	end := offset + int(pageSize) + 1
+	if end > len(feed) {
+		end = len(feed)
+	}
+	return feed[offset:end], nil
+}
+
+// build 多路召回后按来源权重和热度融合排序
+func (r *Recommender) build(ctx context.Context, userID string) ([]*Recommendation, error) {
+	since := time.Now().Add(-recommendWindow())
+	followWeight, topicWeight, tagWeight, hotWeight := recommendWeights()
+
+	// 互动过和看过的帖子不再推荐
+	interacted, err := r.interactedPosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	exclude := make(map[string]bool, len(interacted))
+	for _, p := range interacted {
+		exclude[p.ID] = true
+	}
+	seen, err := r.redis.ZRevRange(seenKeyPrefix+userID, 0, -1)
+	if err != nil {
+		log.GetLogger().Warnf("get seen posts %s failed: %v", userID, err)
+	}
+	for _, id := range seen {
+		exclude[id] = true
+	}
+	// 拉黑和屏蔽的用户的帖子不推荐，匿名帖子除外
+	hiddenIDs, err := relation.NewRelations(r.db).HiddenIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[string]bool, len(hiddenIDs))
+	for _, id := range hiddenIDs {
+		hidden[id] = true
+	}
+
+	followPosts, err := r.followedAuthorPosts(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	topicPosts, err := r.joinedTopicPosts(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	tagPosts, err := r.taggedPosts(ctx, interestTags(interacted), since)
+	if err != nil {
+		return nil, err
+	}
+	hotPosts, err := r.hotPosts(ctx)
+	if err != nil {
+		// 热榜不可用时只使用个性化来源
+		log.GetLogger().Warnf("get hot posts for recommend failed: %v", err)
+	}
+
+	return blend(userID, []*recommendSource{
+		{reason: ReasonFollow, weight: followWeight, posts: followPosts},
+		{reason: ReasonTopic, weight: topicWeight, posts: topicPosts},
+		{reason: ReasonTag, weight: tagWeight, posts: tagPosts},
+		{reason: ReasonHot, weight: hotWeight, posts: hotPosts},
+	}, exclude, hidden, time.Now()), nil
+}
+
+// blend 候选得分为各来源 权重×热度 之和，推荐理由取贡献最大的来源
+// exclude 为互动过和看过的帖子，hidden 为拉黑和屏蔽的作者
+func blend(userID string, sources []*recommendSource, exclude, hidden map[string]bool, now time.Time) []*Recommendation {
+	type candidate struct {
+		post      *models.Post
+		score     float64
+		best      float64
+		reason    string
+		createdAt time.Time
+	}
+
+	d := decay()
+	candidates := make(map[string]*candidate)
+	for _, source := range sources {
+		for _, p := range source.posts {
+			if p.UserID == userID || exclude[p.ID] || (!p.IsAnonymous && hidden[p.UserID]) {
+				continue
+			}
+			contribution := source.weight * HotValue(p, d, now)
+			c, ok := candidates[p.ID]
+			if !ok {
+				c = &candidate{post: p, createdAt: p.CreatedAt}
+				candidates[p.ID] = c
+			}
+			c.score += contribution
+			if contribution > c.best {
+				c.best = contribution
+				c.reason = source.reason
+			}
+		}
+	}
+
+	ranked := make([]*candidate, 0, len(candidates))
+	for _, c := range candidates {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].createdAt.After(ranked[j].createdAt)
+	})
+	if len(ranked) > recommendFeedSize {
+		ranked = ranked[:recommendFeedSize]
+	}
+
+	feed := make([]*Recommendation, 0, len(ranked))
+	for _, c := range ranked {
+		feed = append(feed, &Recommendation{PostID: c.post.ID, Reason: c.reason})
+	}
+	return feed
+}
+
+// interestTags 从互动过的帖子中统计出现最多的标签
+func interestTags(posts []*models.Post) []string {
+	counts := make(map[string]int)
+	for _, p := range posts {
+		for _, tag := range p.Tags {
+			counts[tag]++
+		}
+	}
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if counts[tags[i]] != counts[tags[j]] {
+			return counts[tags[i]] > counts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > recommendTagCount {
+		tags = tags[:recommendTagCount]
+	}
+	return tags
+}
+
+// interactedPosts 用户点赞、高分评价或收藏过的帖子
+func (r *Recommender) interactedPosts(ctx context.Context, userID string) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Where("id IN (SELECT target_id FROM likes WHERE user_id = ? AND target_type = ? AND deleted_at IS NULL)"+
+			" OR id IN (SELECT post_id FROM post_ratings WHERE user_id = ? AND score >= ? AND deleted_at IS NULL)"+
+			" OR id IN (SELECT post_id FROM post_favorites WHERE user_id = ? AND deleted_at IS NULL)",
+			userID, constants.TargetTypePost, userID, recommendHighRating, userID).
+		Order("created_at DESC").
+		Limit(recommendInterestLimit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// followedAuthorPosts 关注的人最近发布的帖子，匿名帖不暴露作者，不参与该来源
+func (r *Recommender) followedAuthorPosts(ctx context.Context, userID string, since time.Time) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published).
+		Where("user_id IN (SELECT following_id FROM follows WHERE follower_id = ? AND deleted_at IS NULL)", userID).
+		Where("created_at > ? AND is_anonymous = ?", since, false).
+		Order("created_at DESC").
+		Limit(recommendSourceLimit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// joinedTopicPosts 用户发过帖或评论过的话题下的最近帖子
+func (r *Recommender) joinedTopicPosts(ctx context.Context, userID string, since time.Time) ([]*models.Post, error) {
+	var posts []*models.Post
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published).
+		Where(`topic_id IN (
+			SELECT topic_id FROM posts WHERE user_id = ? AND topic_id IS NOT NULL AND deleted_at IS NULL
+			UNION
+			SELECT p.topic_id FROM comments c JOIN posts p ON p.id = c.post_id
+			WHERE c.user_id = ? AND c.deleted_at IS NULL AND p.topic_id IS NOT NULL
+		)`, userID, userID).
+		Where("created_at > ?", since).
+		Order("created_at DESC").
+		Limit(recommendSourceLimit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// taggedPosts 带有兴趣标签的最近帖子
+func (r *Recommender) taggedPosts(ctx context.Context, tags []string, since time.Time) ([]*models.Post, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	var posts []*models.Post
+	err := r.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published, models.TaggedWithAny(tags...)).
+		Where("created_at > ?", since).
+		Order("created_at DESC").
+		Limit(recommendSourceLimit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// hotPosts 全站热榜
+func (r *Recommender) hotPosts(ctx context.Context) ([]*models.Post, error) {
+	ids, err := r.hot.Page(ctx, "", "", 1, recommendSourceLimit)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	var posts []*models.Post
+	err = r.db.WithContext(ctx).Scopes(models.Published).Where("id IN ?", ids).Find(&posts).Error
+	return posts, err
+}
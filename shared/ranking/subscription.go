@@ -0,0 +1,95 @@
+package ranking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+const (
+	topicHotNotifiedKeyPrefix = "topic:hot:notified:" // + 帖子ID，已发送过热门通知的帖子
+
+	// DefaultTopicHotPostRank 帖子进入全站热榜前多少名时通知话题订阅者
+	DefaultTopicHotPostRank = 50
+
+	// notificationTypeTopicUpdate 对应 notification.thrift 的 TOPIC_UPDATE
+	notificationTypeTopicUpdate = 8
+	topicNotifyBatch            = 500
+)
+
+func topicHotPostRank() int64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.TopicHotPostRank > 0 {
+		return int64(config.GlobalConfig.Post.TopicHotPostRank)
+	}
+	return DefaultTopicHotPostRank
+}
+
+// TopicNotifier 话题下出现新的热门帖子时通知订阅者
+type TopicNotifier struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+}
+
+// NewTopicNotifier 创建话题订阅通知器
+func NewTopicNotifier(db *gorm.DB, redisClient *utils.RedisClient) *TopicNotifier {
+	return &TopicNotifier{
+		db:    db,
+		redis: redisClient,
+	}
+}
+
+// NotifyHotPost 帖子首次进入全站热榜前列时给所属话题的订阅者发送 TOPIC_UPDATE 通知
+// 每个帖子只通知一次，作者本人不会收到通知
+func (n *TopicNotifier) NotifyHotPost(ctx context.Context, post *models.Post) error {
+	if post.TopicID == nil || *post.TopicID == "" {
+		return nil
+	}
+	rank, err := n.redis.ZRevRank(hotKeyAll, post.ID)
+	if err != nil || rank < 0 || rank >= topicHotPostRank() {
+		return err
+	}
+	ok, err := n.redis.SetNX(topicHotNotifiedKeyPrefix+post.ID, 1, window())
+	if err != nil || !ok {
+		return err
+	}
+
+	var topic models.Topic
+	if err := n.db.WithContext(ctx).Select("id", "name").Where("id = ?", *post.TopicID).First(&topic).Error; err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("你订阅的话题 #%s# 有新的热门帖子", topic.Name)
+	var subscriptions []*models.TopicSubscription
+	return n.db.WithContext(ctx).
+		Select("id", "user_id").
+		Where("topic_id = ? AND user_id <> ?", topic.ID, post.UserID).
+		FindInBatches(&subscriptions, topicNotifyBatch, func(tx *gorm.DB, batch int) error {
+			now := time.Now()
+			notifications := make([]*models.Notification, 0, len(subscriptions))
+			for _, s := range subscriptions {
+				notification := &models.Notification{
+					ID:        xid.New().String(),
+					UserID:    s.UserID,
+					Title:     title,
+					Content:   post.Title,
+					Type:      notificationTypeTopicUpdate,
+					TargetID:  post.ID,
+					CreatedAt: now,
+					UpdatedAt: now,
+				}
+				// 匿名帖子不记录发送者，以免暴露作者
+				if !post.IsAnonymous {
+					notification.SenderID = &post.UserID
+				}
+				notifications = append(notifications, notification)
+			}
+			return n.db.WithContext(ctx).Create(&notifications).Error
+		}).Error
+}
@@ -0,0 +1,115 @@
+package ranking
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"hupu/shared/utils"
+)
+
+const (
+	tagHourKeyPrefix     = "tag:trend:hour:" // + 小时，该小时内各标签被使用的次数
+	tagTrendingKeyPrefix = "tag:trending:"   // + 窗口，窗口内的热门标签，短暂缓存
+
+	tagHourTTL     = (7*24 + 1) * time.Hour // 小时桶保留到最长的统计窗口之外
+	tagTrendingTTL = time.Minute
+)
+
+// 热门标签的统计窗口
+const (
+	TagWindowHour = "1h"
+	TagWindowDay  = "24h"
+	TagWindowWeek = "7d"
+)
+
+// tagWindowHours 各统计窗口包含的小时数
+var tagWindowHours = map[string]int{
+	TagWindowHour: 1,
+	TagWindowDay:  24,
+	TagWindowWeek: 7 * 24,
+}
+
+// ValidTagWindow 统计窗口是否受支持
+func ValidTagWindow(window string) bool {
+	_, ok := tagWindowHours[window]
+	return ok
+}
+
+// TagTrend 热门标签及其窗口内的使用次数
+type TagTrend struct {
+	Name  string
+	Count int64
+}
+
+// TagRanker 按小时分桶记录标签使用次数，合并最近若干小时得到滑动窗口内的热门标签
+type TagRanker struct {
+	redis *utils.RedisClient
+}
+
+// NewTagRanker 创建热门标签统计器
+func NewTagRanker(redisClient *utils.RedisClient) *TagRanker {
+	return &TagRanker{redis: redisClient}
+}
+
+func tagHourKey(t time.Time) string {
+	return tagHourKeyPrefix + t.Format("2006010215")
+}
+
+// Record 记录一次标签使用（帖子发布或编辑时新增标签），tags 须为规范化后的标签名
+func (r *TagRanker) Record(ctx context.Context, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	key := tagHourKey(time.Now())
+	pipe := r.redis.Pipeline()
+	for _, tag := range tags {
+		pipe.ZIncrBy(ctx, key, 1, tag)
+	}
+	pipe.Expire(ctx, key, tagHourTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Trending 统计窗口内使用次数最多的标签
+func (r *TagRanker) Trending(ctx context.Context, window string, limit int) ([]*TagTrend, error) {
+	key := tagTrendingKeyPrefix + window
+	if err := r.refreshTrending(key, tagWindowHours[window]); err != nil {
+		return nil, err
+	}
+
+	results, err := r.redis.ZRevRangeWithScores(key, 0, int64(limit-1))
+	if err != nil {
+		return nil, err
+	}
+	trends := make([]*TagTrend, 0, len(results))
+	for _, z := range results {
+		name, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		trends = append(trends, &TagTrend{Name: name, Count: int64(z.Score)})
+	}
+	return trends, nil
+}
+
+// refreshTrending 合并最近 hours 个小时桶，结果缓存1分钟
+func (r *TagRanker) refreshTrending(key string, hours int) error {
+	exists, err := r.redis.Exists(key)
+	if err != nil || exists > 0 {
+		return err
+	}
+
+	now := time.Now()
+	store := &redis.ZStore{Aggregate: "SUM"}
+	for i := 0; i < hours; i++ {
+		store.Keys = append(store.Keys, tagHourKey(now.Add(-time.Duration(i)*time.Hour)))
+	}
+	if _, err := r.redis.ZUnionStore(key, store); err != nil {
+		return err
+	}
+	_, err = r.redis.Expire(key, tagTrendingTTL)
+	return err
+}
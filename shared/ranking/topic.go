@@ -0,0 +1,110 @@
+package ranking
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+const (
+	topicParticipantsKeyPrefix = "topic:participants:"       // + 话题ID，累计参与人数
+	topicDailyKeyPrefix        = "topic:participants:daily:" // + 日期:话题ID，当日参与人数
+	topicHotKeyPrefix          = "topic:hot:"                // + 日期，当日热门话题排行
+
+	// topicDailyTTL 当日统计多保留一天，跨零点时仍可读取
+	topicDailyTTL = 48 * time.Hour
+)
+
+// TopicHeat 热门话题及其今日参与人数
+type TopicHeat struct {
+	TopicID string
+	Today   int64
+}
+
+// TopicRanker 用 HyperLogLog 统计话题的去重参与人数，按今日参与人数维护热门话题
+type TopicRanker struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+}
+
+// NewTopicRanker 创建话题热度统计器
+func NewTopicRanker(db *gorm.DB, redisClient *utils.RedisClient) *TopicRanker {
+	return &TopicRanker{
+		db:    db,
+		redis: redisClient,
+	}
+}
+
+func topicDay(t time.Time) string {
+	return t.Format("20060102")
+}
+
+// Participate 记录用户参与话题（发帖、评论、分享）
+// 参与人数变化时更新今日排行，并回写话题的累计参与人数
+func (r *TopicRanker) Participate(ctx context.Context, topicID, userID string) error {
+	day := topicDay(time.Now())
+	dailyKey := topicDailyKeyPrefix + day + ":" + topicID
+	hotKey := topicHotKeyPrefix + day
+
+	changed, err := r.redis.PFAdd(dailyKey, userID)
+	if err != nil {
+		return err
+	}
+	if changed > 0 {
+		today, err := r.redis.PFCount(dailyKey)
+		if err != nil {
+			return err
+		}
+		if _, err := r.redis.ZAdd(hotKey, redis.Z{Score: float64(today), Member: topicID}); err != nil {
+			return err
+		}
+		r.redis.Expire(dailyKey, topicDailyTTL)
+		r.redis.Expire(hotKey, topicDailyTTL)
+	}
+
+	totalKey := topicParticipantsKeyPrefix + topicID
+	changed, err = r.redis.PFAdd(totalKey, userID)
+	if err != nil || changed == 0 {
+		return err
+	}
+	total, err := r.redis.PFCount(totalKey)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).
+		Model(&models.Topic{}).
+		Where("id = ?", topicID).
+		UpdateColumn("participant_count", total).Error
+}
+
+// ParticipatePost 记录用户参与帖子所属的话题，帖子不属于任何话题时忽略
+func (r *TopicRanker) ParticipatePost(ctx context.Context, postID, userID string) error {
+	var post models.Post
+	err := r.db.WithContext(ctx).Select("id", "topic_id").Where("id = ?", postID).First(&post).Error
+	if err != nil || post.TopicID == nil {
+		return err
+	}
+	return r.Participate(ctx, *post.TopicID, userID)
+}
+
+// Hot 今日参与人数最多的话题
+func (r *TopicRanker) Hot(ctx context.Context, limit int) ([]*TopicHeat, error) {
+	results, err := r.redis.ZRevRangeWithScores(topicHotKeyPrefix+topicDay(time.Now()), 0, int64(limit-1))
+	if err != nil {
+		return nil, err
+	}
+	heats := make([]*TopicHeat, 0, len(results))
+	for _, z := range results {
+		id, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		heats = append(heats, &TopicHeat{TopicID: id, Today: int64(z.Score)})
+	}
+	return heats, nil
+}
@@ -0,0 +1,165 @@
+package reconcile
+
+import (
+	"gorm.io/gorm"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+)
+
+// counterCheck 一项冗余计数的校对：按 key 分批扫描 table，将 column 与源表统计的实际值比较
+type counterCheck struct {
+	name   string // 校对项名称，表名.字段名
+	table  string
+	key    string // 分批扫描和修复时使用的唯一键
+	column string
+	where  string                                                     // 只校对满足条件的行，回收站中的数据不参与校对
+	count  func(db *gorm.DB, keys []string) (map[string]int64, error) // 从源表统计 keys 对应的实际值，缺失的视为0
+}
+
+// checks 全部校对项，user_stats 的校对前会先补建缺失的记录
+var checks = []*counterCheck{
+	{
+		name: "posts.like_count", table: "posts", key: "id", column: "like_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("likes").
+				Where("target_type = ? AND deleted_at IS NULL", constants.TargetTypePost), "target_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "posts.comment_count", table: "posts", key: "id", column: "comment_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("comments").Scopes(models.NormalComments).Where("deleted_at IS NULL"), "post_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "posts.favorite_count", table: "posts", key: "id", column: "favorite_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("post_favorites").Where("deleted_at IS NULL"), "post_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "posts.rating_count", table: "posts", key: "id", column: "rating_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("post_ratings").Where("deleted_at IS NULL"), "post_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "posts.rating_sum", table: "posts", key: "id", column: "rating_sum", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("post_ratings").Where("deleted_at IS NULL"), "post_id", "SUM(score)", keys)
+		},
+	},
+	{
+		name: "comments.like_count", table: "comments", key: "id", column: "like_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("likes").
+				Where("target_type = ? AND deleted_at IS NULL", constants.TargetTypeComment), "target_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "comments.reply_count", table: "comments", key: "id", column: "reply_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("comments").Scopes(models.NormalComments).Where("deleted_at IS NULL"), "parent_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "topics.post_count", table: "topics", key: "id", column: "post_count",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("posts").Scopes(models.Published).Where("deleted_at IS NULL"), "topic_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "users.follower_count", table: "users", key: "id", column: "follower_count", where: "deleted_at IS NULL",
+		count: countFollowers,
+	},
+	{
+		name: "users.following_count", table: "users", key: "id", column: "following_count", where: "deleted_at IS NULL",
+		count: countFollowing,
+	},
+	{
+		name: "user_stats.post_count", table: "user_stats", key: "user_id", column: "post_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("posts").Scopes(models.Published).Where("deleted_at IS NULL"), "user_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "user_stats.comment_count", table: "user_stats", key: "user_id", column: "comment_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("comments").Scopes(models.NormalComments).Where("deleted_at IS NULL"), "user_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "user_stats.like_count", table: "user_stats", key: "user_id", column: "like_count", where: "deleted_at IS NULL",
+		count: countLikesReceived,
+	},
+	{
+		name: "user_stats.favorite_count", table: "user_stats", key: "user_id", column: "favorite_count", where: "deleted_at IS NULL",
+		count: func(db *gorm.DB, keys []string) (map[string]int64, error) {
+			return groupCount(db.Table("post_favorites").
+				Joins("JOIN posts ON posts.id = post_favorites.post_id AND posts.deleted_at IS NULL").
+				Where("post_favorites.deleted_at IS NULL"), "posts.user_id", "COUNT(*)", keys)
+		},
+	},
+	{
+		name: "user_stats.follower_count", table: "user_stats", key: "user_id", column: "follower_count", where: "deleted_at IS NULL",
+		count: countFollowers,
+	},
+	{
+		name: "user_stats.following_count", table: "user_stats", key: "user_id", column: "following_count", where: "deleted_at IS NULL",
+		count: countFollowing,
+	},
+}
+
+// groupCount 在 query 的基础上按 groupBy 分组统计 keys 对应的聚合值
+func groupCount(query *gorm.DB, groupBy, aggregate string, keys []string) (map[string]int64, error) {
+	var rows []struct {
+		RefID string
+		Total int64
+	}
+	err := query.
+		Select(groupBy+" AS ref_id, COALESCE("+aggregate+", 0) AS total").
+		Where(groupBy+" IN ?", keys).
+		Group(groupBy).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.RefID] = row.Total
+	}
+	return counts, nil
+}
+
+// countFollowers 统计用户的粉丝数
+func countFollowers(db *gorm.DB, keys []string) (map[string]int64, error) {
+	return groupCount(db.Table("follows").Where("deleted_at IS NULL"), "following_id", "COUNT(*)", keys)
+}
+
+// countFollowing 统计用户的关注数
+func countFollowing(db *gorm.DB, keys []string) (map[string]int64, error) {
+	return groupCount(db.Table("follows").Where("deleted_at IS NULL"), "follower_id", "COUNT(*)", keys)
+}
+
+// countLikesReceived 统计用户的帖子和评论收到的点赞数
+func countLikesReceived(db *gorm.DB, keys []string) (map[string]int64, error) {
+	postLikes, err := groupCount(db.Table("likes").
+		Joins("JOIN posts ON posts.id = likes.target_id AND posts.deleted_at IS NULL").
+		Where("likes.target_type = ? AND likes.deleted_at IS NULL", constants.TargetTypePost), "posts.user_id", "COUNT(*)", keys)
+	if err != nil {
+		return nil, err
+	}
+	commentLikes, err := groupCount(db.Table("likes").
+		Joins("JOIN comments ON comments.id = likes.target_id AND comments.deleted_at IS NULL").
+		Where("likes.target_type = ? AND likes.deleted_at IS NULL", constants.TargetTypeComment), "comments.user_id", "COUNT(*)", keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for userID, count := range commentLikes {
+		postLikes[userID] += count
+	}
+	return postLikes, nil
+}
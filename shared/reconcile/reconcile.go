@@ -0,0 +1,328 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/config"
+	"hupu/shared/log"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+const (
+	reconcileLockKey = "reconcile:lock"
+	maxDriftSamples  = 20
+
+	DefaultInterval  = 6 * time.Hour
+	DefaultBatchSize = 500
+)
+
+// Interval 定时校对间隔
+func Interval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Reconcile.IntervalMin > 0 {
+		return time.Duration(config.GlobalConfig.Reconcile.IntervalMin) * time.Minute
+	}
+	return DefaultInterval
+}
+
+// BatchSize 每批校对的行数
+func BatchSize() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Reconcile.BatchSize > 0 {
+		return config.GlobalConfig.Reconcile.BatchSize
+	}
+	return DefaultBatchSize
+}
+
+// autoFix 定时校对时是否修复偏差
+func autoFix() bool {
+	return config.GlobalConfig != nil && config.GlobalConfig.Reconcile.AutoFix
+}
+
+// CheckNames 全部校对项的名称
+func CheckNames() []string {
+	names := make([]string, 0, len(checks))
+	for _, c := range checks {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// Drift 一行冗余计数与源表统计值的偏差
+type Drift struct {
+	Key    string
+	Stored int64
+	Actual int64
+}
+
+// CheckResult 一个校对项的结果
+type CheckResult struct {
+	Name    string
+	Checked int64    // 校对的行数
+	Drifted int64    // 存在偏差的行数
+	Fixed   int64    // 已修复的行数，修复前计数又发生变化的行留到下次校对
+	Samples []*Drift // 偏差样例，最多 maxDriftSamples 条
+}
+
+// Report 一次校对的结果
+type Report struct {
+	Results          []*CheckResult
+	UserStatsMissing int64 // 缺少 user_stats 记录的用户数，修复时会补建
+}
+
+// Drifted 存在偏差的总行数
+func (r *Report) Drifted() int64 {
+	var total int64
+	for _, result := range r.Results {
+		total += result.Drifted
+	}
+	return total
+}
+
+// Options 校对选项
+type Options struct {
+	Checks    []string // 只执行指定的校对项，为空时执行全部
+	BatchSize int
+	Fix       bool // 是否修复偏差，否则只报告
+}
+
+// Reconciler 从源表重新统计点赞、评论、回复、收藏、评分、话题帖子数和用户统计等冗余计数，报告并可选修复偏差
+type Reconciler struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+}
+
+// NewReconciler 创建计数校对器，redis 只用于定时任务的多实例互斥，可以为空
+func NewReconciler(db *gorm.DB, redisClient *utils.RedisClient) *Reconciler {
+	return &Reconciler{db: db, redis: redisClient}
+}
+
+// Run 执行一次校对
+func (r *Reconciler) Run(ctx context.Context, opts Options) (*Report, error) {
+	selected, err := selectChecks(opts.Checks)
+	if err != nil {
+		return nil, err
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	report := &Report{}
+	userStatsEnsured := false
+	for _, c := range selected {
+		if c.table == "user_stats" && !userStatsEnsured {
+			if report.UserStatsMissing, err = r.ensureUserStats(ctx, opts.BatchSize, opts.Fix); err != nil {
+				return report, fmt.Errorf("ensure user stats: %w", err)
+			}
+			userStatsEnsured = true
+		}
+		result, err := r.runCheck(ctx, c, opts.BatchSize, opts.Fix)
+		if err != nil {
+			return report, fmt.Errorf("check %s: %w", c.name, err)
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// selectChecks 按名称选择校对项，保持定义顺序
+func selectChecks(names []string) ([]*counterCheck, error) {
+	if len(names) == 0 {
+		return checks, nil
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	selected := make([]*counterCheck, 0, len(names))
+	for _, c := range checks {
+		if wanted[c.name] {
+			selected = append(selected, c)
+			delete(wanted, c.name)
+		}
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("unknown check: %s", name)
+	}
+	return selected, nil
+}
+
+// runCheck 按唯一键分批校对一项计数
+// 修复时只在计数仍等于读取到的值时更新，期间被业务修改过的行留到下次校对，避免覆盖并发的增减
+func (r *Reconciler) runCheck(ctx context.Context, c *counterCheck, batchSize int, fix bool) (*CheckResult, error) {
+	result := &CheckResult{Name: c.name}
+	lastKey := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		var rows []struct {
+			RefID string
+			Total int64
+		}
+		query := r.db.WithContext(ctx).
+			Table(c.table).
+			Select(c.key+" AS ref_id, "+c.column+" AS total").
+			Where(c.key+" > ?", lastKey)
+		if c.where != "" {
+			query = query.Where(c.where)
+		}
+		if err := query.Order(c.key).Limit(batchSize).Scan(&rows).Error; err != nil {
+			return result, err
+		}
+		if len(rows) == 0 {
+			return result, nil
+		}
+
+		keys := make([]string, 0, len(rows))
+		for _, row := range rows {
+			keys = append(keys, row.RefID)
+		}
+		actual, err := c.count(r.db.WithContext(ctx), keys)
+		if err != nil {
+			return result, err
+		}
+
+		for _, row := range rows {
+			result.Checked++
+			if actual[row.RefID] == row.Total {
+				continue
+			}
+			result.Drifted++
+			if len(result.Samples) < maxDriftSamples {
+				result.Samples = append(result.Samples, &Drift{Key: row.RefID, Stored: row.Total, Actual: actual[row.RefID]})
+			}
+			if !fix {
+				continue
+			}
+			res := r.db.WithContext(ctx).
+				Table(c.table).
+				Where(c.key+" = ? AND "+c.column+" = ?", row.RefID, row.Total).
+				UpdateColumn(c.column, actual[row.RefID])
+			if res.Error != nil {
+				return result, res.Error
+			}
+			result.Fixed += res.RowsAffected
+		}
+
+		lastKey = rows[len(rows)-1].RefID
+		if len(rows) < batchSize {
+			return result, nil
+		}
+	}
+}
+
+// ensureUserStats 统计缺少 user_stats 记录的用户，fix 为 true 时补建计数为0的记录，随后由各校对项填充
+func (r *Reconciler) ensureUserStats(ctx context.Context, batchSize int, fix bool) (int64, error) {
+	var missing int64
+	lastID := ""
+	for {
+		var userIDs []string
+		err := r.db.WithContext(ctx).
+			Model(&models.User{}).
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(batchSize).
+			Pluck("id", &userIDs).Error
+		if err != nil {
+			return missing, err
+		}
+		if len(userIDs) == 0 {
+			return missing, nil
+		}
+
+		// 已删除的统计记录仍占用 user_id 唯一索引，不再补建
+		var existing []string
+		if err := r.db.WithContext(ctx).Unscoped().
+			Model(&models.UserStats{}).
+			Where("user_id IN ?", userIDs).
+			Pluck("user_id", &existing).Error; err != nil {
+			return missing, err
+		}
+		exists := make(map[string]bool, len(existing))
+		for _, userID := range existing {
+			exists[userID] = true
+		}
+
+		now := time.Now()
+		var stats []*models.UserStats
+		for _, userID := range userIDs {
+			if !exists[userID] {
+				stats = append(stats, &models.UserStats{ID: xid.New().String(), UserID: userID, CreatedAt: now, UpdatedAt: now})
+			}
+		}
+		missing += int64(len(stats))
+		if fix && len(stats) > 0 {
+			if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&stats).Error; err != nil {
+				return missing, err
+			}
+		}
+
+		lastID = userIDs[len(userIDs)-1]
+		if len(userIDs) < batchSize {
+			return missing, nil
+		}
+	}
+}
+
+// StartWorker 启动定时校对任务，ctx取消时退出
+// 是否修复由配置 reconcile.auto_fix 决定，默认只报告偏差
+func (r *Reconciler) StartWorker(ctx context.Context) {
+	logger := log.GetLogger()
+	ticker := time.NewTicker(Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// 多实例部署时只允许一个实例执行校对
+		locked, err := r.redis.SetNX(reconcileLockKey, 1, Interval()/2)
+		if err != nil {
+			logger.Errorf("Reconciler acquire lock failed: %v", err)
+			continue
+		}
+		if !locked {
+			logger.Debugf("Reconciler skipped, lock held by another instance")
+			continue
+		}
+
+		start := time.Now()
+		report, err := r.Run(ctx, Options{BatchSize: BatchSize(), Fix: autoFix()})
+		if err != nil {
+			logger.Errorf("Reconciler run failed: %v", err)
+		}
+		if report != nil {
+			logReport(report)
+		}
+		logger.Infof("Reconciler finished in %v", time.Since(start))
+	}
+}
+
+// logReport 记录校对结果，存在偏差的校对项逐条记录样例
+func logReport(report *Report) {
+	logger := log.GetLogger()
+	if report.UserStatsMissing > 0 {
+		logger.Warnf("Reconciler found %d users without user_stats", report.UserStatsMissing)
+	}
+	for _, result := range report.Results {
+		if result.Drifted == 0 {
+			logger.Infof("Reconciler %s: checked %d, no drift", result.Name, result.Checked)
+			continue
+		}
+		logger.Warnf("Reconciler %s: checked %d, drifted %d, fixed %d", result.Name, result.Checked, result.Drifted, result.Fixed)
+		for _, d := range result.Samples {
+			logger.Warnf("Reconciler %s drift: key=%s stored=%d actual=%d", result.Name, d.Key, d.Stored, d.Actual)
+		}
+	}
+}
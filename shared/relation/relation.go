@@ -0,0 +1,212 @@
+package relation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/xid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"hupu/shared/constants"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+// Relations 拉黑和屏蔽关系，供关注、帖子、评论、点赞和通知服务过滤内容和校验操作
+type Relations struct {
+	db *gorm.DB
+}
+
+// NewRelations 创建拉黑和屏蔽关系
+func NewRelations(db *gorm.DB) *Relations {
+	return &Relations{db: db}
+}
+
+// Block 拉黑用户，同时解除双方的关注关系
+func (r *Relations) Block(ctx context.Context, userID, targetID string) error {
+	if userID == targetID {
+		return fmt.Errorf("cannot block yourself")
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Block{
+			ID:        xid.New().String(),
+			UserID:    userID,
+			BlockedID: targetID,
+			CreatedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("already blocked")
+		}
+		if err := unfollow(tx, userID, targetID); err != nil {
+			return err
+		}
+		return unfollow(tx, targetID, userID)
+	})
+}
+
+// unfollow 在事务内解除关注并维护双方的关注数、粉丝数
+func unfollow(tx *gorm.DB, followerID, followingID string) error {
+	result := tx.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&models.Follow{})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return result.Error
+	}
+	if err := tx.Model(&models.User{}).Where("id = ? AND following_count > 0", followerID).
+		UpdateColumn("following_count", gorm.Expr("following_count - ?", result.RowsAffected)).Error; err != nil {
+		return err
+	}
+	return tx.Model(&models.User{}).Where("id = ? AND follower_count > 0", followingID).
+		UpdateColumn("follower_count", gorm.Expr("follower_count - ?", result.RowsAffected)).Error
+}
+
+// Unblock 取消拉黑，解除的关注关系不会恢复
+func (r *Relations) Unblock(ctx context.Context, userID, targetID string) error {
+	result := r.db.WithContext(ctx).Where("user_id = ? AND blocked_id = ?", userID, targetID).Delete(&models.Block{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("not blocked")
+	}
+	return nil
+}
+
+// Mute 屏蔽用户
+func (r *Relations) Mute(ctx context.Context, userID, targetID string) error {
+	if userID == targetID {
+		return fmt.Errorf("cannot mute yourself")
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Mute{
+		ID:        xid.New().String(),
+		UserID:    userID,
+		MutedID:   targetID,
+		CreatedAt: time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("already muted")
+	}
+	return nil
+}
+
+// Unmute 取消屏蔽
+func (r *Relations) Unmute(ctx context.Context, userID, targetID string) error {
+	result := r.db.WithContext(ctx).Where("user_id = ? AND muted_id = ?", userID, targetID).Delete(&models.Mute{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("not muted")
+	}
+	return nil
+}
+
+// ListBlocks 获取用户拉黑的记录，按 (created_at, id) 倒序，传入游标时从游标之后开始
+func (r *Relations) ListBlocks(ctx context.Context, userID string, cursor *utils.Cursor, offset, limit int) ([]*models.Block, error) {
+	var blocks []*models.Block
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Scopes(utils.TimeCursorScope("created_at", "id", cursor)).
+		Order("created_at DESC, id DESC").
+		Offset(offset).Limit(limit).Find(&blocks).Error
+	return blocks, err
+}
+
+// ListMutes 获取用户屏蔽的记录，排序和游标规则同 ListBlocks
+func (r *Relations) ListMutes(ctx context.Context, userID string, cursor *utils.Cursor, offset, limit int) ([]*models.Mute, error) {
+	var mutes []*models.Mute
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Scopes(utils.TimeCursorScope("created_at", "id", cursor)).
+		Order("created_at DESC, id DESC").
+		Offset(offset).Limit(limit).Find(&mutes).Error
+	return mutes, err
+}
+
+// BlockedByAny actorID 是否被 ownerIDs 中的任一用户拉黑，空的 ownerID 会被忽略
+func (r *Relations) BlockedByAny(ctx context.Context, actorID string, ownerIDs ...string) (bool, error) {
+	owners := make([]string, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		if id != "" && id != actorID {
+			owners = append(owners, id)
+		}
+	}
+	if actorID == "" || len(owners) == 0 {
+		return false, nil
+	}
+
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Block{}).
+		Where("user_id IN ? AND blocked_id = ?", owners, actorID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// BlockedIDs 获取用户拉黑的全部用户，这些用户触发的通知不再发给该用户
+func (r *Relations) BlockedIDs(ctx context.Context, userID string) ([]string, error) {
+	var ids []string
+	if userID == "" {
+		return ids, nil
+	}
+	err := r.db.WithContext(ctx).Model(&models.Block{}).Where("user_id = ?", userID).Pluck("blocked_id", &ids).Error
+	return ids, err
+}
+
+// HiddenIDs 获取用户拉黑和屏蔽的全部用户，这些用户的内容不出现在该用户的信息流和评论列表中
+func (r *Relations) HiddenIDs(ctx context.Context, userID string) ([]string, error) {
+	ids, err := r.BlockedIDs(ctx, userID)
+	if err != nil || userID == "" {
+		return ids, err
+	}
+	var muted []string
+	if err := r.db.WithContext(ctx).Model(&models.Mute{}).Where("user_id = ?", userID).Pluck("muted_id", &muted).Error; err != nil {
+		return nil, err
+	}
+	return append(ids, muted...), nil
+}
+
+// ContentOwner 获取帖子或评论的作者，用于拉黑校验，内容不存在或匿名发布时返回空
+// 匿名内容不做拉黑校验，以免被拉黑的用户据此推断出作者
+func (r *Relations) ContentOwner(ctx context.Context, targetType, targetID string) (string, error) {
+	var ownerIDs []string
+	query := r.db.WithContext(ctx)
+	switch targetType {
+	case constants.TargetTypePost:
+		query = query.Model(&models.Post{})
+	case constants.TargetTypeComment:
+		query = query.Model(&models.Comment{})
+	default:
+		return "", nil
+	}
+	if err := query.Where("id = ? AND is_anonymous = ?", targetID, false).Limit(1).Pluck("user_id", &ownerIDs).Error; err != nil {
+		return "", err
+	}
+	if len(ownerIDs) == 0 {
+		return "", nil
+	}
+	return ownerIDs[0], nil
+}
+
+// ExcludeUsers 排除指定用户的内容，column 为作者字段
+func ExcludeUsers(column string, userIDs []string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(userIDs) == 0 {
+			return db
+		}
+		return db.Where(column+" NOT IN ?", userIDs)
+	}
+}
+
+// ExcludeNamedUsers 同 ExcludeUsers，但保留匿名内容，以免暴露作者
+func ExcludeNamedUsers(column string, userIDs []string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(userIDs) == 0 {
+			return db
+		}
+		return db.Where("(is_anonymous = ? OR "+column+" NOT IN ?)", true, userIDs)
+	}
+}
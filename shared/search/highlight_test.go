@@ -0,0 +1,127 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"英文转小写", "Hello World", []string{"hello", "world"}},
+		{"中文二元组", "湖人夺冠", []string{"湖人", "人夺", "夺冠"}},
+		{"单个汉字", "赞", []string{"赞"}},
+		{"中英数字混排", "NBA总决赛G7", []string{"nba", "总决", "决赛", "g7"}},
+		{"标点分隔", "詹姆斯，库里!", []string{"詹姆", "姆斯", "库里"}},
+		{"日文假名", "バスケ", []string{"バス", "スケ"}},
+		{"空文本", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokenize(tt.text)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := Terms("湖人 湖人 Lakers lakers")
+	want := []string{"湖人", "lakers"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Terms = %v, want %v", got, want)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		keyword  string
+		maxRunes int
+		want     string
+	}{
+		{
+			name:    "英文不区分大小写",
+			text:    "Lakers win the title",
+			keyword: "lakers",
+			want:    "<em>Lakers</em> win the title",
+		},
+		{
+			name:    "中文命中",
+			text:    "湖人队夺冠",
+			keyword: "湖人",
+			want:    "<em>湖人</em>队夺冠",
+		},
+		{
+			name:    "相邻二元组合并为一段",
+			text:    "勒布朗詹姆斯得分",
+			keyword: "詹姆斯",
+			want:    "勒布朗<em>詹姆斯</em>得分",
+		},
+		{
+			name:    "中英混排连续命中",
+			text:    "看NBA总决赛",
+			keyword: "nba 总决赛",
+			want:    "看<em>NBA总决赛</em>",
+		},
+		{
+			name:    "多处命中",
+			text:    "湖人赢了，湖人加油",
+			keyword: "湖人",
+			want:    "<em>湖人</em>赢了，<em>湖人</em>加油",
+		},
+		{
+			name:    "HTML转义",
+			text:    "<b>湖人</b>&",
+			keyword: "湖人",
+			want:    "&lt;b&gt;<em>湖人</em>&lt;/b&gt;&amp;",
+		},
+		{
+			name:    "未命中",
+			text:    "勇士队",
+			keyword: "湖人",
+			want:    "勇士队",
+		},
+		{
+			name:     "摘要从命中词前20字开始",
+			text:     strings.Repeat("一", 30) + "湖人" + strings.Repeat("二", 30),
+			keyword:  "湖人",
+			maxRunes: 30,
+			want:     "..." + strings.Repeat("一", 20) + "<em>湖人</em>" + strings.Repeat("二", 8) + "...",
+		},
+		{
+			name:     "命中词靠前时从开头截取",
+			text:     strings.Repeat("一", 5) + "湖人" + strings.Repeat("二", 40),
+			keyword:  "湖人",
+			maxRunes: 10,
+			want:     strings.Repeat("一", 5) + "<em>湖人</em>" + strings.Repeat("二", 3) + "...",
+		},
+		{
+			name:     "命中词靠后时截取结尾",
+			text:     strings.Repeat("一", 50) + "湖人",
+			keyword:  "湖人",
+			maxRunes: 30,
+			want:     "..." + strings.Repeat("一", 28) + "<em>湖人</em>",
+		},
+		{
+			name:     "未超出长度不截取",
+			text:     "湖人队夺冠",
+			keyword:  "夺冠",
+			maxRunes: 10,
+			want:     "湖人队<em>夺冠</em>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Highlight(tt.text, Terms(tt.keyword), tt.maxRunes)
+			if got != tt.want {
+				t.Errorf("Highlight(%q, %q, %d) = %q, want %q", tt.text, tt.keyword, tt.maxRunes, got, tt.want)
+			}
+		})
+	}
+}
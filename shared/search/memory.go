@@ -0,0 +1,219 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hupu/shared/models"
+)
+
+const (
+	// titleWeight 标题和标签中的词频权重，命中标题比命中正文更相关
+	titleWeight = 3
+	// memoryLoadBatch 首次加载时每批读取的帖子数
+	memoryLoadBatch = 500
+
+	// BM25 参数
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// memoryDoc 倒排索引中的一篇帖子
+type memoryDoc struct {
+	post   *models.Post
+	terms  map[string]int // 词 -> 加权词频
+	length int
+}
+
+// MemoryBackend 进程内倒排索引搜索后端，按 BM25 计算相关度
+// 适用于测试和本地运行，首次搜索时从数据库加载全部帖子，之后随 Index 增量更新
+type MemoryBackend struct {
+	db *gorm.DB
+
+	loadOnce sync.Once
+	loadErr  error
+
+	mu          sync.RWMutex
+	docs        map[string]*memoryDoc
+	postings    map[string]map[string]int // 词 -> 帖子ID -> 加权词频
+	totalLength int
+}
+
+// NewMemoryBackend 创建进程内搜索后端，db 为空时不从数据库加载
+func NewMemoryBackend(db *gorm.DB) *MemoryBackend {
+	return &MemoryBackend{
+		db:       db,
+		docs:     make(map[string]*memoryDoc),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// load 首次使用时从数据库加载全部已发布的帖子
+func (b *MemoryBackend) load(ctx context.Context) error {
+	b.loadOnce.Do(func() {
+		if b.db == nil {
+			return
+		}
+		var batch []*models.Post
+		b.loadErr = b.db.WithContext(ctx).
+			Model(&models.Post{}).
+			Scopes(models.Published).
+			FindInBatches(&batch, memoryLoadBatch, func(tx *gorm.DB, _ int) error {
+				for _, post := range batch {
+					b.Put(post)
+				}
+				return nil
+			}).Error
+	})
+	return b.loadErr
+}
+
+func (b *MemoryBackend) Index(ctx context.Context, postID string) error {
+	if err := b.load(ctx); err != nil {
+		return err
+	}
+	if b.db == nil {
+		return nil
+	}
+
+	var post models.Post
+	err := b.db.WithContext(ctx).Scopes(models.Published).Where("id = ?", postID).First(&post).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		b.Delete(postID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	b.Put(&post)
+	return nil
+}
+
+// Put 写入或替换帖子的索引
+func (b *MemoryBackend) Put(post *models.Post) {
+	terms := make(map[string]int)
+	length := 0
+	add := func(text string, weight int) {
+		for _, token := range Tokenize(text) {
+			terms[token] += weight
+			length += weight
+		}
+	}
+	add(post.Title, titleWeight)
+	for _, tag := range post.Tags {
+		add(tag, titleWeight)
+	}
+	add(post.Content, 1)
+
+	copied := *post
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.deleteLocked(post.ID)
+	b.docs[post.ID] = &memoryDoc{post: &copied, terms: terms, length: length}
+	b.totalLength += length
+	for term, tf := range terms {
+		if b.postings[term] == nil {
+			b.postings[term] = make(map[string]int)
+		}
+		b.postings[term][post.ID] = tf
+	}
+}
+
+// Delete 从索引移除帖子
+func (b *MemoryBackend) Delete(postID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.deleteLocked(postID)
+}
+
+func (b *MemoryBackend) deleteLocked(postID string) {
+	doc, ok := b.docs[postID]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		delete(b.postings[term], postID)
+		if len(b.postings[term]) == 0 {
+			delete(b.postings, term)
+		}
+	}
+	b.totalLength -= doc.length
+	delete(b.docs, postID)
+}
+
+func (b *MemoryBackend) Search(ctx context.Context, q *Query) ([]*Hit, error) {
+	if err := b.load(ctx); err != nil {
+		return nil, err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.docs) == 0 {
+		return nil, nil
+	}
+	n := float64(len(b.docs))
+	avgLength := float64(b.totalLength) / n
+
+	excluded := make(map[string]bool, len(q.ExcludeUserIDs))
+	for _, id := range q.ExcludeUserIDs {
+		excluded[id] = true
+	}
+
+	// 按 BM25 累加每个检索词的得分
+	scores := make(map[string]float64)
+	for _, term := range Terms(q.Keyword) {
+		postings := b.postings[term]
+		if len(postings) == 0 {
+			continue
+		}
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for postID, tf := range postings {
+			doc := b.docs[postID]
+			if q.Category != "" && strconv.Itoa(int(doc.post.Category)) != q.Category {
+				continue
+			}
+			if !doc.post.IsAnonymous && excluded[doc.post.UserID] {
+				continue
+			}
+			norm := float64(tf) + bm25K1*(1-bm25B+bm25B*float64(doc.length)/avgLength)
+			scores[postID] += idf * float64(tf) * (bm25K1 + 1) / norm
+		}
+	}
+
+	now := time.Now()
+	hits := make([]*Hit, 0, len(scores))
+	for postID, score := range scores {
+		post := *b.docs[postID].post
+		hits = append(hits, &Hit{Post: &post, Relevance: score * boost(&post, now)})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		a, c := hits[i], hits[j]
+		if q.Sort != SortLatest && a.Relevance != c.Relevance {
+			return a.Relevance > c.Relevance
+		}
+		if !a.Post.CreatedAt.Equal(c.Post.CreatedAt) {
+			return a.Post.CreatedAt.After(c.Post.CreatedAt)
+		}
+		return a.Post.ID > c.Post.ID
+	})
+
+	offset := pageOffset(q)
+	if offset >= len(hits) {
+		return nil, nil
+	}
+	end := offset + int(q.PageSize) + 1 // 多取一条用于判断 has_more
+	if end > len(hits) {
+		end = len(hits)
+	}
+	return hits[offset:end], nil
+}
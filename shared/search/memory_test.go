@@ -0,0 +1,177 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"hupu/shared/models"
+)
+
+// newTestBackend 用给定帖子构建进程内索引，发布时间相同，排序只由相关度决定
+func newTestBackend(posts ...*models.Post) *MemoryBackend {
+	b := NewMemoryBackend(nil)
+	createdAt := time.Now().Add(-time.Hour)
+	for _, p := range posts {
+		if p.CreatedAt.IsZero() {
+			p.CreatedAt = createdAt
+		}
+		b.Put(p)
+	}
+	return b
+}
+
+func hitIDs(hits []*Hit) string {
+	ids := make([]string, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.Post.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestMemoryBackendRelevanceOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		posts   []*models.Post
+		keyword string
+		want    string
+	}{
+		{
+			name: "标题命中排在正文命中之前",
+			posts: []*models.Post{
+				{ID: "body", Title: "今日赛况", Content: "湖人客场取胜"},
+				{ID: "title", Title: "湖人客场取胜", Content: "今日赛况"},
+			},
+			keyword: "湖人",
+			want:    "title,body",
+		},
+		{
+			name: "标签与标题同权",
+			posts: []*models.Post{
+				{ID: "body", Title: "赛后", Content: "湖人"},
+				{ID: "tag", Title: "赛后", Content: "比赛", Tags: models.StringArray{"湖人"}},
+			},
+			keyword: "湖人",
+			want:    "tag,body",
+		},
+		{
+			name: "命中更多检索词的排前面",
+			posts: []*models.Post{
+				{ID: "one", Title: "湖人新赛季", Content: "球队阵容"},
+				{ID: "both", Title: "湖人新赛季", Content: "詹姆斯领衔阵容"},
+				{ID: "none", Title: "勇士新赛季", Content: "库里领衔阵容"},
+			},
+			keyword: "湖人 詹姆斯",
+			want:    "both,one",
+		},
+		{
+			name: "完整命中中文词的排在部分命中之前",
+			posts: []*models.Post{
+				{ID: "partial", Title: "詹姆士", Content: "球员"},
+				{ID: "full", Title: "詹姆斯", Content: "球员"},
+			},
+			keyword: "詹姆斯",
+			want:    "full,partial",
+		},
+		{
+			name: "稀有词权重更高",
+			posts: []*models.Post{
+				{ID: "c1", Title: "篮球", Content: "比赛"},
+				{ID: "rare", Title: "冰球", Content: "比赛"},
+				{ID: "c2", Title: "篮球", Content: "训练"},
+				{ID: "c3", Title: "篮球", Content: "新闻"},
+			},
+			keyword: "篮球 冰球",
+			// 相关度和发布时间相同时按ID倒序
+			want: "rare,c3,c2,c1",
+		},
+		{
+			name: "英文不区分大小写",
+			posts: []*models.Post{
+				{ID: "upper", Title: "LAKERS", Content: "win"},
+				{ID: "lower", Title: "game", Content: "lakers"},
+			},
+			keyword: "Lakers",
+			want:    "upper,lower",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(tt.posts...)
+			hits, err := b.Search(context.Background(), &Query{Keyword: tt.keyword, Page: 1, PageSize: 10})
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if got := hitIDs(hits); got != tt.want {
+				t.Errorf("Search(%q) = %s, want %s", tt.keyword, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryBackendSortLatestAndCategory(t *testing.T) {
+	now := time.Now()
+	b := newTestBackend(
+		&models.Post{ID: "old", Title: "湖人湖人湖人", Content: "湖人", Category: 1, CreatedAt: now.Add(-48 * time.Hour)},
+		&models.Post{ID: "new", Title: "比赛", Content: "湖人", Category: 2, CreatedAt: now.Add(-time.Hour)},
+	)
+
+	hits, err := b.Search(context.Background(), &Query{Keyword: "湖人", Sort: SortRelevance, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "old,new" {
+		t.Errorf("relevance order = %s, want old,new", got)
+	}
+
+	hits, err = b.Search(context.Background(), &Query{Keyword: "湖人", Sort: SortLatest, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "new,old" {
+		t.Errorf("latest order = %s, want new,old", got)
+	}
+
+	hits, err = b.Search(context.Background(), &Query{Keyword: "湖人", Category: "2", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "new" {
+		t.Errorf("category 2 = %s, want new", got)
+	}
+}
+
+func TestMemoryBackendPagingAndDelete(t *testing.T) {
+	b := newTestBackend(
+		&models.Post{ID: "a", Title: "湖人湖人湖人", Content: "湖人"},
+		&models.Post{ID: "b", Title: "湖人湖人", Content: "比赛"},
+		&models.Post{ID: "c", Title: "比赛", Content: "湖人"},
+	)
+
+	// 每页多取一条用于判断 has_more
+	hits, err := b.Search(context.Background(), &Query{Keyword: "湖人", Page: 1, PageSize: 1})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "a,b" {
+		t.Errorf("page 1 = %s, want a,b", got)
+	}
+	hits, err = b.Search(context.Background(), &Query{Keyword: "湖人", Page: 3, PageSize: 1})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "c" {
+		t.Errorf("page 3 = %s, want c", got)
+	}
+
+	b.Delete("a")
+	b.Put(&models.Post{ID: "c", Title: "勇士", Content: "库里", CreatedAt: time.Now()})
+	hits, err = b.Search(context.Background(), &Query{Keyword: "湖人", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := hitIDs(hits); got != "b" {
+		t.Errorf("after delete and update = %s, want b", got)
+	}
+}
@@ -0,0 +1,88 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"hupu/shared/models"
+	"hupu/shared/relation"
+)
+
+// matchExpr 使用 idx_posts_title_content 全文索引（ngram 分词）的匹配表达式
+const matchExpr = "MATCH(posts.title, posts.content) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+// tagMatchScore 标签完全命中时额外增加的相关度
+const tagMatchScore = 1.0
+
+// MySQLBackend 基于 MySQL FULLTEXT 索引的搜索后端
+// 帖子写入即被索引，Index 无需额外操作
+type MySQLBackend struct {
+	db *gorm.DB
+}
+
+// NewMySQLBackend 创建 MySQL 全文搜索后端
+func NewMySQLBackend(db *gorm.DB) *MySQLBackend {
+	return &MySQLBackend{db: db}
+}
+
+func (b *MySQLBackend) Index(ctx context.Context, postID string) error {
+	return nil
+}
+
+// scoredPost 带相关度的查询结果
+type scoredPost struct {
+	models.Post
+	Relevance float64
+}
+
+func (b *MySQLBackend) Search(ctx context.Context, q *Query) ([]*Hit, error) {
+	// 搜索词按标签规范化后与帖子的标签关联匹配，标签名唯一，每个帖子最多关联一行
+	relevance := fmt.Sprintf("(%s + IF(keyword_tag.post_id IS NOT NULL, %f, 0)) * %s",
+		matchExpr, tagMatchScore, boostExpr())
+
+	query := b.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Scopes(models.Published).
+		Select("posts.*, "+relevance+" AS relevance", q.Keyword).
+		Joins("LEFT JOIN tags ON tags.name = ?", NormalizeTag(q.Keyword)).
+		Joins("LEFT JOIN post_tags keyword_tag ON keyword_tag.tag_id = tags.id AND keyword_tag.post_id = posts.id").
+		Where(matchExpr+" OR keyword_tag.post_id IS NOT NULL", q.Keyword)
+
+	// 添加分类筛选
+	if q.Category != "" {
+		query = query.Where("posts.category = ?", q.Category)
+	}
+	query = query.Scopes(relation.ExcludeNamedUsers("posts.user_id", q.ExcludeUserIDs))
+
+	switch q.Sort {
+	case SortLatest:
+		query = query.Order("posts.created_at DESC, posts.id DESC")
+	default:
+		query = query.Order("relevance DESC, posts.created_at DESC, posts.id DESC")
+	}
+
+	var rows []*scoredPost
+	err := query.
+		Offset(pageOffset(q)).
+		Limit(int(q.PageSize) + 1). // 多取一条用于判断 has_more
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*Hit, 0, len(rows))
+	for _, row := range rows {
+		post := row.Post
+		hits = append(hits, &Hit{Post: &post, Relevance: row.Relevance})
+	}
+	return hits, nil
+}
+
+// boostExpr 与 boost 等价的 SQL 表达式
+func boostExpr() string {
+	return fmt.Sprintf("(1 + %f * POW(0.5, TIMESTAMPDIFF(HOUR, posts.created_at, NOW()) / %d)"+
+		" + %f * IF(posts.rating_count > 0, posts.rating_sum / posts.rating_count / %d, 0))",
+		recencyBoost(), recencyHalfLifeHours, scoreBoost(), models.RatingScoreMax)
+}
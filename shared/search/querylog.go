@@ -0,0 +1,204 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+
+	"hupu/shared/utils"
+)
+
+const (
+	queryHourKeyPrefix = "search:query:hour:"
+	queryTrendingKey   = "search:query:trending"
+	querySeenKeyPrefix = "search:query:seen:"
+
+	// queryWindowHours 热搜统计最近多少小时的搜索
+	queryWindowHours = 24
+	// queryHalfLifeHours 热搜按小时衰减，6小时前的一次搜索算半次
+	queryHalfLifeHours = 6
+	queryHourTTL       = (queryWindowHours + 1) * time.Hour
+	queryTrendingTTL   = time.Minute
+	// queryMaxRunes 超长的搜索词不计入统计
+	queryMaxRunes = 50
+	// queryPrefixScan 按前缀匹配搜索词时扫描的热搜条数
+	queryPrefixScan = 200
+	// queryMinScore 进入热搜和搜索建议的最低热度，同一人24小时内搜索同一个词只计一次，约为最少搜索人数
+	queryMinScore = 3
+)
+
+// TrendingQuery 热门搜索词
+type TrendingQuery struct {
+	Query string
+	Score float64
+}
+
+// QueryLog 按小时分桶记录搜索词，用于热搜和搜索建议
+type QueryLog struct {
+	redis *utils.RedisClient
+	// allow 搜索词能否公开展示，含敏感词的搜索词不计入统计也不展示
+	allow func(q string) bool
+}
+
+// NewQueryLog 创建搜索词记录器，allow 为空时不过滤
+func NewQueryLog(redisClient *utils.RedisClient, allow func(q string) bool) *QueryLog {
+	return &QueryLog{redis: redisClient, allow: allow}
+}
+
+func (l *QueryLog) allowed(q string) bool {
+	return l.allow == nil || l.allow(q)
+}
+
+// NormalizeQuery 规范化搜索词：去掉首尾空白、合并连续空白并转为小写
+func NormalizeQuery(q string) string {
+	return strings.ToLower(strings.Join(strings.Fields(q), " "))
+}
+
+func queryHourKey(t time.Time) string {
+	return queryHourKeyPrefix + t.Format("2006010215")
+}
+
+// querySeenKey 搜索者搜索过某个词的去重key
+func querySeenKey(searcher, q string) string {
+	sum := fnv.New64a()
+	sum.Write([]byte(searcher + "\x00" + q))
+	return fmt.Sprintf("%s%x", querySeenKeyPrefix, sum.Sum64())
+}
+
+// Record 记录一次搜索，searcher 为搜索者标识，同一搜索者24小时内搜索同一个词只计一次
+func (l *QueryLog) Record(ctx context.Context, q, searcher string) error {
+	q = NormalizeQuery(q)
+	if q == "" || searcher == "" || utf8.RuneCountInString(q) > queryMaxRunes || !l.allowed(q) {
+		return nil
+	}
+
+	first, err := l.redis.SetNX(querySeenKey(searcher, q), 1, queryWindowHours*time.Hour)
+	if err != nil || !first {
+		return err
+	}
+
+	key := queryHourKey(time.Now())
+	pipe := l.redis.Pipeline()
+	pipe.ZIncrBy(ctx, key, 1, q)
+	pipe.Expire(ctx, key, queryHourTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// Trending 最近24小时的热门搜索词，越近的搜索权重越高
+// 热度低于 queryMinScore 的搜索词不展示，记录后才加入词库的敏感词在展示时过滤
+func (l *QueryLog) Trending(ctx context.Context, limit int) ([]*TrendingQuery, error) {
+	if err := l.refreshTrending(); err != nil {
+		return nil, err
+	}
+
+	results, err := l.redis.ZRevRangeByScoreWithScores(queryTrendingKey, &redis.ZRangeBy{
+		Max:   "+inf",
+		Min:   fmt.Sprint(queryMinScore),
+		Count: int64(limit + queryPrefixScan),
+	})
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]*TrendingQuery, 0, limit)
+	for _, z := range results {
+		if len(queries) >= limit {
+			break
+		}
+		member, ok := z.Member.(string)
+		if !ok || !l.allowed(member) {
+			continue
+		}
+		queries = append(queries, &TrendingQuery{Query: member, Score: z.Score})
+	}
+	return queries, nil
+}
+
+// Prefix 以 prefix 开头的热门搜索词
+func (l *QueryLog) Prefix(ctx context.Context, prefix string, limit int) ([]string, error) {
+	prefix = NormalizeQuery(prefix)
+	trending, err := l.Trending(ctx, queryPrefixScan)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []string
+	for _, t := range trending {
+		if len(matched) >= limit {
+			break
+		}
+		if strings.HasPrefix(t.Query, prefix) && t.Query != prefix {
+			matched = append(matched, t.Query)
+		}
+	}
+	return matched, nil
+}
+
+// refreshTrending 合并最近24个小时桶，结果缓存1分钟
+func (l *QueryLog) refreshTrending() error {
+	exists, err := l.redis.Exists(queryTrendingKey)
+	if err != nil || exists > 0 {
+		return err
+	}
+
+	now := time.Now()
+	store := &redis.ZStore{Aggregate: "SUM"}
+	for i := 0; i < queryWindowHours; i++ {
+		store.Keys = append(store.Keys, queryHourKey(now.Add(-time.Duration(i)*time.Hour)))
+		store.Weights = append(store.Weights, math.Pow(0.5, float64(i)/queryHalfLifeHours))
+	}
+	if _, err := l.redis.ZUnionStore(queryTrendingKey, store); err != nil {
+		return err
+	}
+	_, err = l.redis.Expire(queryTrendingKey, queryTrendingTTL)
+	return err
+}
+
+// 搜索建议来源
+const (
+	SuggestionTopic = "topic"
+	SuggestionTag   = "tag"
+	SuggestionQuery = "query"
+	SuggestionPost  = "post"
+)
+
+// Suggestion 一条搜索建议
+type Suggestion struct {
+	Text string
+	Type string
+}
+
+// MergeSuggestions 按传入顺序合并各来源的建议，忽略大小写去重，最多返回 limit 条
+func MergeSuggestions(limit int, groups ...[]*Suggestion) []*Suggestion {
+	seen := make(map[string]bool)
+	merged := make([]*Suggestion, 0, limit)
+	for _, group := range groups {
+		for _, s := range group {
+			if len(merged) >= limit {
+				return merged
+			}
+			key := NormalizeQuery(s.Text)
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
+
+// NewSuggestions 将同一来源的文本转换为建议
+func NewSuggestions(texts []string, typ string) []*Suggestion {
+	suggestions := make([]*Suggestion, 0, len(texts))
+	for _, text := range texts {
+		suggestions = append(suggestions, &Suggestion{Text: text, Type: typ})
+	}
+	return suggestions
+}
@@ -0,0 +1,140 @@
+package search
+
+import (
+	"math"
+	"sort"
+
+	"hupu/shared/models"
+)
+
+// 相关帖子打分权重，标签重合度和文本相似度取值 0-1
+const (
+	relatedWeightTag      = 3.0
+	relatedWeightTopic    = 2.0
+	relatedWeightCategory = 0.5
+	relatedWeightText     = 4.0
+)
+
+// RankRelated 按标签、话题、分类的重合度和标题内容的 TF-IDF 相似度为候选帖子打分，
+// 返回得分最高的 limit 条，候选中的目标帖子本身会被忽略
+func RankRelated(target *models.Post, candidates []*models.Post, limit int) []*models.Post {
+	type scored struct {
+		post  *models.Post
+		score float64
+	}
+
+	// IDF 在目标帖子和候选帖子组成的语料上计算
+	vectors := make([]map[string]float64, len(candidates))
+	df := make(map[string]int)
+	targetTF := termFrequency(target)
+	for term := range targetTF {
+		df[term]++
+	}
+	for i, c := range candidates {
+		vectors[i] = termFrequency(c)
+		for term := range vectors[i] {
+			df[term]++
+		}
+	}
+	n := float64(len(candidates) + 1)
+	idf := func(term string) float64 {
+		return math.Log((n+1)/(float64(df[term])+1)) + 1
+	}
+	targetVec := weighTerms(targetTF, idf)
+
+	results := make([]scored, 0, len(candidates))
+	for i, c := range candidates {
+		if c.ID == target.ID {
+			continue
+		}
+		score := relatedWeightTag*tagOverlap(target.Tags, c.Tags) +
+			relatedWeightText*cosine(targetVec, weighTerms(vectors[i], idf))
+		if target.TopicID != nil && c.TopicID != nil && *target.TopicID == *c.TopicID {
+			score += relatedWeightTopic
+		}
+		if target.Category == c.Category {
+			score += relatedWeightCategory
+		}
+		results = append(results, scored{post: c, score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+		return results[i].post.CreatedAt.After(results[j].post.CreatedAt)
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	posts := make([]*models.Post, 0, len(results))
+	for _, r := range results {
+		posts = append(posts, r.post)
+	}
+	return posts
+}
+
+// termFrequency 标题和正文的加权词频
+func termFrequency(post *models.Post) map[string]float64 {
+	tf := make(map[string]float64)
+	for _, token := range Tokenize(post.Title) {
+		tf[token] += titleWeight
+	}
+	for _, token := range Tokenize(post.Content) {
+		tf[token]++
+	}
+	return tf
+}
+
+func weighTerms(tf map[string]float64, idf func(string) float64) map[string]float64 {
+	vec := make(map[string]float64, len(tf))
+	for term, freq := range tf {
+		vec[term] = freq * idf(term)
+	}
+	return vec
+}
+
+// cosine 两个稀疏向量的余弦相似度
+func cosine(a, b map[string]float64) float64 {
+	var dot, normA, normB float64
+	for term, wa := range a {
+		normA += wa * wa
+		if wb, ok := b[term]; ok {
+			dot += wa * wb
+		}
+	}
+	for _, wb := range b {
+		normB += wb * wb
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// tagOverlap 标签的 Jaccard 相似度
+func tagOverlap(a, b models.StringArray) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]bool, len(a))
+	for _, tag := range a {
+		set[tag] = true
+	}
+	inter := 0
+	union := len(set)
+	seen := make(map[string]bool, len(b))
+	for _, tag := range b {
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		if set[tag] {
+			inter++
+		} else {
+			union++
+		}
+	}
+	return float64(inter) / float64(union)
+}
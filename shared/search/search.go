@@ -0,0 +1,98 @@
+package search
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/models"
+)
+
+// 搜索后端类型
+const (
+	BackendMySQL  = "mysql"
+	BackendMemory = "memory"
+)
+
+// 搜索结果排序方式
+const (
+	SortRelevance = "relevance"
+	SortLatest    = "latest"
+)
+
+const (
+	DefaultRecencyBoost = 0.5
+	DefaultScoreBoost   = 0.3
+
+	// recencyHalfLifeHours 时间加权的半衰期，发布一周后时间加权减半
+	recencyHalfLifeHours = 7 * 24
+)
+
+// Query 帖子搜索条件
+type Query struct {
+	Keyword        string
+	Category       string
+	Sort           string
+	Page           int32
+	PageSize       int32
+	ExcludeUserIDs []string // 查看者拉黑和屏蔽的用户，其匿名帖子不排除
+}
+
+// Hit 一条搜索结果
+type Hit struct {
+	Post      *models.Post
+	Relevance float64 // 加权后的相关度，按时间排序时同样返回
+}
+
+// SearchBackend 帖子搜索后端
+// Search 按页多取一条结果，调用方据此判断 has_more
+type SearchBackend interface {
+	// Index 同步帖子到索引，帖子不存在、已删除或尚未发布时从索引移除
+	Index(ctx context.Context, postID string) error
+	// Search 按相关度或时间返回一页结果
+	Search(ctx context.Context, q *Query) ([]*Hit, error)
+}
+
+// NewSearchBackend 按配置创建搜索后端，默认使用 MySQL 全文索引
+func NewSearchBackend(db *gorm.DB) SearchBackend {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.SearchBackend == BackendMemory {
+		return NewMemoryBackend(db)
+	}
+	return NewMySQLBackend(db)
+}
+
+func recencyBoost() float64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.SearchRecencyBoost > 0 {
+		return config.GlobalConfig.Post.SearchRecencyBoost
+	}
+	return DefaultRecencyBoost
+}
+
+func scoreBoost() float64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.SearchScoreBoost > 0 {
+		return config.GlobalConfig.Post.SearchScoreBoost
+	}
+	return DefaultScoreBoost
+}
+
+// boost 相关度加权系数：1 + 时间加权 × 时间衰减 + 评分加权 × 归一化平均分
+// 新帖和高分帖在相关度接近时排在前面，但不会压过明显更相关的结果
+func boost(post *models.Post, now time.Time) float64 {
+	ageHours := now.Sub(post.CreatedAt).Hours()
+	if ageHours < 0 {
+		ageHours = 0
+	}
+	recency := math.Pow(0.5, ageHours/recencyHalfLifeHours)
+	score := post.AverageScore() / float64(models.RatingScoreMax)
+	return 1 + recencyBoost()*recency + scoreBoost()*score
+}
+
+func pageOffset(q *Query) int {
+	if q.Page < 1 {
+		return 0
+	}
+	return int((q.Page - 1) * q.PageSize)
+}
@@ -0,0 +1,101 @@
+package search
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"hupu/shared/config"
+	"hupu/shared/models"
+)
+
+var (
+	tagSynonymsOnce sync.Once
+	tagSynonyms     map[string]string
+)
+
+// foldWidth 全角字符转为半角，全角空格转为普通空格
+func foldWidth(r rune) rune {
+	switch {
+	case r == '　':
+		return ' '
+	case r >= '！' && r <= '～':
+		return r - 0xFEE0
+	default:
+		return r
+	}
+}
+
+// normalizeTagForm 规范化标签的书写形式：全角转半角、去掉开头的#、合并空白并转为小写
+func normalizeTagForm(tag string) string {
+	tag = strings.Map(foldWidth, tag)
+	tag = strings.TrimLeft(strings.TrimSpace(tag), "#")
+	return strings.Map(unicode.ToLower, strings.Join(strings.Fields(tag), " "))
+}
+
+// synonyms 配置中的同义词表，键和值都按书写形式规范化
+func synonyms() map[string]string {
+	tagSynonymsOnce.Do(func() {
+		tagSynonyms = make(map[string]string)
+		if config.GlobalConfig == nil {
+			return
+		}
+		for from, to := range config.GlobalConfig.Tag.Synonyms {
+			from, to = normalizeTagForm(from), normalizeTagForm(to)
+			if from != "" && to != "" && from != to {
+				tagSynonyms[from] = to
+			}
+		}
+	})
+	return tagSynonyms
+}
+
+// NormalizeTag 规范化标签：统一全半角和大小写，并将同义词替换为标准标签名
+func NormalizeTag(tag string) string {
+	tag = normalizeTagForm(tag)
+	if canonical, ok := synonyms()[tag]; ok {
+		return canonical
+	}
+	return tag
+}
+
+// NormalizeTags 规范化标签列表，去掉空标签和规范化后重复的标签，保持原有顺序
+// 存在超过长度限制的标签时 ok 为 false
+func NormalizeTags(tags []string) (normalized []string, ok bool) {
+	normalized = make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > models.TagMaxLength {
+			return nil, false
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, true
+}
+
+// DiffTags 返回 newTags 中新增的标签和 oldTags 中被移除的标签
+func DiffTags(oldTags, newTags []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(oldTags))
+	for _, tag := range oldTags {
+		oldSet[tag] = true
+	}
+	newSet := make(map[string]bool, len(newTags))
+	for _, tag := range newTags {
+		newSet[tag] = true
+		if !oldSet[tag] {
+			added = append(added, tag)
+		}
+	}
+	for _, tag := range oldTags {
+		if !newSet[tag] {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
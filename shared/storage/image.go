@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	MaxImages = 9 // 帖子和评论最多附带的图片数
+
+	imagePrefix     = "images/"
+	thumbnailPrefix = "thumbs/"
+
+	maxThumbnailPixels = 40 << 20 // 解码前按尺寸拒绝像素过多的图片
+	thumbnailQuality   = 85
+)
+
+// imageExts 允许上传的图片类型及对应的扩展名，缩略图只能处理标准库可解码的格式
+var imageExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// IsImageType 是否为允许上传的图片类型
+func IsImageType(contentType string) bool {
+	_, ok := imageExts[contentType]
+	return ok
+}
+
+// NewImageKey 为用户生成图片 key：images/<user_id>/<yyyymmdd>/<xid><ext>
+func NewImageKey(userID, contentType string) (string, error) {
+	ext, ok := imageExts[contentType]
+	if !ok {
+		return "", fmt.Errorf("unsupported image type: %s", contentType)
+	}
+	if userID == "" || strings.ContainsAny(userID, "/.") {
+		return "", fmt.Errorf("invalid user id")
+	}
+	return imagePrefix + userID + "/" + time.Now().Format("20060102") + "/" + xid.New().String() + ext, nil
+}
+
+// OwnsImage key 是否为该用户上传的图片
+func OwnsImage(userID, key string) bool {
+	return userID != "" && strings.HasPrefix(key, imagePrefix+userID+"/") && !strings.Contains(key, "..")
+}
+
+// IsLegacyURL 是否为接入对象存储前直接保存的图片地址
+func IsLegacyURL(image string) bool {
+	return strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://")
+}
+
+// ThumbnailKey 图片对应的缩略图 key
+func ThumbnailKey(key string) string {
+	name := strings.TrimPrefix(key, imagePrefix)
+	return thumbnailPrefix + strings.TrimSuffix(name, path.Ext(name)) + ".jpg"
+}
+
+// ImageURL 图片 key 转为访问地址，旧数据中的地址原样返回
+func ImageURL(image string) string {
+	if image == "" || IsLegacyURL(image) {
+		return image
+	}
+	store, err := Default()
+	if err != nil {
+		return image
+	}
+	return store.URL(image)
+}
+
+// ImageURLs 批量转换图片访问地址
+func ImageURLs(images []string) []string {
+	if images == nil {
+		return nil
+	}
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, ImageURL(image))
+	}
+	return urls
+}
+
+// ThumbnailURLs 九宫格缩略图地址，与 images 一一对应，缩略图未生成时使用原图地址
+func ThumbnailURLs(images, thumbnails []string) []string {
+	if images == nil {
+		return nil
+	}
+	urls := make([]string, 0, len(images))
+	for i, image := range images {
+		if i < len(thumbnails) && thumbnails[i] != "" {
+			urls = append(urls, ImageURL(thumbnails[i]))
+			continue
+		}
+		urls = append(urls, ImageURL(image))
+	}
+	return urls
+}
+
+// NormalizeImages 将客户端回传的本站图片地址还原为 key，其他值原样保留
+func NormalizeImages(images []string) []string {
+	store, err := Default()
+	if err != nil || images == nil {
+		return images
+	}
+	prefix := store.URL("")
+	normalized := make([]string, 0, len(images))
+	for _, image := range images {
+		if strings.HasPrefix(image, prefix) {
+			if key, err := url.PathUnescape(strings.TrimPrefix(image, prefix)); err == nil {
+				image = key
+			}
+		}
+		normalized = append(normalized, image)
+	}
+	return normalized
+}
+
+// ValidateImages 校验图片 key 属于 userID、已上传且是大小不超限的图片，existing 中的图片视为已校验
+func ValidateImages(ctx context.Context, store BlobStore, userID string, images, existing []string) error {
+	if len(images) > MaxImages {
+		return fmt.Errorf("最多上传%d张图片", MaxImages)
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, image := range existing {
+		known[image] = true
+	}
+	for _, image := range images {
+		if known[image] {
+			continue
+		}
+		if !OwnsImage(userID, image) {
+			return fmt.Errorf("图片不属于当前用户: %s", image)
+		}
+		info, err := store.Stat(ctx, image)
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("图片未上传: %s", image)
+		}
+		if err != nil {
+			return fmt.Errorf("图片校验失败: %w", err)
+		}
+		if !IsImageType(info.ContentType) {
+			return fmt.Errorf("图片格式不支持: %s", image)
+		}
+		if info.Size > MaxImageSize() {
+			return fmt.Errorf("图片大小超过限制: %s", image)
+		}
+		known[image] = true
+	}
+	return nil
+}
+
+// GenerateThumbnail 居中裁剪为正方形并缩放到 size，以 JPEG 写入 ThumbnailKey(key)，返回缩略图 key
+func GenerateThumbnail(ctx context.Context, store BlobStore, key string, size int) (string, error) {
+	rc, err := store.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(io.LimitReader(rc, MaxImageSize()+1))
+	rc.Close()
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) > MaxImageSize() {
+		return "", fmt.Errorf("image too large: %s", key)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width*cfg.Height > maxThumbnailPixels {
+		return "", fmt.Errorf("image too many pixels: %dx%d", cfg.Width, cfg.Height)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, squareThumbnail(src, size), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return "", err
+	}
+	thumbKey := ThumbnailKey(key)
+	if err := store.Put(ctx, thumbKey, &buf, int64(buf.Len()), "image/jpeg"); err != nil {
+		return "", err
+	}
+	return thumbKey, nil
+}
+
+// squareThumbnail 居中裁剪并按区域均值缩放，不放大小图，透明部分铺白底
+func squareThumbnail(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	size = min(size, side)
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for dy := 0; dy < size; dy++ {
+		sy0, sy1 := y0+dy*side/size, y0+(dy+1)*side/size
+		for dx := 0; dx < size; dx++ {
+			sx0, sx1 := x0+dx*side/size, x0+(dx+1)*side/size
+
+			var r, g, bl, a, n uint64
+			for y := sy0; y < sy1; y++ {
+				for x := sx0; x < sx1; x++ {
+					pr, pg, pb, pa := src.At(x, y).RGBA()
+					r, g, bl, a = r+uint64(pr), g+uint64(pg), bl+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			r, g, bl, a = r/n, g/n, bl/n, a/n
+			white := 0xffff - a
+			dst.SetRGBA64(dx, dy, color.RGBA64{
+				R: uint16(r + white),
+				G: uint16(g + white),
+				B: uint16(bl + white),
+				A: 0xffff,
+			})
+		}
+	}
+	return dst
+}
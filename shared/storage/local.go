@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalStore 本地文件系统对象存储，用于开发和测试
+// 上传地址指向网关的本地上传接口，由网关校验签名后写入
+type LocalStore struct {
+	root      string
+	publicURL string
+	secret    []byte
+}
+
+// NewLocalStore 创建本地对象存储，publicURL 为网关本地上传接口的地址
+func NewLocalStore(root, publicURL, secret string) (*LocalStore, error) {
+	if root == "" || publicURL == "" || secret == "" {
+		return nil, fmt.Errorf("local storage config incomplete")
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalStore{root: abs, publicURL: publicURL, secret: []byte(secret)}, nil
+}
+
+// resolve key 对应的文件路径，key 中的 .. 不会越出存储目录
+func (s *LocalStore) resolve(key string) (string, error) {
+	cleaned := strings.TrimPrefix(path.Clean("/"+key), "/")
+	if cleaned == "" {
+		return "", fmt.Errorf("invalid key: %s", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+// sign 计算上传签名
+func (s *LocalStore) sign(key, contentType, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(http.MethodPut + "\n" + contentType + "\n" + expires + "\n" + key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PresignPut 签发本地上传地址
+func (s *LocalStore) PresignPut(ctx context.Context, key, contentType string, expires time.Duration) (*UploadTicket, error) {
+	expiresAt := time.Now().Add(expires)
+	expiresStr := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expiresStr)
+	query.Set("signature", s.sign(key, contentType, expiresStr))
+
+	return &UploadTicket{
+		Key:       key,
+		URL:       joinURL(s.publicURL, key) + "?" + query.Encode(),
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// VerifyUpload 校验本地上传地址的签名和有效期
+func (s *LocalStore) VerifyUpload(key, contentType, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid expires")
+	}
+	if time.Now().Unix() > expiresAt {
+		return fmt.Errorf("upload ticket expired")
+	}
+	if !hmac.Equal([]byte(s.sign(key, contentType, expires)), []byte(signature)) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// Put 写入文件，先写临时文件再重命名，避免读到写了一半的文件
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	filePath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+// Get 打开文件，调用方负责关闭
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := f.Stat(); err != nil || fi.IsDir() {
+		f.Close()
+		return nil, ErrNotFound
+	}
+	return f, nil
+}
+
+// Stat 获取文件大小，文件类型按内容识别
+func (s *LocalStore) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	f, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	file := f.(*os.File)
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size:        fi.Size(),
+		ContentType: http.DetectContentType(head[:n]),
+	}, nil
+}
+
+// URL 文件的访问地址
+func (s *LocalStore) URL(key string) string {
+	return joinURL(s.publicURL, key)
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"hupu/shared/config"
+)
+
+const ossRequestTimeout = 30 * time.Second
+
+// OSSStore 兼容阿里云 OSS 的对象存储，使用 V1 签名直接调用 REST 接口
+type OSSStore struct {
+	scheme    string
+	endpoint  string // 不含协议的地域节点
+	bucket    string
+	accessKey string
+	secretKey string
+	publicURL string
+	client    *http.Client
+}
+
+// NewOSSStore 创建 OSS 对象存储，publicURL 为空时使用 bucket 域名访问对象
+func NewOSSStore(cfg config.OSSConfig, publicURL string) (*OSSStore, error) {
+	if cfg.Endpoint == "" || cfg.BucketName == "" || cfg.AccessKeyID == "" || cfg.AccessKeySecret == "" {
+		return nil, fmt.Errorf("oss config incomplete")
+	}
+
+	scheme, endpoint := "https", cfg.Endpoint
+	if u, err := url.Parse(cfg.Endpoint); err == nil && u.Host != "" {
+		scheme, endpoint = u.Scheme, u.Host
+	}
+	s := &OSSStore{
+		scheme:    scheme,
+		endpoint:  strings.TrimRight(endpoint, "/"),
+		bucket:    cfg.BucketName,
+		accessKey: cfg.AccessKeyID,
+		secretKey: cfg.AccessKeySecret,
+		publicURL: publicURL,
+		client:    &http.Client{Timeout: ossRequestTimeout},
+	}
+	if s.publicURL == "" {
+		s.publicURL = s.scheme + "://" + s.bucket + "." + s.endpoint
+	}
+	return s, nil
+}
+
+// objectURL 对象的 REST 接口地址
+func (s *OSSStore) objectURL(key string) string {
+	return joinURL(s.scheme+"://"+s.bucket+"."+s.endpoint, key)
+}
+
+// sign 计算 V1 签名，date 在预签名地址中为过期时间戳
+func (s *OSSStore) sign(method, contentType, date, key string) string {
+	stringToSign := method + "\n\n" + contentType + "\n" + date + "\n/" + s.bucket + "/" + key
+	mac := hmac.New(sha1.New, []byte(s.secretKey))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// PresignPut 签发直传 OSS 的上传地址
+func (s *OSSStore) PresignPut(ctx context.Context, key, contentType string, expires time.Duration) (*UploadTicket, error) {
+	expiresAt := time.Now().Add(expires)
+	expiresStr := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("OSSAccessKeyId", s.accessKey)
+	query.Set("Expires", expiresStr)
+	query.Set("Signature", s.sign(http.MethodPut, contentType, expiresStr, key))
+
+	return &UploadTicket{
+		Key:       key,
+		URL:       s.objectURL(key) + "?" + query.Encode(),
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// do 发送带签名的请求
+func (s *OSSStore) do(ctx context.Context, method, key string, body io.Reader, size int64, contentType string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), body)
+	if err != nil {
+		return nil, err
+	}
+	date := time.Now().UTC().Format(http.TimeFormat)
+	req.Header.Set("Date", date)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	req.Header.Set("Authorization", "OSS "+s.accessKey+":"+s.sign(method, contentType, date, key))
+	return s.client.Do(req)
+}
+
+// Put 上传对象
+func (s *OSSStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	resp, err := s.do(ctx, http.MethodPut, key, body, size, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return ossError(resp)
+	}
+	return nil
+}
+
+// Get 下载对象，调用方负责关闭
+func (s *OSSStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, ossError(resp)
+	}
+	return resp.Body, nil
+}
+
+// Stat 获取对象大小和类型
+func (s *OSSStore) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, ossError(resp)
+	}
+	return &ObjectInfo{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+// URL 对象的访问地址
+func (s *OSSStore) URL(key string) string {
+	return joinURL(s.publicURL, key)
+}
+
+// ossError 读取 OSS 返回的错误信息
+func ossError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("oss %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"hupu/shared/config"
+)
+
+const (
+	BackendOSS   = "oss"
+	BackendLocal = "local"
+
+	DefaultUploadExpire  = 10 * time.Minute
+	DefaultMaxImageSize  = 10 << 20
+	DefaultThumbnailSize = 360
+)
+
+// ErrNotFound 对象不存在
+var ErrNotFound = errors.New("object not found")
+
+// UploadTicket 预签名上传凭证，客户端按 Method 和 Headers 直接把文件上传到 URL
+type UploadTicket struct {
+	Key       string
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// ObjectInfo 对象元信息
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// BlobStore 对象存储，key 为不以 / 开头的相对路径
+type BlobStore interface {
+	// PresignPut 签发上传凭证，上传时必须使用签名时的 Content-Type
+	PresignPut(ctx context.Context, key, contentType string, expires time.Duration) (*UploadTicket, error)
+	// Put 写入对象，已存在时覆盖
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get 读取对象，不存在时返回 ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Stat 获取对象元信息，不存在时返回 ErrNotFound
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// URL 对象的访问地址
+	URL(key string) string
+}
+
+var (
+	defaultStore BlobStore
+	defaultErr   error
+	defaultOnce  sync.Once
+)
+
+// Default 按配置创建的对象存储，进程内只创建一次
+func Default() (BlobStore, error) {
+	defaultOnce.Do(func() {
+		if config.GlobalConfig == nil {
+			defaultErr = fmt.Errorf("config not loaded")
+			return
+		}
+		defaultStore, defaultErr = New(config.GlobalConfig.Storage, config.GlobalConfig.OSS)
+	})
+	return defaultStore, defaultErr
+}
+
+// New 按存储后端创建对象存储
+func New(cfg config.StorageConfig, ossCfg config.OSSConfig) (BlobStore, error) {
+	switch cfg.Backend {
+	case BackendOSS:
+		return NewOSSStore(ossCfg, cfg.PublicURL)
+	case BackendLocal, "":
+		return NewLocalStore(cfg.LocalDir, cfg.PublicURL, cfg.LocalSecret)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", cfg.Backend)
+	}
+}
+
+// UploadExpire 上传凭证有效期
+func UploadExpire() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Storage.UploadExpireSec > 0 {
+		return time.Duration(config.GlobalConfig.Storage.UploadExpireSec) * time.Second
+	}
+	return DefaultUploadExpire
+}
+
+// MaxImageSize 单张图片大小上限(字节)
+func MaxImageSize() int64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Storage.MaxImageSizeKB > 0 {
+		return int64(config.GlobalConfig.Storage.MaxImageSizeKB) << 10
+	}
+	return DefaultMaxImageSize
+}
+
+// ThumbnailSize 九宫格缩略图边长
+func ThumbnailSize() int {
+	if config.GlobalConfig != nil && config.GlobalConfig.Storage.ThumbnailSize > 0 {
+		return config.GlobalConfig.Storage.ThumbnailSize
+	}
+	return DefaultThumbnailSize
+}
+
+// joinURL 拼接访问地址前缀和对象 key
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + escapeKey(key)
+}
+
+// escapeKey 按路径段转义 key，保留分隔符 /
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvalidCursor 游标无法解析
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor 键集分页游标，记录上一页最后一条记录的排序值和ID
+// 按时间排序的列表使用 Time，按分值排序的列表使用 Score
+type Cursor struct {
+	Time  int64   `json:"t,omitempty"` // 毫秒时间戳
+	Score float64 `json:"s,omitempty"`
+	ID    string  `json:"i"`
+}
+
+// NewTimeCursor 根据创建时间和ID生成游标
+func NewTimeCursor(t time.Time, id string) *Cursor {
+	return &Cursor{Time: t.UnixMilli(), ID: id}
+}
+
+// NewScoreCursor 根据分值和ID生成游标
+func NewScoreCursor(score float64, id string) *Cursor {
+	return &Cursor{Score: score, ID: id}
+}
+
+// CreatedAt 游标对应的时间
+func (c *Cursor) CreatedAt() time.Time {
+	return time.UnixMilli(c.Time)
+}
+
+// Encode 编码为对客户端不透明的字符串
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor 解析客户端传入的游标，空字符串返回 nil
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// TimeCursorScope 按 (timeColumn, idColumn) 倒序翻页，取游标之后的记录
+// 调用方需按 timeColumn DESC, idColumn DESC 排序
+func TimeCursorScope(timeColumn, idColumn string, c *Cursor) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if c == nil {
+			return db
+		}
+		t := c.CreatedAt()
+		return db.Where("("+timeColumn+" < ? OR ("+timeColumn+" = ? AND "+idColumn+" < ?))", t, t, c.ID)
+	}
+}
@@ -61,6 +61,22 @@ func InitDB() error {
 		&models.PostFavorite{},
 		&models.PostRating{},
 		&models.Topic{},
+		&models.TopicCategory{},
+		&models.TopicSubscription{},
+		&models.PostRevision{},
+		&models.PostDraft{},
+		&models.Poll{},
+		&models.PollOption{},
+		&models.PollVote{},
+		&models.Tag{},
+		&models.PostTag{},
+		&models.FavoriteFolder{},
+		&models.ModerationRecord{},
+		&models.ReportCase{},
+		&models.Report{},
+		&models.Appeal{},
+		&models.Block{},
+		&models.Mute{},
 	)
 	if err != nil {
 		return err
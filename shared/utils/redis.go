@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/rs/xid"
 )
 
 var redisClient *RedisClient
@@ -153,6 +154,11 @@ func (rc *RedisClient) HDel(key string, fields ...string) (int64, error) {
 	return rc.client.HDel(rc.ctx, key, fields...).Result()
 }
 
+// HIncrBy 为哈希表中的字段值加上增量
+func (rc *RedisClient) HIncrBy(key, field string, incr int64) (int64, error) {
+	return rc.client.HIncrBy(rc.ctx, key, field, incr).Result()
+}
+
 // HExists 查看哈希表的指定字段是否存在
 func (rc *RedisClient) HExists(key, field string) (bool, error) {
 	return rc.client.HExists(rc.ctx, key, field).Result()
@@ -296,6 +302,16 @@ func (rc *RedisClient) ZRevRangeByScore(key string, opt *redis.ZRangeBy) ([]stri
 	return rc.client.ZRevRangeByScore(rc.ctx, key, opt).Result()
 }
 
+// ZRevRangeByScoreWithScores 通过分数区间返回有序集合的成员和分数 (按分数从大到小排序)
+func (rc *RedisClient) ZRevRangeByScoreWithScores(key string, opt *redis.ZRangeBy) ([]redis.Z, error) {
+	return rc.client.ZRevRangeByScoreWithScores(rc.ctx, key, opt).Result()
+}
+
+// ZCount 计算有序集合中指定分数区间的成员数
+func (rc *RedisClient) ZCount(key, min, max string) (int64, error) {
+	return rc.client.ZCount(rc.ctx, key, min, max).Result()
+}
+
 // ZCard 获取有序集合的成员数
 func (rc *RedisClient) ZCard(key string) (int64, error) {
 	return rc.client.ZCard(rc.ctx, key).Result()
@@ -324,6 +340,38 @@ func (rc *RedisClient) ZRevRank(key, member string) (int64, error) {
 	return rank, err
 }
 
+// ZIncrBy 为有序集合中指定成员的分数加上增量
+func (rc *RedisClient) ZIncrBy(key string, increment float64, member string) (float64, error) {
+	return rc.client.ZIncrBy(rc.ctx, key, increment, member).Result()
+}
+
+// ZInterStore 计算给定有序集合的交集并存储到 destination
+func (rc *RedisClient) ZInterStore(destination string, store *redis.ZStore) (int64, error) {
+	return rc.client.ZInterStore(rc.ctx, destination, store).Result()
+}
+
+// ZUnionStore 计算给定有序集合的并集并存储到 destination
+func (rc *RedisClient) ZUnionStore(destination string, store *redis.ZStore) (int64, error) {
+	return rc.client.ZUnionStore(rc.ctx, destination, store).Result()
+}
+
+// ZRemRangeByScore 移除有序集合中给定分数区间的所有成员
+func (rc *RedisClient) ZRemRangeByScore(key, min, max string) (int64, error) {
+	return rc.client.ZRemRangeByScore(rc.ctx, key, min, max).Result()
+}
+
+// --- HyperLogLog Commands ---
+
+// PFAdd 向 HyperLogLog 添加元素，基数估计值发生变化时返回 1
+func (rc *RedisClient) PFAdd(key string, els ...interface{}) (int64, error) {
+	return rc.client.PFAdd(rc.ctx, key, els...).Result()
+}
+
+// PFCount 返回 HyperLogLog 的基数估计值，多个 key 时返回并集的基数
+func (rc *RedisClient) PFCount(keys ...string) (int64, error) {
+	return rc.client.PFCount(rc.ctx, keys...).Result()
+}
+
 // --- Key Commands ---
 
 // Exists 检查给定 key 是否存在
@@ -331,6 +379,11 @@ func (rc *RedisClient) Exists(keys ...string) (int64, error) {
 	return rc.client.Exists(rc.ctx, keys...).Result()
 }
 
+// Rename 修改 key 的名称，newKey 已存在时会被覆盖
+func (rc *RedisClient) Rename(key, newKey string) error {
+	return rc.client.Rename(rc.ctx, key, newKey).Err()
+}
+
 // Expire 为给定 key 设置过期时间
 func (rc *RedisClient) Expire(key string, expiration time.Duration) (bool, error) {
 	return rc.client.Expire(rc.ctx, key, expiration).Result()
@@ -415,6 +468,31 @@ func (rc *RedisClient) ScriptFlush() error {
 	return rc.client.ScriptFlush(rc.ctx).Err()
 }
 
+// --- Lock ---
+
+// unlockScript 只有锁仍由自己持有时才删除，避免锁过期后误删其他实例的锁
+const unlockScript = `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`
+
+// TryLock 尝试获取锁，成功时返回用于释放锁的持有者标识
+func (rc *RedisClient) TryLock(key string, expiration time.Duration) (string, bool, error) {
+	token := xid.New().String()
+	ok, err := rc.SetNX(key, token, expiration)
+	if err != nil || !ok {
+		return "", false, err
+	}
+	return token, true, nil
+}
+
+// Unlock 释放 TryLock 获取的锁，锁已过期或被其他实例持有时不做任何操作
+func (rc *RedisClient) Unlock(key, token string) error {
+	_, err := rc.Eval(unlockScript, []string{key}, token)
+	return err
+}
+
 // --- Other Commands ---
 
 // Ping 测试服务器是否连接正常
@@ -0,0 +1,184 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"hupu/shared/config"
+	"hupu/shared/models"
+	"hupu/shared/utils"
+)
+
+const (
+	viewDedupeKeyPrefix = "post:view:dedupe:" // + 帖子ID:浏览者，窗口内已计数
+	viewBufferKey       = "post:view:buffer"  // 待写入数据库的浏览量，帖子ID -> 增量
+	viewFlushingKey     = "post:view:buffer:flushing"
+	viewFlushLockKey    = "post:view:flush:lock"
+
+	viewFlushLockTTL = time.Minute
+	viewFlushBatch   = 200
+
+	DefaultViewDedupeWindow  = 30 * time.Minute
+	DefaultViewFlushInterval = 30 * time.Second
+)
+
+// DedupeWindow 同一浏览者重复浏览只计一次的时间窗口
+func DedupeWindow() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.ViewDedupeWindowMin > 0 {
+		return time.Duration(config.GlobalConfig.Post.ViewDedupeWindowMin) * time.Minute
+	}
+	return DefaultViewDedupeWindow
+}
+
+// FlushInterval 浏览量写回数据库的间隔
+func FlushInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.ViewFlushIntervalSec > 0 {
+		return time.Duration(config.GlobalConfig.Post.ViewFlushIntervalSec) * time.Second
+	}
+	return DefaultViewFlushInterval
+}
+
+// Viewer 浏览者标识，登录用户用用户ID，未登录用设备ID，都没有时用客户端IP
+func Viewer(userID, deviceID, clientIP string) string {
+	if userID != "" {
+		return "u:" + userID
+	}
+	if deviceID != "" {
+		return "d:" + deviceID
+	}
+	if clientIP != "" {
+		return "ip:" + clientIP
+	}
+	return ""
+}
+
+// Counter 浏览量计数器
+// 同一浏览者在窗口内只计一次，计数先累加在 Redis 中，由后台任务批量写回 posts.view_count
+type Counter struct {
+	db    *gorm.DB
+	redis *utils.RedisClient
+}
+
+// NewCounter 创建浏览量计数器
+func NewCounter(db *gorm.DB, redisClient *utils.RedisClient) *Counter {
+	return &Counter{
+		db:    db,
+		redis: redisClient,
+	}
+}
+
+// Record 记录一次浏览，返回是否计入浏览量
+// 无法识别浏览者时不计数，避免反复请求刷浏览量
+func (c *Counter) Record(postID, viewer string) (bool, error) {
+	if viewer == "" {
+		return false, nil
+	}
+	ok, err := c.redis.SetNX(viewDedupeKeyPrefix+postID+":"+viewer, 1, DedupeWindow())
+	if err != nil || !ok {
+		return false, err
+	}
+	if _, err := c.redis.HIncrBy(viewBufferKey, postID, 1); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Pending 尚未写回数据库的浏览量
+func (c *Counter) Pending(postID string) int32 {
+	var pending int32
+	for _, key := range []string{viewBufferKey, viewFlushingKey} {
+		value, err := c.redis.HGet(key, postID)
+		if err != nil || value == "" {
+			continue
+		}
+		if n, err := strconv.ParseInt(value, 10, 32); err == nil {
+			pending += int32(n)
+		}
+	}
+	return pending
+}
+
+// Flush 将缓冲的浏览量批量写回数据库，返回浏览量有变化的帖子
+// 缓冲区先整体改名再写库，写库期间的新浏览进入新的缓冲区；每批写入成功后才从待写入集合中删除，
+// 失败的批次留到下次重试
+func (c *Counter) Flush(ctx context.Context) ([]string, error) {
+	token, ok, err := c.redis.TryLock(viewFlushLockKey, viewFlushLockTTL)
+	if err != nil || !ok {
+		return nil, err
+	}
+	defer c.redis.Unlock(viewFlushLockKey, token)
+
+	// 上次未写完的批次优先处理
+	exists, err := c.redis.Exists(viewFlushingKey)
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		exists, err = c.redis.Exists(viewBufferKey)
+		if err != nil || exists == 0 {
+			return nil, err
+		}
+		if err := c.redis.Rename(viewBufferKey, viewFlushingKey); err != nil {
+			return nil, err
+		}
+	}
+
+	buffered, err := c.redis.HGetAll(viewFlushingKey)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(buffered))
+	deltas := make(map[string]int64, len(buffered))
+	for id, value := range buffered {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			c.redis.HDel(viewFlushingKey, id)
+			continue
+		}
+		ids = append(ids, id)
+		deltas[id] = n
+	}
+
+	flushed := make([]string, 0, len(ids))
+	for start := 0; start < len(ids); start += viewFlushBatch {
+		end := start + viewFlushBatch
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+		if err := c.incrementViewCounts(ctx, batch, deltas); err != nil {
+			return flushed, err
+		}
+		if _, err := c.redis.HDel(viewFlushingKey, batch...); err != nil {
+			return flushed, err
+		}
+		flushed = append(flushed, batch...)
+	}
+	return flushed, nil
+}
+
+// incrementViewCounts 一条 UPDATE 累加一批帖子的浏览量
+func (c *Counter) incrementViewCounts(ctx context.Context, ids []string, deltas map[string]int64) error {
+	var expr strings.Builder
+	args := make([]interface{}, 0, len(ids)*2)
+	expr.WriteString("view_count + CASE id")
+	for _, id := range ids {
+		expr.WriteString(" WHEN ? THEN ?")
+		args = append(args, id, deltas[id])
+	}
+	expr.WriteString(" ELSE 0 END")
+
+	err := c.db.WithContext(ctx).
+		Model(&models.Post{}).
+		Where("id IN ?", ids).
+		UpdateColumn("view_count", gorm.Expr(expr.String(), args...)).Error
+	if err != nil {
+		return fmt.Errorf("failed to flush view counts: %w", err)
+	}
+	return nil
+}
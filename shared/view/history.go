@@ -0,0 +1,96 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"hupu/shared/config"
+	"hupu/shared/utils"
+)
+
+const (
+	historyKeyPrefix = "post:history:" // + 用户ID，浏览历史，成员为帖子ID，分值为浏览时间
+
+	historyTTL = 90 * 24 * time.Hour
+
+	DefaultHistoryMaxSize = 200
+)
+
+func historyMaxSize() int64 {
+	if config.GlobalConfig != nil && config.GlobalConfig.Post.HistoryMaxSize > 0 {
+		return int64(config.GlobalConfig.Post.HistoryMaxSize)
+	}
+	return DefaultHistoryMaxSize
+}
+
+// HistoryEntry 一条浏览记录
+type HistoryEntry struct {
+	PostID   string
+	ViewedAt time.Time
+}
+
+// History 用户浏览历史，按最近浏览时间排序，重复浏览只保留最近一次，超出上限时淘汰最早的记录
+type History struct {
+	redis *utils.RedisClient
+}
+
+// NewHistory 创建浏览历史
+func NewHistory(redisClient *utils.RedisClient) *History {
+	return &History{redis: redisClient}
+}
+
+// Add 记录用户浏览了帖子
+func (h *History) Add(ctx context.Context, userID, postID string, at time.Time) error {
+	key := historyKeyPrefix + userID
+	_, err := h.redis.TxPipeline(func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(at.Unix()), Member: postID})
+		pipe.ZRemRangeByRank(ctx, key, 0, -historyMaxSize()-1)
+		pipe.Expire(ctx, key, historyTTL)
+		return nil
+	})
+	return err
+}
+
+// List 按浏览时间倒序分页获取浏览记录，同时返回记录总数
+func (h *History) List(userID string, page, pageSize int32) ([]*HistoryEntry, int64, error) {
+	key := historyKeyPrefix + userID
+	total, err := h.redis.ZCard(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	start := int64((page - 1) * pageSize)
+	results, err := h.redis.ZRevRangeWithScores(key, start, start+int64(pageSize)-1)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries := make([]*HistoryEntry, 0, len(results))
+	for _, z := range results {
+		id, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		entries = append(entries, &HistoryEntry{PostID: id, ViewedAt: time.Unix(int64(z.Score), 0)})
+	}
+	return entries, total, nil
+}
+
+// Remove 删除浏览记录
+func (h *History) Remove(userID string, postIDs ...string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(postIDs))
+	for _, id := range postIDs {
+		members = append(members, id)
+	}
+	_, err := h.redis.ZRem(historyKeyPrefix+userID, members...)
+	return err
+}
+
+// Clear 清空浏览历史
+func (h *History) Clear(userID string) error {
+	_, err := h.redis.Del(historyKeyPrefix + userID)
+	return err
+}